@@ -24,6 +24,7 @@ import (
 	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/bidon-io/bidon-backend/cmd/bidon-admin/web"
 	"github.com/bidon-io/bidon-backend/config"
@@ -77,6 +78,13 @@ func main() {
 	configureCORS(e)
 
 	store := adminstore.New(db)
+	// Redis is only needed to inspect floor statistics collected by SDK API.
+	if redisClusterAddrs := os.Getenv("REDIS_CLUSTER"); redisClusterAddrs != "" {
+		rdb := redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs: strings.Split(redisClusterAddrs, ","),
+		})
+		store.FloorStatsRepo = adminstore.NewFloorStatsRepo(rdb)
+	}
 	authConfig := auth.Config{
 		SecretKey:         []byte(os.Getenv("APP_SECRET")),
 		SuperUserLogin:    []byte(os.Getenv("SUPERUSER_LOGIN")),
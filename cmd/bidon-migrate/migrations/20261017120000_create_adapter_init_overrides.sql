@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.adapter_init_overrides
+(
+    id         bigserial PRIMARY KEY,
+    app_id     bigint                         NOT NULL,
+    segment_id bigint,
+    adapters   character varying[],
+    patches    jsonb   DEFAULT '{}'::jsonb    NOT NULL,
+    enabled    boolean DEFAULT true           NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL,
+
+    FOREIGN KEY (app_id) REFERENCES public.apps,
+    FOREIGN KEY (segment_id) REFERENCES public.segments
+);
+CREATE INDEX index_adapter_init_overrides_on_app_id ON public.adapter_init_overrides (app_id);
+CREATE UNIQUE INDEX adapter_init_overrides_app_uniq_idx
+    ON public.adapter_init_overrides (app_id)
+    WHERE segment_id IS NULL;
+CREATE UNIQUE INDEX adapter_init_overrides_segment_uniq_idx
+    ON public.adapter_init_overrides (app_id, segment_id)
+    WHERE segment_id IS NOT NULL;
+
+-- Move overrides previously hardcoded in SDK API config handler.
+INSERT INTO public.adapter_init_overrides (app_id, adapters, created_at, updated_at)
+SELECT id, ARRAY ['bidmachine'], NOW(), NOW()
+FROM public.apps
+WHERE id IN (735400, 735401, 735402, 735456);
+
+INSERT INTO public.adapter_init_overrides (app_id, patches, created_at, updated_at)
+SELECT id,
+       jsonb_build_object('applovin', jsonb_build_object(
+               'mediator', 'Bidon',
+               'ad_unit_ids', CASE id
+                                  WHEN 735385 THEN '["dbadb46cdb8dcbc4", "162430caf838b573", "af144a6470c71e42", "3a06357bdab4ee6b", "75401a0c835c12af", "8aacc527c3b5be39", "1638c8cee4745fa0", "48077ec3fc1fc3ec", "3ed4466ff294569a"]'::jsonb
+                                  ELSE '["062983d4dd0358e2", "4cbcf418b2f994e2", "280987aff5690161", "1cdd6eb52418c959", "e264b1821be63bc9", "065b4f0d1977d97d", "ce39f0751a52990f", "926fb917d86ee946", "13407842c572a710"]'::jsonb
+                   END
+                          )),
+       NOW(),
+       NOW()
+FROM public.apps
+WHERE id IN (735385, 735379);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.adapter_init_overrides;
+-- +goose StatementEnd
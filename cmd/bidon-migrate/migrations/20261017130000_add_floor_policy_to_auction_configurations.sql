@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE auction_configurations
+ADD COLUMN floor_policy jsonb DEFAULT '{}'::jsonb NOT NULL;
+
+-- Move auction keys previously hardcoded in SDK API auction service.
+UPDATE auction_configurations
+SET floor_policy = '{"ignore_floor_for_mediators": ["max", "level_play"]}'::jsonb
+WHERE auction_key IN (
+    '1LOQ1LROG0000',
+    '1LOQ2BFG00000',
+    '1LOQ2KLES0400',
+    '1LPGM6QBC0000',
+    '1LPGMABLK0000',
+    '1LRBRTLCS0400',
+    '1LVHHK37O0400',
+    '1LVHHK51K0400',
+    '1LVHEVUJO0400',
+    '1LQP712M00000',
+    '1LQP75UU00400',
+    '1LQP7A4UG0400'
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE auction_configurations
+DROP COLUMN floor_policy;
+-- +goose StatementEnd
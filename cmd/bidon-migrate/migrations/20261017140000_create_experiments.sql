@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.experiments
+(
+    id                       bigserial PRIMARY KEY,
+    app_id                   bigint                                        NOT NULL,
+    auction_configuration_id bigint                                        NOT NULL,
+    name                     character varying                             NOT NULL,
+    status                   character varying DEFAULT 'running'::character varying NOT NULL,
+    variants                 jsonb             DEFAULT '[]'::jsonb         NOT NULL,
+    winner_variant_id        character varying,
+    concluded_at             timestamp(6) without time zone,
+    created_at               timestamp(6) without time zone                NOT NULL,
+    updated_at               timestamp(6) without time zone                NOT NULL,
+
+    FOREIGN KEY (app_id) REFERENCES public.apps,
+    FOREIGN KEY (auction_configuration_id) REFERENCES public.auction_configurations
+);
+CREATE INDEX index_experiments_on_app_id ON public.experiments (app_id);
+-- Auction configuration can take part in a single experiment at a time.
+CREATE UNIQUE INDEX experiments_active_auction_configuration_uniq_idx
+    ON public.experiments (auction_configuration_id)
+    WHERE status <> 'concluded';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.experiments;
+-- +goose StatementEnd
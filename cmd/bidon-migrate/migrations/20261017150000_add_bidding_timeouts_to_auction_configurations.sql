@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE auction_configurations
+ADD COLUMN bidding_timeouts jsonb DEFAULT '{}'::jsonb NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE auction_configurations
+DROP COLUMN bidding_timeouts;
+-- +goose StatementEnd
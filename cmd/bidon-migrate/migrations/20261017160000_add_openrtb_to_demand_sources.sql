@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE demand_sources
+ADD COLUMN openrtb jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE demand_sources
+DROP COLUMN openrtb;
+-- +goose StatementEnd
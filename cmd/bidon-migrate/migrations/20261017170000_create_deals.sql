@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE public.deals
+(
+    id               bigserial PRIMARY KEY,
+    app_id           bigint                         NOT NULL,
+    demand_source_id bigint                         NOT NULL,
+    deal_id          character varying              NOT NULL,
+    bid_floor        numeric,
+    priority         integer DEFAULT 0              NOT NULL,
+    segment_id       bigint,
+    countries        character varying[],
+    enabled          boolean DEFAULT true           NOT NULL,
+    created_at       timestamp(6) without time zone NOT NULL,
+    updated_at       timestamp(6) without time zone NOT NULL,
+
+    FOREIGN KEY (app_id) REFERENCES public.apps,
+    FOREIGN KEY (demand_source_id) REFERENCES public.demand_sources,
+    FOREIGN KEY (segment_id) REFERENCES public.segments
+);
+CREATE INDEX index_deals_on_app_id ON public.deals (app_id);
+CREATE UNIQUE INDEX deals_deal_uniq_idx ON public.deals (app_id, demand_source_id, deal_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.deals;
+-- +goose StatementEnd
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"os/signal"
 	"runtime"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -19,12 +21,14 @@ import (
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
 	"github.com/oschwald/maxminddb-golang"
 	"github.com/redis/go-redis/v9"
 	"github.com/twmb/franz-go/pkg/kgo"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/bidon-io/bidon-backend/config"
@@ -35,6 +39,8 @@ import (
 	"github.com/bidon-io/bidon-backend/internal/bidding"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters_builder"
 	dbpkg "github.com/bidon-io/bidon-backend/internal/db"
+	"github.com/bidon-io/bidon-backend/internal/floor"
+	floorstore "github.com/bidon-io/bidon-backend/internal/floor/store"
 	"github.com/bidon-io/bidon-backend/internal/notification"
 	notificationstore "github.com/bidon-io/bidon-backend/internal/notification/store"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi"
@@ -105,32 +111,125 @@ func main() {
 		}
 	}
 
-	var loggerEngine event.LoggerEngine
-	if os.Getenv("USE_KAFKA") == "true" {
-		conf, err := config.Kafka()
-		if err != nil {
-			log.Fatalf("config.Kafka(): %v", err)
-		}
+	eventSinksConf, err := config.EventSinks()
+	if err != nil {
+		log.Fatalf("config.EventSinks(): %v", err)
+	}
+	eventSinksCtx, stopEventSinks := context.WithCancel(context.Background())
+	eventSinksWG := sync.WaitGroup{}
+	runEventSink := func(run func(ctx context.Context, interval time.Duration, handleErr func(error)), interval time.Duration) {
+		eventSinksWG.Add(1)
+		go func() {
+			defer eventSinksWG.Done()
+			run(eventSinksCtx, interval, func(err error) {
+				log.Printf("event sink: %v", err)
+			})
+		}()
+	}
+	eventSinks := make([]engine.Sink, 0, len(eventSinksConf.Sinks))
+	for _, sinkName := range eventSinksConf.Sinks {
+		var sinkEngine event.LoggerEngine
+		switch sinkName {
+		case config.KafkaEventSink:
+			conf, err := config.Kafka()
+			if err != nil {
+				log.Fatalf("config.Kafka(): %v", err)
+			}
 
-		client, err := kgo.NewClient(conf.ClientOpts...)
-		if err != nil {
-			log.Fatalf("kgo.NewClient(): %v", err)
-		}
-		defer func() {
-			ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
-			defer ctxCancel()
+			var spill *engine.Spill
+			if conf.Spill.Dir != "" {
+				spill = &engine.Spill{Dir: conf.Spill.Dir, MaxSize: conf.Spill.MaxSize}
+				defer spill.Close()
+			}
 
-			err := client.Flush(ctx)
+			client, err := kgo.NewClient(conf.ClientOpts...)
 			if err != nil {
-				log.Printf("kgo.Client.Flush(): %v", err)
+				log.Fatalf("kgo.NewClient(): %v", err)
 			}
-		}()
+			defer func() {
+				ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer ctxCancel()
 
-		loggerEngine = &engine.Kafka{Client: client, Topics: conf.Topics}
-	} else {
-		loggerEngine = &engine.Log{}
+				err := client.Flush(ctx)
+				if err != nil {
+					log.Printf("kgo.Client.Flush(): %v", err)
+				}
+			}()
+
+			kafkaEngine := &engine.Kafka{Client: client, Topics: conf.Topics, Spill: spill}
+			if err := kafkaEngine.Monitor(meter); err != nil {
+				log.Fatalf("engine.Kafka.Monitor(): %v", err)
+			}
+			if spill != nil {
+				runEventSink(kafkaEngine.Run, conf.Spill.ReplayInterval)
+			}
+
+			sinkEngine = kafkaEngine
+		case config.FileEventSink:
+			fileEngine := &engine.File{
+				Dir:     eventSinksConf.File.Dir,
+				MaxSize: eventSinksConf.File.MaxSize,
+				MaxAge:  eventSinksConf.File.MaxAge,
+				Clock:   clock.New(),
+			}
+			defer fileEngine.Close()
+
+			sinkEngine = fileEngine
+		case config.HTTPEventSink:
+			httpEngine := &engine.HTTPBatch{
+				URL:       eventSinksConf.HTTP.URL,
+				Client:    &http.Client{Timeout: 10 * time.Second},
+				BatchSize: eventSinksConf.HTTP.BatchSize,
+			}
+			runEventSink(httpEngine.Run, eventSinksConf.HTTP.FlushInterval)
+
+			sinkEngine = httpEngine
+		case config.ClickHouseEventSink:
+			clickHouseEngine := &engine.ClickHouse{
+				URL:       eventSinksConf.ClickHouse.URL,
+				Database:  eventSinksConf.ClickHouse.Database,
+				User:      eventSinksConf.ClickHouse.User,
+				Password:  eventSinksConf.ClickHouse.Password,
+				Tables:    eventSinksConf.ClickHouse.Tables,
+				Client:    &http.Client{Timeout: 10 * time.Second},
+				BatchSize: eventSinksConf.ClickHouse.BatchSize,
+			}
+			runEventSink(clickHouseEngine.Run, eventSinksConf.ClickHouse.FlushInterval)
+
+			sinkEngine = clickHouseEngine
+		default:
+			sinkEngine = &engine.Log{}
+		}
+
+		eventSinks = append(eventSinks, engine.Sink{Engine: sinkEngine, Topics: eventSinksConf.Topics[sinkName]})
+	}
+	var loggerEngine event.LoggerEngine = &engine.FanOut{Sinks: eventSinks}
+	if len(eventSinks) == 1 && len(eventSinks[0].Topics) == 0 {
+		loggerEngine = eventSinks[0].Engine
+	}
+	floorDistributionsCache := config.NewRedisCacheOf[floor.Distribution](rdb, 10*time.Minute, "floor_distributions")
+	err = floorDistributionsCache.Monitor(meter)
+	if err != nil {
+		log.Fatalf("Unable to register observer for floorDistributionsCache: %v", err)
+	}
+	floorDistributionRepo := &floorstore.DistributionRepo{
+		Redis: rdb,
+		Clock: clock.New(),
+		Cache: floorDistributionsCache,
+	}
+	floorCollector := &floor.Collector{Recorder: floorDistributionRepo}
+	floorCollectorCtx, stopFloorCollector := context.WithCancel(context.Background())
+	go floorCollector.Run(floorCollectorCtx, time.Minute, func(err error) {
+		log.Printf("floor.Collector.Flush(): %v", err)
+	})
+	eventLogger := &event.Logger{
+		Engine:    loggerEngine,
+		Observers: []event.Observer{floorCollector},
+		Encodings: make(map[config.Topic]event.Encoding),
+	}
+	for topic, encoding := range eventSinksConf.Encodings {
+		eventLogger.Encodings[topic] = event.Encoding(encoding)
 	}
-	eventLogger := &event.Logger{Engine: loggerEngine}
 
 	geoCoder := &geocoder.Geocoder{
 		DB:        db,
@@ -174,12 +273,74 @@ func main() {
 			MaxIdleConnsPerHost: 30 * cpus,
 		}),
 	}
+	notificationMacrosConf, err := config.NotificationMacros()
+	if err != nil {
+		log.Fatalf("config.NotificationMacros(): %v", err)
+	}
+	notificationMacros := &notification.MacroExpander{
+		Currencies:        make(map[adapter.Key]string, len(notificationMacrosConf.Currencies)),
+		CurrencyConverter: notification.ExchangeRates(notificationMacrosConf.ExchangeRates),
+		CustomMacros:      make(map[adapter.Key]map[string]notification.MacroFunc, len(notificationMacrosConf.CustomMacros)),
+	}
+	for demandID, currency := range notificationMacrosConf.Currencies {
+		if _, ok := notificationMacrosConf.ExchangeRates[currency]; !ok && currency != "USD" {
+			log.Fatalf("config.NotificationMacros(): no exchange rate for %s currency of %s", currency, demandID)
+		}
+		notificationMacros.Currencies[adapter.Key(demandID)] = currency
+	}
+	for demandID, macros := range notificationMacrosConf.CustomMacros {
+		fns := make(map[string]notification.MacroFunc, len(macros))
+		for name, param := range macros {
+			fn, ok := notification.ParamMacros[param]
+			if !ok {
+				log.Fatalf("config.NotificationMacros(): unknown param %q of %s macro %s", param, demandID, name)
+			}
+			fns[name] = fn
+		}
+		notificationMacros.CustomMacros[adapter.Key(demandID)] = fns
+	}
+	notificationSender := notification.EventSender{
+		HttpClient:  biddingHTTPClient,
+		EventLogger: eventLogger,
+		Macros:      notificationMacros,
+	}
 	notificationHandler := notification.Handler{
 		AuctionResultRepo: notificationstore.AuctionResultRepo{Redis: rdb},
-		Sender: notification.EventSender{
-			HttpClient:  biddingHTTPClient,
-			EventLogger: eventLogger,
-		},
+		Sender:            notificationSender,
+		EventLogger:       eventLogger,
+	}
+	notificationQueueConf, err := config.NotificationQueue()
+	if err != nil {
+		log.Fatalf("config.NotificationQueue(): %v", err)
+	}
+	notificationQueueCtx, stopNotificationQueue := context.WithCancel(context.Background())
+	notificationQueueDone := make(chan struct{})
+	if notificationQueueConf.Enabled {
+		hostname, _ := os.Hostname()
+		rateLimits := make(map[adapter.Key]rate.Limit, len(notificationQueueConf.RateLimits))
+		for demandID, rps := range notificationQueueConf.RateLimits {
+			rateLimits[adapter.Key(demandID)] = rate.Limit(rps)
+		}
+		notificationQueue := &notification.DeliveryQueue{
+			Store:            &notificationstore.DeliveryQueueRepo{Redis: rdb},
+			Deliverer:        notificationSender,
+			EventLogger:      eventLogger,
+			Clock:            clock.New(),
+			Consumer:         hostname,
+			Workers:          notificationQueueConf.Workers,
+			MaxAttempts:      notificationQueueConf.MaxAttempts,
+			RateLimits:       rateLimits,
+			DefaultRateLimit: rate.Limit(notificationQueueConf.DefaultRateLimit),
+		}
+		notificationHandler.Sender = notificationQueue
+		go func() {
+			defer close(notificationQueueDone)
+			notificationQueue.Run(notificationQueueCtx, func(err error) {
+				log.Printf("notification.DeliveryQueue.Run(): %v", err)
+			})
+		}()
+	} else {
+		close(notificationQueueDone)
 	}
 	adUnitsCache := config.NewRedisCacheOf[[]auction.AdUnit](rdb, 10*time.Minute, "ad_units")
 	err = adUnitsCache.Monitor(meter)
@@ -190,10 +351,22 @@ func main() {
 		DB:    db,
 		Cache: adUnitsCache,
 	}
+	dealsCache := config.NewRedisCacheOf[[]auction.Deal](rdb, 10*time.Minute, "deals")
+	err = dealsCache.Monitor(meter)
+	if err != nil {
+		log.Fatalf("Unable to register observer for dealsCache: %v", err)
+	}
+	dealsFetcher := &auctionstore.DealsFetcher{
+		DB:    db,
+		Cache: dealsCache,
+	}
+	circuitBreaker := bidding.NewCircuitBreaker(clock.New())
+	circuitBreaker.PerAccount = os.Getenv("BIDDING_CIRCUIT_BREAKER_PER_ACCOUNT") == "true"
 	biddingBuilder := &bidding.Builder{
 		AdaptersBuilder:     adapters_builder.BuildBiddingAdapters(biddingHTTPClient),
 		NotificationHandler: notificationHandler,
-		BidCacher:           &bidding.BidCache{Redis: rdb, Clock: clock.New()},
+		BidCacher:           &bidding.BidCache{Redis: rdb, Clock: clock.New(), NotificationHandler: notificationHandler, EventLogger: eventLogger},
+		CircuitBreaker:      circuitBreaker,
 	}
 	biddingAdaptersCfgCache := config.NewRedisCacheOf[adapter.RawConfigsMap](rdb, 10*time.Minute, "bidding_adapters_cfg")
 	err = biddingAdaptersCfgCache.Monitor(meter)
@@ -225,6 +398,12 @@ func main() {
 	}
 
 	adapterInitConfigsFetcher := &sdkapistore.AdapterInitConfigsFetcher{DB: db, ProfilesCache: profilesCache, AmazonSlotsCache: amazonSlotsCache, LineItemsCache: lineItemsCache}
+	adapterInitOverridesCache := config.NewRedisCacheOf[[]sdkapi.AdapterInitOverride](rdb, 10*time.Minute, "adapter_init_overrides")
+	err = adapterInitOverridesCache.Monitor(meter)
+	if err != nil {
+		log.Fatalf("Unable to register observer for adapterInitOverridesCache: %v", err)
+	}
+	adapterInitOverridesFetcher := &sdkapistore.AdapterInitOverridesFetcher{DB: db, Cache: adapterInitOverridesCache}
 	configsCache := config.NewRedisCacheOf[adapter.RawConfigsMap](rdb, 10*time.Minute, "configs")
 	err = configsCache.Monitor(meter)
 	if err != nil {
@@ -243,16 +422,33 @@ func main() {
 		DB:    db,
 		Cache: adUnitLookupCache,
 	}
+	rawPayloadsConf, err := config.RawPayloads()
+	if err != nil {
+		log.Fatalf("config.RawPayloads(): %v", err)
+	}
+	rawPayloads := &auction.RawPayloadPolicy{
+		SampleRate:         rawPayloadsConf.SampleRate,
+		AppSampleRates:     rawPayloadsConf.AppSampleRates,
+		AdapterSampleRates: make(map[adapter.Key]float64),
+		CaptureErrors:      rawPayloadsConf.CaptureErrors,
+	}
+	for key, rate := range rawPayloadsConf.AdapterSampleRates {
+		rawPayloads.AdapterSampleRates[adapter.Key(key)] = rate
+	}
+
 	auctionService := &auction.Service{
 		ConfigFetcher:      configFetcher,
 		SegmentMatcher:     segmentMatcher,
 		AdapterKeysFetcher: adapterInitConfigsFetcher,
+		FloorOptimizer:     &floor.Optimizer{Distributions: floorDistributionRepo},
 		AuctionBuilder: &auction.Builder{
 			AdUnitsMatcher:               adUnitsMatcher,
 			BiddingBuilder:               biddingBuilder,
 			BiddingAdaptersConfigBuilder: biddingAdaptersCfgBuilder,
+			DealsFetcher:                 dealsFetcher,
 		},
 		EventLogger: eventLogger,
+		RawPayloads: rawPayloads,
 	}
 
 	e := config.Echo()
@@ -265,21 +461,34 @@ func main() {
 	})
 	v2Group.Use(sdkapi.CheckBidonHeader)
 	routerV2 := v2.Router{
-		ConfigFetcher:             configFetcher,
-		AppFetcher:                appFetcher,
-		SegmentMatcher:            segmentMatcher,
-		BiddingBuilder:            biddingBuilder,
-		AdUnitsMatcher:            adUnitsMatcher,
-		NotificationHandler:       notificationHandler,
-		GeoCoder:                  geoCoder,
-		EventLogger:               eventLogger,
-		AdapterInitConfigsFetcher: adapterInitConfigsFetcher,
-		ConfigurationFetcher:      configurationFetcher,
-		AuctionService:            auctionService,
-		AdUnitLookup:              adUnitLookup,
+		ConfigFetcher:               configFetcher,
+		AppFetcher:                  appFetcher,
+		SegmentMatcher:              segmentMatcher,
+		BiddingBuilder:              biddingBuilder,
+		AdUnitsMatcher:              adUnitsMatcher,
+		NotificationHandler:         notificationHandler,
+		GeoCoder:                    geoCoder,
+		EventLogger:                 eventLogger,
+		AdapterInitConfigsFetcher:   adapterInitConfigsFetcher,
+		AdapterInitOverridesFetcher: adapterInitOverridesFetcher,
+		ConfigurationFetcher:        configurationFetcher,
+		AuctionService:              auctionService,
+		AdUnitLookup:                adUnitLookup,
 	}
 	routerV2.RegisterRoutes(v2Group)
 
+	if debugToken := os.Getenv("DEBUG_API_TOKEN"); debugToken != "" {
+		debugGroup := e.Group("/debug")
+		config.UseCommonMiddleware(debugGroup, config.Middleware{
+			Service: "bidon-sdkapi",
+			Logger:  logger,
+		})
+		debugGroup.Use(middleware.KeyAuth(func(key string, _ echo.Context) (bool, error) {
+			return subtle.ConstantTimeCompare([]byte(key), []byte(debugToken)) == 1, nil
+		}))
+		routerV2.RegisterDebugRoutes(debugGroup)
+	}
+
 	docsWebServer := http.FileServer(http.FS(openapi.FS))
 	e.GET("/docs/*", echo.WrapHandler(http.StripPrefix("/docs/", docsWebServer)))
 
@@ -291,6 +500,9 @@ func main() {
 		"redis": config.NewRedisPinger(rdb),
 		"kafka": eventLogger.Engine,
 	})
+	e.GET("/health_checks/bidding_adapters", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, circuitBreaker.Statuses())
+	})
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -341,4 +553,15 @@ func main() {
 	}
 
 	grpcServer.GracefulStop()
+
+	stopNotificationQueue()
+	<-notificationQueueDone
+
+	stopFloorCollector()
+	if err := floorCollector.Flush(ctx); err != nil {
+		log.Printf("floor.Collector.Flush(): %v", err)
+	}
+
+	stopEventSinks()
+	eventSinksWG.Wait()
 }
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event sinks selectable in EVENT_SINKS.
+const (
+	KafkaEventSink      = "kafka"
+	LogEventSink        = "log"
+	FileEventSink       = "file"
+	HTTPEventSink       = "http"
+	ClickHouseEventSink = "clickhouse"
+)
+
+// Event encodings selectable in EVENT_ENCODINGS.
+const (
+	JSONEventEncoding     = "json"
+	ProtobufEventEncoding = "protobuf"
+)
+
+type EventSinksConfig struct {
+	Sinks []string
+	// Topics routed to a sink by sink name. All topics are routed to sinks not in the map.
+	Topics map[string][]Topic
+	// Encodings of event payloads by topic. Topics not in the map are encoded as JSON.
+	Encodings  map[Topic]string
+	File       FileEventSinkConfig
+	HTTP       HTTPEventSinkConfig
+	ClickHouse ClickHouseEventSinkConfig
+}
+
+type FileEventSinkConfig struct {
+	Dir     string
+	MaxSize int64
+	MaxAge  time.Duration
+}
+
+type HTTPEventSinkConfig struct {
+	URL           string
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+type ClickHouseEventSinkConfig struct {
+	URL           string
+	Database      string
+	User          string
+	Password      string
+	Tables        map[Topic]string
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+// EventSinks reads settings of engines analytic events are written to.
+// EVENT_SINKS is a comma separated list of sinks, e.g. "kafka,clickhouse". If it's empty, events go to Kafka
+// if USE_KAFKA is set and to the log otherwise. EVENT_<SINK>_TOPICS limits topics routed to the sink,
+// e.g. EVENT_FILE_TOPICS=ad_events. EVENT_ENCODINGS sets encoding by topic, e.g. "ad_events=protobuf";
+// only Kafka and log sinks accept protobuf events.
+func EventSinks() (conf EventSinksConfig, err error) {
+	conf.Sinks = splitList(os.Getenv("EVENT_SINKS"))
+	if len(conf.Sinks) == 0 {
+		conf.Sinks = []string{LogEventSink}
+		if os.Getenv("USE_KAFKA") == "true" {
+			conf.Sinks = []string{KafkaEventSink}
+		}
+	}
+
+	conf.Topics = make(map[string][]Topic)
+	for _, sink := range conf.Sinks {
+		switch sink {
+		case KafkaEventSink, LogEventSink, FileEventSink, HTTPEventSink, ClickHouseEventSink:
+		default:
+			return conf, fmt.Errorf("invalid EVENT_SINKS: unknown sink %q", sink)
+		}
+
+		for _, topic := range splitList(os.Getenv("EVENT_" + strings.ToUpper(sink) + "_TOPICS")) {
+			conf.Topics[sink] = append(conf.Topics[sink], Topic(topic))
+		}
+	}
+
+	if conf.Encodings, err = eventEncodings(conf); err != nil {
+		return conf, err
+	}
+
+	conf.File.Dir = os.Getenv("EVENT_FILE_DIR")
+	if value := os.Getenv("EVENT_FILE_MAX_SIZE_MB"); value != "" {
+		mb, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return conf, fmt.Errorf("invalid EVENT_FILE_MAX_SIZE_MB: %v", err)
+		}
+		conf.File.MaxSize = mb << 20
+	}
+	if conf.File.MaxAge, err = durationEnv("EVENT_FILE_MAX_AGE"); err != nil {
+		return conf, err
+	}
+
+	conf.HTTP.URL = os.Getenv("EVENT_HTTP_URL")
+	if conf.HTTP.BatchSize, err = intEnv("EVENT_HTTP_BATCH_SIZE"); err != nil {
+		return conf, err
+	}
+	if conf.HTTP.FlushInterval, err = durationEnv("EVENT_HTTP_FLUSH_INTERVAL"); err != nil {
+		return conf, err
+	}
+
+	conf.ClickHouse.URL = os.Getenv("CLICKHOUSE_URL")
+	conf.ClickHouse.Database = os.Getenv("CLICKHOUSE_DATABASE")
+	conf.ClickHouse.User = os.Getenv("CLICKHOUSE_USER")
+	conf.ClickHouse.Password = os.Getenv("CLICKHOUSE_PASSWORD")
+	conf.ClickHouse.Tables = map[Topic]string{
+		AdEventsTopic:           os.Getenv("CLICKHOUSE_AD_EVENTS_TABLE"),
+		NotificationEventsTopic: os.Getenv("CLICKHOUSE_NOTIFICATION_EVENTS_TABLE"),
+	}
+	if conf.ClickHouse.BatchSize, err = intEnv("CLICKHOUSE_BATCH_SIZE"); err != nil {
+		return conf, err
+	}
+	if conf.ClickHouse.FlushInterval, err = durationEnv("CLICKHOUSE_FLUSH_INTERVAL"); err != nil {
+		return conf, err
+	}
+
+	return conf, nil
+}
+
+func eventEncodings(conf EventSinksConfig) (map[Topic]string, error) {
+	encodings := make(map[Topic]string)
+	for _, item := range splitList(os.Getenv("EVENT_ENCODINGS")) {
+		topic, encoding, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid EVENT_ENCODINGS: %q is not topic=encoding", item)
+		}
+
+		switch encoding {
+		case JSONEventEncoding:
+		case ProtobufEventEncoding:
+			for _, sink := range conf.Sinks {
+				if sink == KafkaEventSink || sink == LogEventSink {
+					continue
+				}
+				if topics := conf.Topics[sink]; len(topics) == 0 || slices.Contains(topics, Topic(topic)) {
+					return nil, fmt.Errorf("invalid EVENT_ENCODINGS: %s sink doesn't accept %s %q events", sink, encoding, topic)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("invalid EVENT_ENCODINGS: unknown encoding %q", encoding)
+		}
+
+		encodings[Topic(topic)] = encoding
+	}
+
+	return encodings, nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func intEnv(name string) (int, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+
+	return n, nil
+}
+
+// durationEnv parses duration like "30s" or "1h".
+func durationEnv(name string) (time.Duration, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+
+	return d, nil
+}
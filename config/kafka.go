@@ -20,6 +20,14 @@ const (
 type KafkaConfig struct {
 	ClientOpts []kgo.Opt
 	Topics     map[Topic]string
+	Spill      KafkaSpillConfig
+}
+
+// KafkaSpillConfig configures disk buffer of records that can't be produced. Spilling is off if Dir is empty.
+type KafkaSpillConfig struct {
+	Dir            string
+	MaxSize        int64
+	ReplayInterval time.Duration
 }
 
 func Kafka() (conf KafkaConfig, err error) {
@@ -52,6 +60,38 @@ func Kafka() (conf KafkaConfig, err error) {
 		conf.ClientOpts = append(conf.ClientOpts, kgo.ProducerLinger(time.Second*time.Duration(value)))
 	}
 
+	deliveryTimeout := os.Getenv("KAFKA_RECORD_DELIVERY_TIMEOUT")
+	if deliveryTimeout != "" {
+		value, err := time.ParseDuration(deliveryTimeout)
+		if err != nil {
+			return conf, fmt.Errorf("invalid KAFKA_RECORD_DELIVERY_TIMEOUT: %v", err)
+		}
+
+		conf.ClientOpts = append(conf.ClientOpts, kgo.RecordDeliveryTimeout(value))
+	}
+
+	maxBufferedRecords := os.Getenv("KAFKA_MAX_BUFFERED_RECORDS")
+	if maxBufferedRecords != "" {
+		value, err := strconv.Atoi(maxBufferedRecords)
+		if err != nil {
+			return conf, fmt.Errorf("invalid KAFKA_MAX_BUFFERED_RECORDS: %v", err)
+		}
+
+		conf.ClientOpts = append(conf.ClientOpts, kgo.MaxBufferedRecords(value))
+	}
+
+	conf.Spill.Dir = os.Getenv("KAFKA_SPILL_DIR")
+	if value := os.Getenv("KAFKA_SPILL_MAX_SIZE_MB"); value != "" {
+		mb, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return conf, fmt.Errorf("invalid KAFKA_SPILL_MAX_SIZE_MB: %v", err)
+		}
+		conf.Spill.MaxSize = mb << 20
+	}
+	if conf.Spill.ReplayInterval, err = durationEnv("KAFKA_SPILL_REPLAY_INTERVAL"); err != nil {
+		return conf, err
+	}
+
 	conf.Topics = map[Topic]string{
 		AdEventsTopic:           os.Getenv("KAFKA_AD_EVENTS_TOPIC"),
 		NotificationEventsTopic: os.Getenv("KAFKA_NOTIFICATION_EVENTS_TOPIC"),
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type NotificationQueueConfig struct {
+	Enabled     bool
+	Workers     int
+	MaxAttempts int
+	// DefaultRateLimit is requests per second to a demand, zero means no limit.
+	DefaultRateLimit float64
+	// RateLimits are requests per second to individual demands by demand ID.
+	RateLimits map[string]float64
+}
+
+// NotificationQueue reads settings of win, loss and billing notifications delivery queue.
+// NOTIFICATION_RATE_LIMITS is a comma separated list of demand=rps pairs, e.g. "meta=50,vungle=20".
+func NotificationQueue() (conf NotificationQueueConfig, err error) {
+	conf.Enabled = os.Getenv("USE_NOTIFICATION_QUEUE") == "true"
+
+	if value := os.Getenv("NOTIFICATION_QUEUE_WORKERS"); value != "" {
+		conf.Workers, err = strconv.Atoi(value)
+		if err != nil {
+			return conf, fmt.Errorf("invalid NOTIFICATION_QUEUE_WORKERS: %v", err)
+		}
+	}
+
+	if value := os.Getenv("NOTIFICATION_QUEUE_MAX_ATTEMPTS"); value != "" {
+		conf.MaxAttempts, err = strconv.Atoi(value)
+		if err != nil {
+			return conf, fmt.Errorf("invalid NOTIFICATION_QUEUE_MAX_ATTEMPTS: %v", err)
+		}
+	}
+
+	if value := os.Getenv("NOTIFICATION_RATE_LIMIT"); value != "" {
+		conf.DefaultRateLimit, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return conf, fmt.Errorf("invalid NOTIFICATION_RATE_LIMIT: %v", err)
+		}
+	}
+
+	conf.RateLimits = make(map[string]float64)
+	if value := os.Getenv("NOTIFICATION_RATE_LIMITS"); value != "" {
+		for _, pair := range strings.Split(value, ",") {
+			demandID, rps, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok {
+				return conf, fmt.Errorf("invalid NOTIFICATION_RATE_LIMITS: %q is not demand=rps pair", pair)
+			}
+
+			conf.RateLimits[demandID], err = strconv.ParseFloat(rps, 64)
+			if err != nil {
+				return conf, fmt.Errorf("invalid NOTIFICATION_RATE_LIMITS: %v", err)
+			}
+		}
+	}
+
+	return conf, nil
+}
+
+type NotificationMacrosConfig struct {
+	// Currencies are currencies demands expect prices in, by demand ID.
+	Currencies map[string]string
+	// ExchangeRates are amounts of currencies for one USD, by currency code.
+	ExchangeRates map[string]float64
+	// CustomMacros are names of notification params substituted for custom macros, by demand ID and macro name.
+	CustomMacros map[string]map[string]string
+}
+
+// NotificationMacros reads settings of macros in win, loss and billing notification URLs.
+// NOTIFICATION_CURRENCIES is a comma separated list of demand=currency pairs, e.g. "meta=EUR".
+// NOTIFICATION_EXCHANGE_RATES is a comma separated list of currency=rate pairs, e.g. "EUR=0.92".
+// NOTIFICATION_CUSTOM_MACROS is a comma separated list of demand:macro=param entries, e.g. "vungle:APP_BUNDLE=bundle".
+func NotificationMacros() (conf NotificationMacrosConfig, err error) {
+	conf.Currencies = make(map[string]string)
+	if value := os.Getenv("NOTIFICATION_CURRENCIES"); value != "" {
+		for _, pair := range strings.Split(value, ",") {
+			demandID, currency, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok || currency == "" {
+				return conf, fmt.Errorf("invalid NOTIFICATION_CURRENCIES: %q is not demand=currency pair", pair)
+			}
+
+			conf.Currencies[demandID] = currency
+		}
+	}
+
+	conf.ExchangeRates = make(map[string]float64)
+	if value := os.Getenv("NOTIFICATION_EXCHANGE_RATES"); value != "" {
+		for _, pair := range strings.Split(value, ",") {
+			currency, rate, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok {
+				return conf, fmt.Errorf("invalid NOTIFICATION_EXCHANGE_RATES: %q is not currency=rate pair", pair)
+			}
+
+			conf.ExchangeRates[currency], err = strconv.ParseFloat(rate, 64)
+			if err != nil {
+				return conf, fmt.Errorf("invalid NOTIFICATION_EXCHANGE_RATES: %v", err)
+			}
+		}
+	}
+
+	conf.CustomMacros = make(map[string]map[string]string)
+	if value := os.Getenv("NOTIFICATION_CUSTOM_MACROS"); value != "" {
+		for _, entry := range strings.Split(value, ",") {
+			demandID, macro, ok := strings.Cut(strings.TrimSpace(entry), ":")
+			name, param, ok2 := strings.Cut(macro, "=")
+			if !ok || !ok2 || name == "" {
+				return conf, fmt.Errorf("invalid NOTIFICATION_CUSTOM_MACROS: %q is not demand:macro=param entry", entry)
+			}
+
+			if conf.CustomMacros[demandID] == nil {
+				conf.CustomMacros[demandID] = make(map[string]string)
+			}
+			conf.CustomMacros[demandID][name] = param
+		}
+	}
+
+	return conf, nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	sentryotel "github.com/getsentry/sentry-go/otel"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/trace"
 )
 
@@ -12,5 +13,9 @@ func ConfigureOTel() {
 	)
 
 	otel.SetTracerProvider(tp)
-	otel.SetTextMapPropagator(sentryotel.NewSentryPropagator())
+	// W3C trace context is propagated to DSPs and other outbound HTTP calls along with Sentry headers
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		sentryotel.NewSentryPropagator(),
+	))
 }
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type RawPayloadsConfig struct {
+	SampleRate float64
+	// AppSampleRates are sample rates by app ID.
+	AppSampleRates map[int64]float64
+	// AdapterSampleRates are sample rates by adapter key.
+	AdapterSampleRates map[string]float64
+	CaptureErrors      bool
+}
+
+// RawPayloads reads sampling of raw bid requests and responses logged with bid_request events.
+// RAW_PAYLOADS_SAMPLE_RATE defaults to 1, i.e. all payloads are logged. RAW_PAYLOADS_APP_SAMPLE_RATES and
+// RAW_PAYLOADS_ADAPTER_SAMPLE_RATES are comma separated lists of key=rate pairs, e.g. "bidmachine=0.1,meta=0".
+// Payloads of failed bid requests are logged regardless of sampling unless RAW_PAYLOADS_CAPTURE_ERRORS is false.
+func RawPayloads() (conf RawPayloadsConfig, err error) {
+	conf.SampleRate = 1
+	if value := os.Getenv("RAW_PAYLOADS_SAMPLE_RATE"); value != "" {
+		if conf.SampleRate, err = parseRate(value); err != nil {
+			return conf, fmt.Errorf("invalid RAW_PAYLOADS_SAMPLE_RATE: %v", err)
+		}
+	}
+
+	conf.AppSampleRates = make(map[int64]float64)
+	for _, pair := range splitList(os.Getenv("RAW_PAYLOADS_APP_SAMPLE_RATES")) {
+		key, rate, err := parseRatePair(pair)
+		if err != nil {
+			return conf, fmt.Errorf("invalid RAW_PAYLOADS_APP_SAMPLE_RATES: %v", err)
+		}
+		appID, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return conf, fmt.Errorf("invalid RAW_PAYLOADS_APP_SAMPLE_RATES: %v", err)
+		}
+		conf.AppSampleRates[appID] = rate
+	}
+
+	conf.AdapterSampleRates = make(map[string]float64)
+	for _, pair := range splitList(os.Getenv("RAW_PAYLOADS_ADAPTER_SAMPLE_RATES")) {
+		key, rate, err := parseRatePair(pair)
+		if err != nil {
+			return conf, fmt.Errorf("invalid RAW_PAYLOADS_ADAPTER_SAMPLE_RATES: %v", err)
+		}
+		conf.AdapterSampleRates[key] = rate
+	}
+
+	conf.CaptureErrors = os.Getenv("RAW_PAYLOADS_CAPTURE_ERRORS") != "false"
+
+	return conf, nil
+}
+
+func parseRatePair(pair string) (string, float64, error) {
+	key, value, ok := strings.Cut(pair, "=")
+	if !ok {
+		return "", 0, fmt.Errorf("%q is not key=rate pair", pair)
+	}
+
+	rate, err := parseRate(value)
+	return key, rate, err
+}
+
+func parseRate(value string) (float64, error) {
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("rate %v is not between 0 and 1", rate)
+	}
+
+	return rate, nil
+}
@@ -8,10 +8,17 @@ type (
 )
 
 type Config struct {
+	// AccountID is ID of demand source account the app demand profile belongs to.
+	AccountID    int64
 	AccountExtra map[string]any
 	AppData      map[string]any
+	// OpenRTB is the definition of demand source requested by the generic OpenRTB adapter, nil for dedicated adapters.
+	OpenRTB *OpenRTBBidder
 }
 
+// AccountIDConfigKey is the key of ProcessedConfigsMap adapter config that holds Config.AccountID.
+const AccountIDConfigKey = "demand_source_account_id"
+
 const (
 	// Sorted alphabetically
 	AdmobKey      Key = "admob"
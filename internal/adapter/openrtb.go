@@ -0,0 +1,77 @@
+package adapter
+
+import (
+	"regexp"
+	"slices"
+)
+
+// OpenRTBBidder defines a demand source that is requested by the generic OpenRTB 2.6 adapter instead of a dedicated one.
+//
+// String templates may contain macros {{account.<key>}}, {{app.<key>}} and {{ad_unit.<key>}} that are replaced with
+// values of demand source account extra, app demand profile data and ad unit extra.
+type OpenRTBBidder struct {
+	// Endpoint is the URL template of bid requests.
+	Endpoint string `json:"endpoint"`
+	// Headers are added to bid requests, values are templates.
+	Headers map[string]string `json:"headers,omitempty"`
+	// TagID is the template of imp.tagid.
+	TagID string `json:"tag_id,omitempty"`
+	// Imps are impressions requested for ad types, keyed by ad type. Ad types without impression are not requested.
+	Imps  map[string]OpenRTBImp `json:"imps"`
+	Token OpenRTBToken          `json:"token"`
+	// Payload is the bid field returned to SDK as payload: "adm" (default) or "ext.<key>".
+	Payload string `json:"payload,omitempty"`
+	// Signaldata is the bid field returned to SDK as signaldata, "ext.<key>". Not returned if empty.
+	Signaldata string `json:"signaldata,omitempty"`
+}
+
+// OpenRTBImp tells which objects are added to the impression of an ad type.
+type OpenRTBImp struct {
+	// Banner adds banner object sized by ad format, or full screen for interstitial and rewarded ads.
+	Banner bool `json:"banner,omitempty"`
+	// Video adds video object with VideoMIMEs.
+	Video      bool     `json:"video,omitempty"`
+	VideoMIMEs []string `json:"video_mimes,omitempty"`
+}
+
+// OpenRTBToken tells where bid token of SDK is found and where it is sent.
+type OpenRTBToken struct {
+	// Field is the key of the token in demand data of auction request, "token" by default.
+	Field string `json:"field,omitempty"`
+	// Target is the bid request field the token is set to: "user.buyeruid" (default), "user.ext.<key>" or "imp.ext.<key>".
+	Target string `json:"target,omitempty"`
+}
+
+const (
+	OpenRTBMacroAccount = "account"
+	OpenRTBMacroApp     = "app"
+	OpenRTBMacroAdUnit  = "ad_unit"
+)
+
+// OpenRTBMacroRegexp matches macros of OpenRTBBidder templates, submatches are source and key.
+var OpenRTBMacroRegexp = regexp.MustCompile(`\{\{(account|app|ad_unit)\.(\w+)\}\}`)
+
+// Templates returns all string templates of the definition.
+func (b *OpenRTBBidder) Templates() []string {
+	templates := []string{b.Endpoint, b.TagID}
+	for _, value := range b.Headers {
+		templates = append(templates, value)
+	}
+
+	return templates
+}
+
+// MacroKeys returns sorted unique keys of macros of the source used in the definition templates.
+func (b *OpenRTBBidder) MacroKeys(source string) []string {
+	var keys []string
+	for _, template := range b.Templates() {
+		for _, match := range OpenRTBMacroRegexp.FindAllStringSubmatch(template, -1) {
+			if match[1] == source {
+				keys = append(keys, match[2])
+			}
+		}
+	}
+	slices.Sort(keys)
+
+	return slices.Compact(keys)
+}
@@ -36,7 +36,7 @@ func (f *ConfigurationFetcher) Fetch(ctx context.Context, appID int64, adapterKe
 		Select("app_demand_profiles.id, app_demand_profiles.data").
 		Where("app_id = ? AND app_demand_profiles.enabled = ?", appID, true).
 		InnerJoins("Account", f.DB.Select("id", "extra")).
-		InnerJoins("Account.DemandSource", f.DB.Select("api_key").Where(map[string]any{"api_key": adapterKeys})).
+		InnerJoins("Account.DemandSource", f.DB.Select("api_key", "openrtb").Where(map[string]any{"api_key": adapterKeys})).
 		Find(&dbProfiles).
 		Error
 	if err != nil {
@@ -59,8 +59,10 @@ func (f *ConfigurationFetcher) Fetch(ctx context.Context, appID int64, adapterKe
 
 		key := adapter.Key(dbProfile.Account.DemandSource.APIKey)
 		configs[key] = adapter.Config{
+			AccountID:    dbProfile.Account.ID,
 			AccountExtra: extra,
 			AppData:      data,
+			OpenRTB:      dbProfile.Account.DemandSource.OpenRTB,
 		}
 	}
 
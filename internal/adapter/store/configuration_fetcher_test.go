@@ -93,20 +93,24 @@ func TestAppDemandProfileFetcher_FetchCached(t *testing.T) {
 			adapterKeys: adapter.Keys,
 			want: adapter.RawConfigsMap{
 				adapter.ApplovinKey: {
+					AccountID:    accounts[0].ID,
 					AccountExtra: map[string]any{"applovin": "applovin"},
 					AppData:      map[string]any{},
 				},
 				adapter.BidmachineKey: {
+					AccountID:    accounts[2].ID,
 					AccountExtra: map[string]any{"bidmachine": "bidmachine"},
 					AppData:      map[string]any{},
 				},
 				adapter.AmazonKey: {
+					AccountID: accounts[4].ID,
 					AccountExtra: map[string]any{"amazon": "amazon", "price_points": []any{
 						map[string]any{"name": "name", "price_point": "price_point", "price": 1.0},
 					}},
 					AppData: map[string]any{},
 				},
 				adapter.YandexKey: {
+					AccountID:    accounts[6].ID,
 					AccountExtra: map[string]any{"oauth_token": "yandex"},
 					AppData:      map[string]any{},
 				},
@@ -118,6 +122,7 @@ func TestAppDemandProfileFetcher_FetchCached(t *testing.T) {
 			adapterKeys: []adapter.Key{adapter.ApplovinKey},
 			want: adapter.RawConfigsMap{
 				adapter.ApplovinKey: {
+					AccountID:    accounts[0].ID,
 					AccountExtra: map[string]any{"applovin": "applovin"},
 					AppData:      map[string]any{},
 				},
@@ -135,14 +140,17 @@ func TestAppDemandProfileFetcher_FetchCached(t *testing.T) {
 			adapterKeys: adapter.Keys,
 			want: adapter.RawConfigsMap{
 				adapter.DTExchangeKey: {
+					AccountID:    accounts[3].ID,
 					AccountExtra: map[string]any{"dtexchange": "dtexchange"},
 					AppData:      map[string]any{},
 				},
 				adapter.GAMKey: {
+					AccountID:    accounts[5].ID,
 					AccountExtra: map[string]any{"network_code": "111"},
 					AppData:      map[string]any{},
 				},
 				adapter.UnityAdsKey: {
+					AccountID:    accounts[1].ID,
 					AccountExtra: map[string]any{"unity": "unity"},
 					AppData:      map[string]any{},
 				},
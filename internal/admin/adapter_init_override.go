@@ -0,0 +1,256 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi"
+)
+
+const AdapterInitOverrideResourceKey = "adapter_init_override"
+
+type AdapterInitOverrideResource struct {
+	*AdapterInitOverride
+	Permissions ResourceInstancePermissions `json:"_permissions"`
+}
+
+// AdapterInitOverride customizes adapter init configs returned by SDK API /config endpoint for an app,
+// or for a segment of an app. Segment override takes precedence over app-wide one.
+type AdapterInitOverride struct {
+	ID int64 `json:"id"`
+	AdapterInitOverrideAttrs
+	App     App      `json:"app"`
+	Segment *Segment `json:"segment"`
+}
+
+type AdapterInitOverrideAttrs struct {
+	AppID     int64  `json:"app_id"`
+	SegmentID *int64 `json:"segment_id"`
+	// Adapters restricts adapters returned to SDK. Empty list means no restriction.
+	Adapters []adapter.Key `json:"adapters"`
+	// Patches maps adapter key to fields merged into its init config.
+	Patches map[string]any `json:"patches"`
+	Enabled *bool          `json:"enabled"`
+}
+
+type AdapterInitOverrideService struct {
+	*ResourceService[AdapterInitOverrideResource, AdapterInitOverride, AdapterInitOverrideAttrs]
+}
+
+func NewAdapterInitOverrideService(store Store) *AdapterInitOverrideService {
+	s := &AdapterInitOverrideService{
+		ResourceService: &ResourceService[AdapterInitOverrideResource, AdapterInitOverride, AdapterInitOverrideAttrs]{},
+	}
+
+	s.resourceKey = AdapterInitOverrideResourceKey
+
+	s.repo = store.AdapterInitOverrides()
+	s.policy = newAdapterInitOverridePolicy(store)
+
+	s.prepareResource = func(authCtx AuthContext, override *AdapterInitOverride) AdapterInitOverrideResource {
+		return AdapterInitOverrideResource{
+			AdapterInitOverride: override,
+			Permissions:         s.policy.instancePermissions(authCtx, override),
+		}
+	}
+
+	s.getValidator = func(attrs *AdapterInitOverrideAttrs) v8n.ValidatableWithContext {
+		return &adapterInitOverrideAttrsValidator{
+			attrs:            attrs,
+			demandSourceRepo: store.DemandSources(),
+		}
+	}
+
+	return s
+}
+
+type AdapterInitOverrideRepo interface {
+	AllResourceQuerier[AdapterInitOverride]
+	OwnedResourceQuerier[AdapterInitOverride]
+	ResourceManipulator[AdapterInitOverride, AdapterInitOverrideAttrs]
+}
+
+type adapterInitOverridePolicy struct {
+	repo AdapterInitOverrideRepo
+
+	appPolicy     *appPolicy
+	segmentPolicy *segmentPolicy
+}
+
+func newAdapterInitOverridePolicy(store Store) *adapterInitOverridePolicy {
+	return &adapterInitOverridePolicy{
+		repo: store.AdapterInitOverrides(),
+
+		appPolicy:     newAppPolicy(store),
+		segmentPolicy: newSegmentPolicy(store),
+	}
+}
+
+func (p *adapterInitOverridePolicy) getReadScope(authCtx AuthContext) resourceScope[AdapterInitOverride] {
+	return &ownedResourceScope[AdapterInitOverride]{
+		repo:    p.repo,
+		authCtx: authCtx,
+	}
+}
+
+func (p *adapterInitOverridePolicy) getManageScope(authCtx AuthContext) resourceScope[AdapterInitOverride] {
+	return &ownedResourceScope[AdapterInitOverride]{
+		repo:    p.repo,
+		authCtx: authCtx,
+	}
+}
+
+func (p *adapterInitOverridePolicy) authorizeCreate(ctx context.Context, authCtx AuthContext, attrs *AdapterInitOverrideAttrs) error {
+	// Check if user can manage the app.
+	_, err := p.appPolicy.getManageScope(authCtx).find(ctx, attrs.AppID)
+	if err != nil {
+		return err
+	}
+
+	if attrs.SegmentID != nil {
+		// Check if user can read the segment and it is a segment of the override app.
+		return p.authorizeSegment(ctx, authCtx, *attrs.SegmentID, attrs.AppID)
+	}
+
+	return nil
+}
+
+func (p *adapterInitOverridePolicy) authorizeUpdate(ctx context.Context, authCtx AuthContext, override *AdapterInitOverride, attrs *AdapterInitOverrideAttrs) error {
+	// If user tries to change the app and app is not the same as before, check if user can manage the new app.
+	if attrs.AppID != 0 && attrs.AppID != override.AppID {
+		_, err := p.appPolicy.getManageScope(authCtx).find(ctx, attrs.AppID)
+		if err != nil {
+			return err
+		}
+	}
+
+	appID, segmentID := override.AppID, override.SegmentID
+	if attrs.AppID != 0 {
+		appID = attrs.AppID
+	}
+	if attrs.SegmentID != nil {
+		segmentID = attrs.SegmentID
+	}
+
+	// If user tries to change the segment or the app, check if user can read the segment and it is a segment of the override app.
+	segmentChanged := attrs.SegmentID != nil && (override.SegmentID == nil || *attrs.SegmentID != *override.SegmentID)
+	if segmentID != nil && (segmentChanged || appID != override.AppID) {
+		return p.authorizeSegment(ctx, authCtx, *segmentID, appID)
+	}
+
+	return nil
+}
+
+// authorizeSegment checks that user can read the segment and it is a segment of the app.
+func (p *adapterInitOverridePolicy) authorizeSegment(ctx context.Context, authCtx AuthContext, segmentID, appID int64) error {
+	segment, err := p.segmentPolicy.getReadScope(authCtx).find(ctx, segmentID)
+	if err != nil {
+		return err
+	}
+
+	if segment.AppID != appID {
+		return v8n.Errors{"segment_id": errors.New("must be a segment of the app")}
+	}
+
+	return nil
+}
+
+func (p *adapterInitOverridePolicy) authorizeDelete(_ context.Context, _ AuthContext, _ *AdapterInitOverride) error {
+	return nil
+}
+
+func (p *adapterInitOverridePolicy) permissions(_ AuthContext) ResourcePermissions {
+	return ResourcePermissions{
+		Read:   true,
+		Create: true,
+	}
+}
+
+func (p *adapterInitOverridePolicy) instancePermissions(_ AuthContext, _ *AdapterInitOverride) ResourceInstancePermissions {
+	return ResourceInstancePermissions{
+		Update: true,
+		Delete: true,
+	}
+}
+
+type adapterInitOverrideAttrsValidator struct {
+	attrs *AdapterInitOverrideAttrs
+
+	demandSourceRepo DemandSourceRepo
+}
+
+func (v *adapterInitOverrideAttrsValidator) ValidateWithContext(ctx context.Context) error {
+	keys, err := demandSourceKeys(ctx, v.demandSourceRepo)
+	if err != nil {
+		return v8n.NewInternalError(err)
+	}
+
+	return v8n.ValidateStructWithContext(ctx, v.attrs,
+		v8n.Field(&v.attrs.Adapters, v8n.Each(v8n.By(isDemandSourceKey(keys)))),
+		v8n.Field(&v.attrs.Patches, v8n.By(func(value any) error {
+			patches, _ := value.(map[string]any)
+
+			var errs []error
+			for _, key := range slices.Sorted(maps.Keys(patches)) {
+				if err := isDemandSourceKey(keys)(adapter.Key(key)); err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				if err := v8n.Validate(patches[key], isMap); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %v", key, err))
+					continue
+				}
+				if err := validateInitConfigPatch(adapter.Key(key), patches[key]); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %v", key, err))
+				}
+			}
+
+			return errors.Join(errs...)
+		})),
+	)
+}
+
+// validateInitConfigPatch checks that patch fits init config SDK API returns for the adapter.
+func validateInitConfigPatch(key adapter.Key, patch any) error {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	return sdkapi.ValidateAdapterInitPatch(key, data)
+}
+
+// demandSourceKeys returns API keys of demand sources. SDK API serves adapters by demand source API key,
+// so keys of other adapters would never match.
+func demandSourceKeys(ctx context.Context, repo DemandSourceRepo) (map[adapter.Key]bool, error) {
+	sources, err := repo.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list demand sources: %v", err)
+	}
+
+	keys := make(map[adapter.Key]bool, len(sources.Items))
+	for _, source := range sources.Items {
+		keys[adapter.Key(source.ApiKey)] = true
+	}
+
+	return keys, nil
+}
+
+// isDemandSourceKey returns a rule checking that value is one of demand source keys.
+func isDemandSourceKey(keys map[adapter.Key]bool) v8n.RuleFunc {
+	return func(value any) error {
+		key, _ := value.(adapter.Key)
+		if !keys[key] {
+			return fmt.Errorf("unknown adapter %q", key)
+		}
+
+		return nil
+	}
+}
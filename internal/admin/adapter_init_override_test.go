@@ -0,0 +1,222 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+)
+
+func Test_adapterInitOverrideAttrsValidator_ValidateWithContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   *AdapterInitOverrideAttrs
+		wantErr bool
+	}{
+		{
+			"valid override",
+			&AdapterInitOverrideAttrs{
+				Adapters: []adapter.Key{adapter.BidmachineKey, adapter.ApplovinKey},
+				Patches: map[string]any{
+					"applovin": map[string]any{"mediator": "Bidon", "ad_unit_ids": []any{"dbadb46cdb8dcbc4"}},
+				},
+			},
+			false,
+		},
+		{
+			"empty override",
+			&AdapterInitOverrideAttrs{},
+			false,
+		},
+		{
+			"adapter of generic OpenRTB demand source",
+			&AdapterInitOverrideAttrs{Adapters: []adapter.Key{adapter.ApplovinKey, "acme"}},
+			false,
+		},
+		{
+			"unknown adapter",
+			&AdapterInitOverrideAttrs{Adapters: []adapter.Key{"unknown"}},
+			true,
+		},
+		{
+			"adapter without demand source",
+			&AdapterInitOverrideAttrs{Adapters: []adapter.Key{adapter.MetaKey}},
+			true,
+		},
+		{
+			"patch of unknown adapter",
+			&AdapterInitOverrideAttrs{Patches: map[string]any{"unknown": map[string]any{}}},
+			true,
+		},
+		{
+			"patch of adapter without init config",
+			&AdapterInitOverrideAttrs{Patches: map[string]any{"acme": map[string]any{"seat": "bidon"}}},
+			true,
+		},
+		{
+			"patch with field of wrong type",
+			&AdapterInitOverrideAttrs{Patches: map[string]any{"applovin": map[string]any{"sdk_key": 1}}},
+			true,
+		},
+		{
+			"patch with field unknown to init config",
+			&AdapterInitOverrideAttrs{Patches: map[string]any{"applovin": map[string]any{"sdkkey": "key"}}},
+			true,
+		},
+		{
+			"patch is not an object",
+			&AdapterInitOverrideAttrs{Patches: map[string]any{"applovin": "Bidon"}},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &adapterInitOverrideAttrsValidator{
+				attrs:            tt.attrs,
+				demandSourceRepo: newDemandSourceRepoMock(adapter.BidmachineKey, adapter.ApplovinKey, "acme"),
+			}
+
+			err := v.ValidateWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWithContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_adapterInitOverridePolicy_authorizeCreate(t *testing.T) {
+	authCtx := &AuthContextMock{
+		IsAdminFunc: func() bool {
+			return true
+		},
+	}
+	segmentID := func(id int64) *int64 {
+		return &id
+	}
+
+	tests := []struct {
+		name    string
+		attrs   AdapterInitOverrideAttrs
+		wantV8n bool
+	}{
+		{
+			name:  "app override",
+			attrs: AdapterInitOverrideAttrs{AppID: 1},
+		},
+		{
+			name:  "segment of the app",
+			attrs: AdapterInitOverrideAttrs{AppID: 1, SegmentID: segmentID(10)},
+		},
+		{
+			name:    "segment of another app",
+			attrs:   AdapterInitOverrideAttrs{AppID: 1, SegmentID: segmentID(20)},
+			wantV8n: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newAdapterInitOverridePolicy(newAdapterInitOverrideStoreMock())
+
+			err := policy.authorizeCreate(context.Background(), authCtx, &tt.attrs)
+
+			var validationErr v8n.Errors
+			if isV8n := errors.As(err, &validationErr); isV8n != tt.wantV8n || (err != nil && !isV8n) {
+				t.Errorf("authorizeCreate() error = %v, want validation error: %v", err, tt.wantV8n)
+			}
+		})
+	}
+}
+
+func Test_adapterInitOverridePolicy_authorizeUpdate(t *testing.T) {
+	authCtx := &AuthContextMock{
+		IsAdminFunc: func() bool {
+			return true
+		},
+	}
+	segmentID := func(id int64) *int64 {
+		return &id
+	}
+	override := &AdapterInitOverride{ID: 1, AdapterInitOverrideAttrs: AdapterInitOverrideAttrs{AppID: 1, SegmentID: segmentID(10)}}
+
+	tests := []struct {
+		name    string
+		attrs   AdapterInitOverrideAttrs
+		wantV8n bool
+	}{
+		{
+			name:  "change adapters",
+			attrs: AdapterInitOverrideAttrs{Adapters: []adapter.Key{adapter.BidmachineKey}},
+		},
+		{
+			name:  "change app and segment",
+			attrs: AdapterInitOverrideAttrs{AppID: 2, SegmentID: segmentID(20)},
+		},
+		{
+			name:    "change segment to segment of another app",
+			attrs:   AdapterInitOverrideAttrs{SegmentID: segmentID(20)},
+			wantV8n: true,
+		},
+		{
+			name:    "change app keeping segment of previous app",
+			attrs:   AdapterInitOverrideAttrs{AppID: 2},
+			wantV8n: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newAdapterInitOverridePolicy(newAdapterInitOverrideStoreMock())
+
+			err := policy.authorizeUpdate(context.Background(), authCtx, override, &tt.attrs)
+
+			var validationErr v8n.Errors
+			if isV8n := errors.As(err, &validationErr); isV8n != tt.wantV8n || (err != nil && !isV8n) {
+				t.Errorf("authorizeUpdate() error = %v, want validation error: %v", err, tt.wantV8n)
+			}
+		})
+	}
+}
+
+func newAdapterInitOverrideStoreMock() *StoreMock {
+	apps := map[int64]*App{1: {ID: 1}, 2: {ID: 2}}
+	segments := map[int64]*Segment{
+		10: {ID: 10, SegmentAttrs: SegmentAttrs{AppID: 1}},
+		20: {ID: 20, SegmentAttrs: SegmentAttrs{AppID: 2}},
+	}
+
+	return &StoreMock{
+		AppsFunc: func() AppRepo {
+			return &AppRepoMock{
+				FindFunc: func(_ context.Context, id int64) (*App, error) {
+					if app, ok := apps[id]; ok {
+						return app, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+		UsersFunc: func() UserRepo {
+			return &UserRepoMock{}
+		},
+		SegmentsFunc: func() SegmentRepo {
+			return &SegmentRepoMock{
+				FindFunc: func(_ context.Context, id int64) (*Segment, error) {
+					if segment, ok := segments[id]; ok {
+						return segment, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+		AdapterInitOverridesFunc: func() AdapterInitOverrideRepo {
+			return nil
+		},
+	}
+}
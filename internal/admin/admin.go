@@ -5,15 +5,20 @@ package admin
 
 // Service is a top-level service for managing resources.
 type Service struct {
+	AdapterInitOverrideService    *AdapterInitOverrideService
 	AppService                    *AppService
 	AppDemandProfileService       *AppDemandProfileService
 	AuctionConfigurationService   *AuctionConfigurationService
 	AuctionConfigurationV2Service *AuctionConfigurationV2Service
 	CountryService                *CountryService
+	DealService                   *DealService
 	DemandSourceService           *DemandSourceService
 	DemandSourceAccountService    *DemandSourceAccountService
+	ExperimentService             *ExperimentService
+	FloorRecommendationService    *FloorRecommendationService
 	LineItemService               *LineItemService
 	SegmentService                *SegmentService
+	SegmentDebugService           *SegmentDebugService
 	UserService                   *UserService
 	SettingsService               *SettingsService
 	APIKeyService                 *APIKeyService
@@ -22,15 +27,20 @@ type Service struct {
 // NewService creates a new Service.
 func NewService(store Store) *Service {
 	return &Service{
+		AdapterInitOverrideService:    NewAdapterInitOverrideService(store),
 		AppService:                    NewAppService(store),
 		AppDemandProfileService:       NewAppDemandProfileService(store),
 		AuctionConfigurationService:   NewAuctionConfigurationService(store),
 		AuctionConfigurationV2Service: NewAuctionConfigurationV2Service(store),
 		CountryService:                NewCountryService(store),
+		DealService:                   NewDealService(store),
 		DemandSourceService:           NewDemandSourceService(store),
 		DemandSourceAccountService:    NewDemandSourceAccountService(store),
+		ExperimentService:             NewExperimentService(store),
+		FloorRecommendationService:    NewFloorRecommendationService(store),
 		LineItemService:               NewLineItemService(store),
 		SegmentService:                NewSegmentService(store),
+		SegmentDebugService:           NewSegmentDebugService(store),
 		UserService:                   NewUserService(store),
 		SettingsService:               NewSettingsService(store),
 		APIKeyService:                 NewAPIKeyService(store),
@@ -39,15 +49,20 @@ func NewService(store Store) *Service {
 
 // Store is an interface for accessing resources from storage.
 type Store interface {
+	AdapterInitOverrides() AdapterInitOverrideRepo
 	Apps() AppRepo
 	AppDemandProfiles() AppDemandProfileRepo
 	AuctionConfigurations() AuctionConfigurationRepo
 	AuctionConfigurationsV2() AuctionConfigurationV2Repo
 	Countries() CountryRepo
+	Deals() DealRepo
 	DemandSources() DemandSourceRepo
 	DemandSourceAccounts() DemandSourceAccountRepo
+	Experiments() ExperimentRepo
+	FloorStats() FloorStatsRepo
 	LineItems() LineItemRepo
 	Segments() SegmentRepo
+	SegmentDebug() SegmentDebugRepo
 	Users() UserRepo
 	APIKeys() APIKeyRepo
 }
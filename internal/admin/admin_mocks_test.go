@@ -20,6 +20,9 @@ var _ Store = &StoreMock{}
 //			APIKeysFunc: func() APIKeyRepo {
 //				panic("mock out the APIKeys method")
 //			},
+//			AdapterInitOverridesFunc: func() AdapterInitOverrideRepo {
+//				panic("mock out the AdapterInitOverrides method")
+//			},
 //			AppDemandProfilesFunc: func() AppDemandProfileRepo {
 //				panic("mock out the AppDemandProfiles method")
 //			},
@@ -35,15 +38,27 @@ var _ Store = &StoreMock{}
 //			CountriesFunc: func() CountryRepo {
 //				panic("mock out the Countries method")
 //			},
+//			DealsFunc: func() DealRepo {
+//				panic("mock out the Deals method")
+//			},
 //			DemandSourceAccountsFunc: func() DemandSourceAccountRepo {
 //				panic("mock out the DemandSourceAccounts method")
 //			},
 //			DemandSourcesFunc: func() DemandSourceRepo {
 //				panic("mock out the DemandSources method")
 //			},
+//			ExperimentsFunc: func() ExperimentRepo {
+//				panic("mock out the Experiments method")
+//			},
+//			FloorStatsFunc: func() FloorStatsRepo {
+//				panic("mock out the FloorStats method")
+//			},
 //			LineItemsFunc: func() LineItemRepo {
 //				panic("mock out the LineItems method")
 //			},
+//			SegmentDebugFunc: func() SegmentDebugRepo {
+//				panic("mock out the SegmentDebug method")
+//			},
 //			SegmentsFunc: func() SegmentRepo {
 //				panic("mock out the Segments method")
 //			},
@@ -60,6 +75,9 @@ type StoreMock struct {
 	// APIKeysFunc mocks the APIKeys method.
 	APIKeysFunc func() APIKeyRepo
 
+	// AdapterInitOverridesFunc mocks the AdapterInitOverrides method.
+	AdapterInitOverridesFunc func() AdapterInitOverrideRepo
+
 	// AppDemandProfilesFunc mocks the AppDemandProfiles method.
 	AppDemandProfilesFunc func() AppDemandProfileRepo
 
@@ -75,15 +93,27 @@ type StoreMock struct {
 	// CountriesFunc mocks the Countries method.
 	CountriesFunc func() CountryRepo
 
+	// DealsFunc mocks the Deals method.
+	DealsFunc func() DealRepo
+
 	// DemandSourceAccountsFunc mocks the DemandSourceAccounts method.
 	DemandSourceAccountsFunc func() DemandSourceAccountRepo
 
 	// DemandSourcesFunc mocks the DemandSources method.
 	DemandSourcesFunc func() DemandSourceRepo
 
+	// ExperimentsFunc mocks the Experiments method.
+	ExperimentsFunc func() ExperimentRepo
+
+	// FloorStatsFunc mocks the FloorStats method.
+	FloorStatsFunc func() FloorStatsRepo
+
 	// LineItemsFunc mocks the LineItems method.
 	LineItemsFunc func() LineItemRepo
 
+	// SegmentDebugFunc mocks the SegmentDebug method.
+	SegmentDebugFunc func() SegmentDebugRepo
+
 	// SegmentsFunc mocks the Segments method.
 	SegmentsFunc func() SegmentRepo
 
@@ -95,6 +125,9 @@ type StoreMock struct {
 		// APIKeys holds details about calls to the APIKeys method.
 		APIKeys []struct {
 		}
+		// AdapterInitOverrides holds details about calls to the AdapterInitOverrides method.
+		AdapterInitOverrides []struct {
+		}
 		// AppDemandProfiles holds details about calls to the AppDemandProfiles method.
 		AppDemandProfiles []struct {
 		}
@@ -110,15 +143,27 @@ type StoreMock struct {
 		// Countries holds details about calls to the Countries method.
 		Countries []struct {
 		}
+		// Deals holds details about calls to the Deals method.
+		Deals []struct {
+		}
 		// DemandSourceAccounts holds details about calls to the DemandSourceAccounts method.
 		DemandSourceAccounts []struct {
 		}
 		// DemandSources holds details about calls to the DemandSources method.
 		DemandSources []struct {
 		}
+		// Experiments holds details about calls to the Experiments method.
+		Experiments []struct {
+		}
+		// FloorStats holds details about calls to the FloorStats method.
+		FloorStats []struct {
+		}
 		// LineItems holds details about calls to the LineItems method.
 		LineItems []struct {
 		}
+		// SegmentDebug holds details about calls to the SegmentDebug method.
+		SegmentDebug []struct {
+		}
 		// Segments holds details about calls to the Segments method.
 		Segments []struct {
 		}
@@ -127,14 +172,19 @@ type StoreMock struct {
 		}
 	}
 	lockAPIKeys                 sync.RWMutex
+	lockAdapterInitOverrides    sync.RWMutex
 	lockAppDemandProfiles       sync.RWMutex
 	lockApps                    sync.RWMutex
 	lockAuctionConfigurations   sync.RWMutex
 	lockAuctionConfigurationsV2 sync.RWMutex
 	lockCountries               sync.RWMutex
+	lockDeals                   sync.RWMutex
 	lockDemandSourceAccounts    sync.RWMutex
 	lockDemandSources           sync.RWMutex
+	lockExperiments             sync.RWMutex
+	lockFloorStats              sync.RWMutex
 	lockLineItems               sync.RWMutex
+	lockSegmentDebug            sync.RWMutex
 	lockSegments                sync.RWMutex
 	lockUsers                   sync.RWMutex
 }
@@ -166,6 +216,33 @@ func (mock *StoreMock) APIKeysCalls() []struct {
 	return calls
 }
 
+// AdapterInitOverrides calls AdapterInitOverridesFunc.
+func (mock *StoreMock) AdapterInitOverrides() AdapterInitOverrideRepo {
+	if mock.AdapterInitOverridesFunc == nil {
+		panic("StoreMock.AdapterInitOverridesFunc: method is nil but Store.AdapterInitOverrides was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockAdapterInitOverrides.Lock()
+	mock.calls.AdapterInitOverrides = append(mock.calls.AdapterInitOverrides, callInfo)
+	mock.lockAdapterInitOverrides.Unlock()
+	return mock.AdapterInitOverridesFunc()
+}
+
+// AdapterInitOverridesCalls gets all the calls that were made to AdapterInitOverrides.
+// Check the length with:
+//
+//	len(mockedStore.AdapterInitOverridesCalls())
+func (mock *StoreMock) AdapterInitOverridesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockAdapterInitOverrides.RLock()
+	calls = mock.calls.AdapterInitOverrides
+	mock.lockAdapterInitOverrides.RUnlock()
+	return calls
+}
+
 // AppDemandProfiles calls AppDemandProfilesFunc.
 func (mock *StoreMock) AppDemandProfiles() AppDemandProfileRepo {
 	if mock.AppDemandProfilesFunc == nil {
@@ -301,6 +378,33 @@ func (mock *StoreMock) CountriesCalls() []struct {
 	return calls
 }
 
+// Deals calls DealsFunc.
+func (mock *StoreMock) Deals() DealRepo {
+	if mock.DealsFunc == nil {
+		panic("StoreMock.DealsFunc: method is nil but Store.Deals was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDeals.Lock()
+	mock.calls.Deals = append(mock.calls.Deals, callInfo)
+	mock.lockDeals.Unlock()
+	return mock.DealsFunc()
+}
+
+// DealsCalls gets all the calls that were made to Deals.
+// Check the length with:
+//
+//	len(mockedStore.DealsCalls())
+func (mock *StoreMock) DealsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDeals.RLock()
+	calls = mock.calls.Deals
+	mock.lockDeals.RUnlock()
+	return calls
+}
+
 // DemandSourceAccounts calls DemandSourceAccountsFunc.
 func (mock *StoreMock) DemandSourceAccounts() DemandSourceAccountRepo {
 	if mock.DemandSourceAccountsFunc == nil {
@@ -355,6 +459,60 @@ func (mock *StoreMock) DemandSourcesCalls() []struct {
 	return calls
 }
 
+// Experiments calls ExperimentsFunc.
+func (mock *StoreMock) Experiments() ExperimentRepo {
+	if mock.ExperimentsFunc == nil {
+		panic("StoreMock.ExperimentsFunc: method is nil but Store.Experiments was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockExperiments.Lock()
+	mock.calls.Experiments = append(mock.calls.Experiments, callInfo)
+	mock.lockExperiments.Unlock()
+	return mock.ExperimentsFunc()
+}
+
+// ExperimentsCalls gets all the calls that were made to Experiments.
+// Check the length with:
+//
+//	len(mockedStore.ExperimentsCalls())
+func (mock *StoreMock) ExperimentsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockExperiments.RLock()
+	calls = mock.calls.Experiments
+	mock.lockExperiments.RUnlock()
+	return calls
+}
+
+// FloorStats calls FloorStatsFunc.
+func (mock *StoreMock) FloorStats() FloorStatsRepo {
+	if mock.FloorStatsFunc == nil {
+		panic("StoreMock.FloorStatsFunc: method is nil but Store.FloorStats was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockFloorStats.Lock()
+	mock.calls.FloorStats = append(mock.calls.FloorStats, callInfo)
+	mock.lockFloorStats.Unlock()
+	return mock.FloorStatsFunc()
+}
+
+// FloorStatsCalls gets all the calls that were made to FloorStats.
+// Check the length with:
+//
+//	len(mockedStore.FloorStatsCalls())
+func (mock *StoreMock) FloorStatsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockFloorStats.RLock()
+	calls = mock.calls.FloorStats
+	mock.lockFloorStats.RUnlock()
+	return calls
+}
+
 // LineItems calls LineItemsFunc.
 func (mock *StoreMock) LineItems() LineItemRepo {
 	if mock.LineItemsFunc == nil {
@@ -382,6 +540,33 @@ func (mock *StoreMock) LineItemsCalls() []struct {
 	return calls
 }
 
+// SegmentDebug calls SegmentDebugFunc.
+func (mock *StoreMock) SegmentDebug() SegmentDebugRepo {
+	if mock.SegmentDebugFunc == nil {
+		panic("StoreMock.SegmentDebugFunc: method is nil but Store.SegmentDebug was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSegmentDebug.Lock()
+	mock.calls.SegmentDebug = append(mock.calls.SegmentDebug, callInfo)
+	mock.lockSegmentDebug.Unlock()
+	return mock.SegmentDebugFunc()
+}
+
+// SegmentDebugCalls gets all the calls that were made to SegmentDebug.
+// Check the length with:
+//
+//	len(mockedStore.SegmentDebugCalls())
+func (mock *StoreMock) SegmentDebugCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSegmentDebug.RLock()
+	calls = mock.calls.SegmentDebug
+	mock.lockSegmentDebug.RUnlock()
+	return calls
+}
+
 // Segments calls SegmentsFunc.
 func (mock *StoreMock) Segments() SegmentRepo {
 	if mock.SegmentsFunc == nil {
@@ -24,6 +24,54 @@ const (
 	BasicAuthScopes = "basicAuth.Scopes"
 )
 
+// Defines values for CreateAdapterInitOverrideJSONBodyAdapters.
+const (
+	CreateAdapterInitOverrideJSONBodyAdaptersAdmob      CreateAdapterInitOverrideJSONBodyAdapters = "admob"
+	CreateAdapterInitOverrideJSONBodyAdaptersAmazon     CreateAdapterInitOverrideJSONBodyAdapters = "amazon"
+	CreateAdapterInitOverrideJSONBodyAdaptersApplovin   CreateAdapterInitOverrideJSONBodyAdapters = "applovin"
+	CreateAdapterInitOverrideJSONBodyAdaptersBidmachine CreateAdapterInitOverrideJSONBodyAdapters = "bidmachine"
+	CreateAdapterInitOverrideJSONBodyAdaptersBigoads    CreateAdapterInitOverrideJSONBodyAdapters = "bigoads"
+	CreateAdapterInitOverrideJSONBodyAdaptersChartboost CreateAdapterInitOverrideJSONBodyAdapters = "chartboost"
+	CreateAdapterInitOverrideJSONBodyAdaptersDtexchange CreateAdapterInitOverrideJSONBodyAdapters = "dtexchange"
+	CreateAdapterInitOverrideJSONBodyAdaptersGam        CreateAdapterInitOverrideJSONBodyAdapters = "gam"
+	CreateAdapterInitOverrideJSONBodyAdaptersInmobi     CreateAdapterInitOverrideJSONBodyAdapters = "inmobi"
+	CreateAdapterInitOverrideJSONBodyAdaptersIronsource CreateAdapterInitOverrideJSONBodyAdapters = "ironsource"
+	CreateAdapterInitOverrideJSONBodyAdaptersMeta       CreateAdapterInitOverrideJSONBodyAdapters = "meta"
+	CreateAdapterInitOverrideJSONBodyAdaptersMintegral  CreateAdapterInitOverrideJSONBodyAdapters = "mintegral"
+	CreateAdapterInitOverrideJSONBodyAdaptersMobilefuse CreateAdapterInitOverrideJSONBodyAdapters = "mobilefuse"
+	CreateAdapterInitOverrideJSONBodyAdaptersMoloco     CreateAdapterInitOverrideJSONBodyAdapters = "moloco"
+	CreateAdapterInitOverrideJSONBodyAdaptersStartio    CreateAdapterInitOverrideJSONBodyAdapters = "startio"
+	CreateAdapterInitOverrideJSONBodyAdaptersTaurusx    CreateAdapterInitOverrideJSONBodyAdapters = "taurusx"
+	CreateAdapterInitOverrideJSONBodyAdaptersUnityads   CreateAdapterInitOverrideJSONBodyAdapters = "unityads"
+	CreateAdapterInitOverrideJSONBodyAdaptersVkads      CreateAdapterInitOverrideJSONBodyAdapters = "vkads"
+	CreateAdapterInitOverrideJSONBodyAdaptersVungle     CreateAdapterInitOverrideJSONBodyAdapters = "vungle"
+	CreateAdapterInitOverrideJSONBodyAdaptersYandex     CreateAdapterInitOverrideJSONBodyAdapters = "yandex"
+)
+
+// Defines values for UpdateAdapterInitOverrideJSONBodyAdapters.
+const (
+	UpdateAdapterInitOverrideJSONBodyAdaptersAdmob      UpdateAdapterInitOverrideJSONBodyAdapters = "admob"
+	UpdateAdapterInitOverrideJSONBodyAdaptersAmazon     UpdateAdapterInitOverrideJSONBodyAdapters = "amazon"
+	UpdateAdapterInitOverrideJSONBodyAdaptersApplovin   UpdateAdapterInitOverrideJSONBodyAdapters = "applovin"
+	UpdateAdapterInitOverrideJSONBodyAdaptersBidmachine UpdateAdapterInitOverrideJSONBodyAdapters = "bidmachine"
+	UpdateAdapterInitOverrideJSONBodyAdaptersBigoads    UpdateAdapterInitOverrideJSONBodyAdapters = "bigoads"
+	UpdateAdapterInitOverrideJSONBodyAdaptersChartboost UpdateAdapterInitOverrideJSONBodyAdapters = "chartboost"
+	UpdateAdapterInitOverrideJSONBodyAdaptersDtexchange UpdateAdapterInitOverrideJSONBodyAdapters = "dtexchange"
+	UpdateAdapterInitOverrideJSONBodyAdaptersGam        UpdateAdapterInitOverrideJSONBodyAdapters = "gam"
+	UpdateAdapterInitOverrideJSONBodyAdaptersInmobi     UpdateAdapterInitOverrideJSONBodyAdapters = "inmobi"
+	UpdateAdapterInitOverrideJSONBodyAdaptersIronsource UpdateAdapterInitOverrideJSONBodyAdapters = "ironsource"
+	UpdateAdapterInitOverrideJSONBodyAdaptersMeta       UpdateAdapterInitOverrideJSONBodyAdapters = "meta"
+	UpdateAdapterInitOverrideJSONBodyAdaptersMintegral  UpdateAdapterInitOverrideJSONBodyAdapters = "mintegral"
+	UpdateAdapterInitOverrideJSONBodyAdaptersMobilefuse UpdateAdapterInitOverrideJSONBodyAdapters = "mobilefuse"
+	UpdateAdapterInitOverrideJSONBodyAdaptersMoloco     UpdateAdapterInitOverrideJSONBodyAdapters = "moloco"
+	UpdateAdapterInitOverrideJSONBodyAdaptersStartio    UpdateAdapterInitOverrideJSONBodyAdapters = "startio"
+	UpdateAdapterInitOverrideJSONBodyAdaptersTaurusx    UpdateAdapterInitOverrideJSONBodyAdapters = "taurusx"
+	UpdateAdapterInitOverrideJSONBodyAdaptersUnityads   UpdateAdapterInitOverrideJSONBodyAdapters = "unityads"
+	UpdateAdapterInitOverrideJSONBodyAdaptersVkads      UpdateAdapterInitOverrideJSONBodyAdapters = "vkads"
+	UpdateAdapterInitOverrideJSONBodyAdaptersVungle     UpdateAdapterInitOverrideJSONBodyAdapters = "vungle"
+	UpdateAdapterInitOverrideJSONBodyAdaptersYandex     UpdateAdapterInitOverrideJSONBodyAdapters = "yandex"
+)
+
 // Defines values for CreateAppJSONBodyPlatformId.
 const (
 	CreateAppJSONBodyPlatformIdAndroid CreateAppJSONBodyPlatformId = "android"
@@ -50,6 +98,102 @@ const (
 	UpdateAuctionConfigurationJSONBodyAdTypeRewarded     UpdateAuctionConfigurationJSONBodyAdType = "rewarded"
 )
 
+// Defines values for CreateExperimentJSONBodyVariantsBidding.
+const (
+	CreateExperimentJSONBodyVariantsBiddingAdmob      CreateExperimentJSONBodyVariantsBidding = "admob"
+	CreateExperimentJSONBodyVariantsBiddingAmazon     CreateExperimentJSONBodyVariantsBidding = "amazon"
+	CreateExperimentJSONBodyVariantsBiddingApplovin   CreateExperimentJSONBodyVariantsBidding = "applovin"
+	CreateExperimentJSONBodyVariantsBiddingBidmachine CreateExperimentJSONBodyVariantsBidding = "bidmachine"
+	CreateExperimentJSONBodyVariantsBiddingBigoads    CreateExperimentJSONBodyVariantsBidding = "bigoads"
+	CreateExperimentJSONBodyVariantsBiddingChartboost CreateExperimentJSONBodyVariantsBidding = "chartboost"
+	CreateExperimentJSONBodyVariantsBiddingDtexchange CreateExperimentJSONBodyVariantsBidding = "dtexchange"
+	CreateExperimentJSONBodyVariantsBiddingGam        CreateExperimentJSONBodyVariantsBidding = "gam"
+	CreateExperimentJSONBodyVariantsBiddingInmobi     CreateExperimentJSONBodyVariantsBidding = "inmobi"
+	CreateExperimentJSONBodyVariantsBiddingIronsource CreateExperimentJSONBodyVariantsBidding = "ironsource"
+	CreateExperimentJSONBodyVariantsBiddingMeta       CreateExperimentJSONBodyVariantsBidding = "meta"
+	CreateExperimentJSONBodyVariantsBiddingMintegral  CreateExperimentJSONBodyVariantsBidding = "mintegral"
+	CreateExperimentJSONBodyVariantsBiddingMobilefuse CreateExperimentJSONBodyVariantsBidding = "mobilefuse"
+	CreateExperimentJSONBodyVariantsBiddingMoloco     CreateExperimentJSONBodyVariantsBidding = "moloco"
+	CreateExperimentJSONBodyVariantsBiddingStartio    CreateExperimentJSONBodyVariantsBidding = "startio"
+	CreateExperimentJSONBodyVariantsBiddingTaurusx    CreateExperimentJSONBodyVariantsBidding = "taurusx"
+	CreateExperimentJSONBodyVariantsBiddingUnityads   CreateExperimentJSONBodyVariantsBidding = "unityads"
+	CreateExperimentJSONBodyVariantsBiddingVkads      CreateExperimentJSONBodyVariantsBidding = "vkads"
+	CreateExperimentJSONBodyVariantsBiddingVungle     CreateExperimentJSONBodyVariantsBidding = "vungle"
+	CreateExperimentJSONBodyVariantsBiddingYandex     CreateExperimentJSONBodyVariantsBidding = "yandex"
+)
+
+// Defines values for CreateExperimentJSONBodyVariantsDemands.
+const (
+	CreateExperimentJSONBodyVariantsDemandsAdmob      CreateExperimentJSONBodyVariantsDemands = "admob"
+	CreateExperimentJSONBodyVariantsDemandsAmazon     CreateExperimentJSONBodyVariantsDemands = "amazon"
+	CreateExperimentJSONBodyVariantsDemandsApplovin   CreateExperimentJSONBodyVariantsDemands = "applovin"
+	CreateExperimentJSONBodyVariantsDemandsBidmachine CreateExperimentJSONBodyVariantsDemands = "bidmachine"
+	CreateExperimentJSONBodyVariantsDemandsBigoads    CreateExperimentJSONBodyVariantsDemands = "bigoads"
+	CreateExperimentJSONBodyVariantsDemandsChartboost CreateExperimentJSONBodyVariantsDemands = "chartboost"
+	CreateExperimentJSONBodyVariantsDemandsDtexchange CreateExperimentJSONBodyVariantsDemands = "dtexchange"
+	CreateExperimentJSONBodyVariantsDemandsGam        CreateExperimentJSONBodyVariantsDemands = "gam"
+	CreateExperimentJSONBodyVariantsDemandsInmobi     CreateExperimentJSONBodyVariantsDemands = "inmobi"
+	CreateExperimentJSONBodyVariantsDemandsIronsource CreateExperimentJSONBodyVariantsDemands = "ironsource"
+	CreateExperimentJSONBodyVariantsDemandsMeta       CreateExperimentJSONBodyVariantsDemands = "meta"
+	CreateExperimentJSONBodyVariantsDemandsMintegral  CreateExperimentJSONBodyVariantsDemands = "mintegral"
+	CreateExperimentJSONBodyVariantsDemandsMobilefuse CreateExperimentJSONBodyVariantsDemands = "mobilefuse"
+	CreateExperimentJSONBodyVariantsDemandsMoloco     CreateExperimentJSONBodyVariantsDemands = "moloco"
+	CreateExperimentJSONBodyVariantsDemandsStartio    CreateExperimentJSONBodyVariantsDemands = "startio"
+	CreateExperimentJSONBodyVariantsDemandsTaurusx    CreateExperimentJSONBodyVariantsDemands = "taurusx"
+	CreateExperimentJSONBodyVariantsDemandsUnityads   CreateExperimentJSONBodyVariantsDemands = "unityads"
+	CreateExperimentJSONBodyVariantsDemandsVkads      CreateExperimentJSONBodyVariantsDemands = "vkads"
+	CreateExperimentJSONBodyVariantsDemandsVungle     CreateExperimentJSONBodyVariantsDemands = "vungle"
+	CreateExperimentJSONBodyVariantsDemandsYandex     CreateExperimentJSONBodyVariantsDemands = "yandex"
+)
+
+// Defines values for UpdateExperimentJSONBodyVariantsBidding.
+const (
+	UpdateExperimentJSONBodyVariantsBiddingAdmob      UpdateExperimentJSONBodyVariantsBidding = "admob"
+	UpdateExperimentJSONBodyVariantsBiddingAmazon     UpdateExperimentJSONBodyVariantsBidding = "amazon"
+	UpdateExperimentJSONBodyVariantsBiddingApplovin   UpdateExperimentJSONBodyVariantsBidding = "applovin"
+	UpdateExperimentJSONBodyVariantsBiddingBidmachine UpdateExperimentJSONBodyVariantsBidding = "bidmachine"
+	UpdateExperimentJSONBodyVariantsBiddingBigoads    UpdateExperimentJSONBodyVariantsBidding = "bigoads"
+	UpdateExperimentJSONBodyVariantsBiddingChartboost UpdateExperimentJSONBodyVariantsBidding = "chartboost"
+	UpdateExperimentJSONBodyVariantsBiddingDtexchange UpdateExperimentJSONBodyVariantsBidding = "dtexchange"
+	UpdateExperimentJSONBodyVariantsBiddingGam        UpdateExperimentJSONBodyVariantsBidding = "gam"
+	UpdateExperimentJSONBodyVariantsBiddingInmobi     UpdateExperimentJSONBodyVariantsBidding = "inmobi"
+	UpdateExperimentJSONBodyVariantsBiddingIronsource UpdateExperimentJSONBodyVariantsBidding = "ironsource"
+	UpdateExperimentJSONBodyVariantsBiddingMeta       UpdateExperimentJSONBodyVariantsBidding = "meta"
+	UpdateExperimentJSONBodyVariantsBiddingMintegral  UpdateExperimentJSONBodyVariantsBidding = "mintegral"
+	UpdateExperimentJSONBodyVariantsBiddingMobilefuse UpdateExperimentJSONBodyVariantsBidding = "mobilefuse"
+	UpdateExperimentJSONBodyVariantsBiddingMoloco     UpdateExperimentJSONBodyVariantsBidding = "moloco"
+	UpdateExperimentJSONBodyVariantsBiddingStartio    UpdateExperimentJSONBodyVariantsBidding = "startio"
+	UpdateExperimentJSONBodyVariantsBiddingTaurusx    UpdateExperimentJSONBodyVariantsBidding = "taurusx"
+	UpdateExperimentJSONBodyVariantsBiddingUnityads   UpdateExperimentJSONBodyVariantsBidding = "unityads"
+	UpdateExperimentJSONBodyVariantsBiddingVkads      UpdateExperimentJSONBodyVariantsBidding = "vkads"
+	UpdateExperimentJSONBodyVariantsBiddingVungle     UpdateExperimentJSONBodyVariantsBidding = "vungle"
+	UpdateExperimentJSONBodyVariantsBiddingYandex     UpdateExperimentJSONBodyVariantsBidding = "yandex"
+)
+
+// Defines values for UpdateExperimentJSONBodyVariantsDemands.
+const (
+	UpdateExperimentJSONBodyVariantsDemandsAdmob      UpdateExperimentJSONBodyVariantsDemands = "admob"
+	UpdateExperimentJSONBodyVariantsDemandsAmazon     UpdateExperimentJSONBodyVariantsDemands = "amazon"
+	UpdateExperimentJSONBodyVariantsDemandsApplovin   UpdateExperimentJSONBodyVariantsDemands = "applovin"
+	UpdateExperimentJSONBodyVariantsDemandsBidmachine UpdateExperimentJSONBodyVariantsDemands = "bidmachine"
+	UpdateExperimentJSONBodyVariantsDemandsBigoads    UpdateExperimentJSONBodyVariantsDemands = "bigoads"
+	UpdateExperimentJSONBodyVariantsDemandsChartboost UpdateExperimentJSONBodyVariantsDemands = "chartboost"
+	UpdateExperimentJSONBodyVariantsDemandsDtexchange UpdateExperimentJSONBodyVariantsDemands = "dtexchange"
+	UpdateExperimentJSONBodyVariantsDemandsGam        UpdateExperimentJSONBodyVariantsDemands = "gam"
+	UpdateExperimentJSONBodyVariantsDemandsInmobi     UpdateExperimentJSONBodyVariantsDemands = "inmobi"
+	UpdateExperimentJSONBodyVariantsDemandsIronsource UpdateExperimentJSONBodyVariantsDemands = "ironsource"
+	UpdateExperimentJSONBodyVariantsDemandsMeta       UpdateExperimentJSONBodyVariantsDemands = "meta"
+	UpdateExperimentJSONBodyVariantsDemandsMintegral  UpdateExperimentJSONBodyVariantsDemands = "mintegral"
+	UpdateExperimentJSONBodyVariantsDemandsMobilefuse UpdateExperimentJSONBodyVariantsDemands = "mobilefuse"
+	UpdateExperimentJSONBodyVariantsDemandsMoloco     UpdateExperimentJSONBodyVariantsDemands = "moloco"
+	UpdateExperimentJSONBodyVariantsDemandsStartio    UpdateExperimentJSONBodyVariantsDemands = "startio"
+	UpdateExperimentJSONBodyVariantsDemandsTaurusx    UpdateExperimentJSONBodyVariantsDemands = "taurusx"
+	UpdateExperimentJSONBodyVariantsDemandsUnityads   UpdateExperimentJSONBodyVariantsDemands = "unityads"
+	UpdateExperimentJSONBodyVariantsDemandsVkads      UpdateExperimentJSONBodyVariantsDemands = "vkads"
+	UpdateExperimentJSONBodyVariantsDemandsVungle     UpdateExperimentJSONBodyVariantsDemands = "vungle"
+	UpdateExperimentJSONBodyVariantsDemandsYandex     UpdateExperimentJSONBodyVariantsDemands = "yandex"
+)
+
 // Defines values for CreateLineItemJSONBodyAdType.
 const (
 	CreateLineItemJSONBodyAdTypeBanner       CreateLineItemJSONBodyAdType = "banner"
@@ -98,6 +242,13 @@ const (
 	UpdateLineItemJSONBodyFormatMREC        UpdateLineItemJSONBodyFormat = "MREC"
 )
 
+// Defines values for ExplainSegmentsJSONBodyAdType.
+const (
+	ExplainSegmentsJSONBodyAdTypeBanner       ExplainSegmentsJSONBodyAdType = "banner"
+	ExplainSegmentsJSONBodyAdTypeInterstitial ExplainSegmentsJSONBodyAdType = "interstitial"
+	ExplainSegmentsJSONBodyAdTypeRewarded     ExplainSegmentsJSONBodyAdType = "rewarded"
+)
+
 // Defines values for CreateAuctionConfigurationV2JSONBodyAdType.
 const (
 	CreateAuctionConfigurationV2JSONBodyAdTypeBanner       CreateAuctionConfigurationV2JSONBodyAdType = "banner"
@@ -155,9 +306,9 @@ const (
 
 // Defines values for UpdateAuctionConfigurationV2JSONBodyAdType.
 const (
-	UpdateAuctionConfigurationV2JSONBodyAdTypeBanner       UpdateAuctionConfigurationV2JSONBodyAdType = "banner"
-	UpdateAuctionConfigurationV2JSONBodyAdTypeInterstitial UpdateAuctionConfigurationV2JSONBodyAdType = "interstitial"
-	UpdateAuctionConfigurationV2JSONBodyAdTypeRewarded     UpdateAuctionConfigurationV2JSONBodyAdType = "rewarded"
+	Banner       UpdateAuctionConfigurationV2JSONBodyAdType = "banner"
+	Interstitial UpdateAuctionConfigurationV2JSONBodyAdType = "interstitial"
+	Rewarded     UpdateAuctionConfigurationV2JSONBodyAdType = "rewarded"
 )
 
 // Defines values for UpdateAuctionConfigurationV2JSONBodyBidding.
@@ -255,6 +406,54 @@ type ErrorResponse struct {
 	} `json:"error"`
 }
 
+// CreateAdapterInitOverrideJSONBody defines parameters for CreateAdapterInitOverride.
+type CreateAdapterInitOverrideJSONBody struct {
+	// Adapters Adapters returned in SDK config. Empty list means no restriction
+	Adapters *[]CreateAdapterInitOverrideJSONBodyAdapters `json:"adapters,omitempty"`
+
+	// AppId A positive integer ID
+	AppId int `json:"app_id"`
+
+	// Enabled Indicates if the override is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Id A positive integer primary ID, read-only
+	Id *int `json:"id,omitempty"`
+
+	// Patches Fields merged into adapter init configs, keyed by adapter key
+	Patches *map[string]map[string]interface{} `json:"patches,omitempty"`
+
+	// SegmentId A positive integer ID
+	SegmentId *int `json:"segment_id,omitempty"`
+}
+
+// CreateAdapterInitOverrideJSONBodyAdapters defines parameters for CreateAdapterInitOverride.
+type CreateAdapterInitOverrideJSONBodyAdapters string
+
+// UpdateAdapterInitOverrideJSONBody defines parameters for UpdateAdapterInitOverride.
+type UpdateAdapterInitOverrideJSONBody struct {
+	// Adapters Adapters returned in SDK config. Empty list means no restriction
+	Adapters *[]UpdateAdapterInitOverrideJSONBodyAdapters `json:"adapters,omitempty"`
+
+	// AppId A positive integer ID
+	AppId *int `json:"app_id,omitempty"`
+
+	// Enabled Indicates if the override is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Id A positive integer primary ID, read-only
+	Id *int `json:"id,omitempty"`
+
+	// Patches Fields merged into adapter init configs, keyed by adapter key
+	Patches *map[string]map[string]interface{} `json:"patches,omitempty"`
+
+	// SegmentId A positive integer ID
+	SegmentId *int `json:"segment_id,omitempty"`
+}
+
+// UpdateAdapterInitOverrideJSONBodyAdapters defines parameters for UpdateAdapterInitOverride.
+type UpdateAdapterInitOverrideJSONBodyAdapters string
+
 // CreateAppDemandProfileJSONBody defines parameters for CreateAppDemandProfile.
 type CreateAppDemandProfileJSONBody struct {
 	// AccountId A positive integer ID
@@ -522,6 +721,66 @@ type UpdateCountryJSONBody struct {
 	Id *int `json:"id,omitempty"`
 }
 
+// CreateDealJSONBody defines parameters for CreateDeal.
+type CreateDealJSONBody struct {
+	// AppId A positive integer ID
+	AppId int `json:"app_id"`
+
+	// BidFloor The minimum bid price of the deal
+	BidFloor *string `json:"bid_floor,omitempty"`
+
+	// Countries ISO 3166-1 alpha-2 codes of countries the deal is targeted to. Empty list means all countries
+	Countries *[]string `json:"countries,omitempty"`
+
+	// DealId The deal ID agreed with the demand source, sent in imp.pmp.deals
+	DealId string `json:"deal_id"`
+
+	// DemandSourceId A positive integer ID
+	DemandSourceId int `json:"demand_source_id"`
+
+	// Enabled Indicates if the deal is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Id A positive integer primary ID, read-only
+	Id *int `json:"id,omitempty"`
+
+	// Priority Deal bids with higher priority are ranked above other bids regardless of price. Open auction bids have priority 0
+	Priority *int32 `json:"priority,omitempty"`
+
+	// SegmentId A positive integer ID
+	SegmentId *int `json:"segment_id,omitempty"`
+}
+
+// UpdateDealJSONBody defines parameters for UpdateDeal.
+type UpdateDealJSONBody struct {
+	// AppId A positive integer ID
+	AppId *int `json:"app_id,omitempty"`
+
+	// BidFloor The minimum bid price of the deal
+	BidFloor *string `json:"bid_floor,omitempty"`
+
+	// Countries ISO 3166-1 alpha-2 codes of countries the deal is targeted to. Empty list means all countries
+	Countries *[]string `json:"countries,omitempty"`
+
+	// DealId The deal ID agreed with the demand source, sent in imp.pmp.deals
+	DealId *string `json:"deal_id,omitempty"`
+
+	// DemandSourceId A positive integer ID
+	DemandSourceId *int `json:"demand_source_id,omitempty"`
+
+	// Enabled Indicates if the deal is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Id A positive integer primary ID, read-only
+	Id *int `json:"id,omitempty"`
+
+	// Priority Deal bids with higher priority are ranked above other bids regardless of price. Open auction bids have priority 0
+	Priority *int32 `json:"priority,omitempty"`
+
+	// SegmentId A positive integer ID
+	SegmentId *int `json:"segment_id,omitempty"`
+}
+
 // CreateDemandSourceAccountJSONBody defines parameters for CreateDemandSourceAccount.
 type CreateDemandSourceAccountJSONBody struct {
 	// DemandSourceId A positive integer ID
@@ -575,7 +834,44 @@ type CreateDemandSourceJSONBody struct {
 	HumanName string `json:"human_name"`
 
 	// Id A positive integer primary ID, read-only
-	Id        *int                `json:"id,omitempty"`
+	Id *int `json:"id,omitempty"`
+
+	// Openrtb Makes demand source requested by the generic OpenRTB 2.6 adapter. Templates may contain {{account.<key>}}, {{app.<key>}} and {{ad_unit.<key>}} macros replaced with values of demand source account extra, app demand profile data and ad unit extra
+	Openrtb *struct {
+		// Endpoint URL template of bid requests
+		Endpoint string `json:"endpoint"`
+
+		// Headers Headers added to bid requests, values are templates
+		Headers *map[string]string `json:"headers,omitempty"`
+
+		// Imps Impressions requested for ad types. Ad types without impression are not requested
+		Imps map[string]struct {
+			// Banner Add banner object sized by ad format, or full screen for interstitial and rewarded ads
+			Banner *bool `json:"banner,omitempty"`
+
+			// Video Add video object
+			Video *bool `json:"video,omitempty"`
+
+			// VideoMimes MIME types of video object, video/mp4 by default
+			VideoMimes *[]string `json:"video_mimes,omitempty"`
+		} `json:"imps"`
+
+		// Payload Bid field returned to SDK as payload: adm (default) or ext.<key>
+		Payload *string `json:"payload,omitempty"`
+
+		// Signaldata Bid field returned to SDK as signaldata: ext.<key>
+		Signaldata *string `json:"signaldata,omitempty"`
+
+		// TagId Template of imp.tagid
+		TagId *string `json:"tag_id,omitempty"`
+		Token *struct {
+			// Field Key of bid token in demand data of auction request, token by default
+			Field *string `json:"field,omitempty"`
+
+			// Target Bid request field the token is set to: user.buyeruid (default), user.ext.<key> or imp.ext.<key>
+			Target *string `json:"target,omitempty"`
+		} `json:"token,omitempty"`
+	} `json:"openrtb,omitempty"`
 	PublicUid *openapi_types.UUID `json:"public_uid,omitempty"`
 }
 
@@ -588,10 +884,179 @@ type UpdateDemandSourceJSONBody struct {
 	HumanName string `json:"human_name"`
 
 	// Id A positive integer primary ID, read-only
-	Id        *int                `json:"id,omitempty"`
+	Id *int `json:"id,omitempty"`
+
+	// Openrtb Makes demand source requested by the generic OpenRTB 2.6 adapter. Templates may contain {{account.<key>}}, {{app.<key>}} and {{ad_unit.<key>}} macros replaced with values of demand source account extra, app demand profile data and ad unit extra
+	Openrtb *struct {
+		// Endpoint URL template of bid requests
+		Endpoint string `json:"endpoint"`
+
+		// Headers Headers added to bid requests, values are templates
+		Headers *map[string]string `json:"headers,omitempty"`
+
+		// Imps Impressions requested for ad types. Ad types without impression are not requested
+		Imps map[string]struct {
+			// Banner Add banner object sized by ad format, or full screen for interstitial and rewarded ads
+			Banner *bool `json:"banner,omitempty"`
+
+			// Video Add video object
+			Video *bool `json:"video,omitempty"`
+
+			// VideoMimes MIME types of video object, video/mp4 by default
+			VideoMimes *[]string `json:"video_mimes,omitempty"`
+		} `json:"imps"`
+
+		// Payload Bid field returned to SDK as payload: adm (default) or ext.<key>
+		Payload *string `json:"payload,omitempty"`
+
+		// Signaldata Bid field returned to SDK as signaldata: ext.<key>
+		Signaldata *string `json:"signaldata,omitempty"`
+
+		// TagId Template of imp.tagid
+		TagId *string `json:"tag_id,omitempty"`
+		Token *struct {
+			// Field Key of bid token in demand data of auction request, token by default
+			Field *string `json:"field,omitempty"`
+
+			// Target Bid request field the token is set to: user.buyeruid (default), user.ext.<key> or imp.ext.<key>
+			Target *string `json:"target,omitempty"`
+		} `json:"token,omitempty"`
+	} `json:"openrtb,omitempty"`
 	PublicUid *openapi_types.UUID `json:"public_uid,omitempty"`
 }
 
+// CreateExperimentJSONBody defines parameters for CreateExperiment.
+type CreateExperimentJSONBody struct {
+	// AppId A positive integer ID
+	AppId int `json:"app_id"`
+
+	// AuctionConfigurationId A positive integer ID
+	AuctionConfigurationId int `json:"auction_configuration_id"`
+
+	// Id A positive integer primary ID, read-only
+	Id *int `json:"id,omitempty"`
+
+	// Name Name of the experiment
+	Name string `json:"name"`
+
+	// Variants Weighted variants of the experiment
+	Variants []struct {
+		// AdUnitIds Ad unit IDs override
+		AdUnitIds *[]int64 `json:"ad_unit_ids,omitempty"`
+
+		// Bidding Bidding adapters override
+		Bidding *[]CreateExperimentJSONBodyVariantsBidding `json:"bidding,omitempty"`
+
+		// Demands CPM adapters override
+		Demands *[]CreateExperimentJSONBodyVariantsDemands `json:"demands,omitempty"`
+
+		// FloorPolicy Controls how auction price floor is calculated
+		FloorPolicy *struct {
+			// DynamicFloor Raise price floor to the one recommended from historical bids
+			DynamicFloor *bool `json:"dynamic_floor,omitempty"`
+
+			// DynamicFloorTargetFillRate Share of auctions that recommended floor should keep filled. Defaults to 0.9
+			DynamicFloorTargetFillRate *float32 `json:"dynamic_floor_target_fill_rate,omitempty"`
+
+			// IgnoreAdCachePrices Do not raise price floor to prices of cached ads
+			IgnoreAdCachePrices *bool `json:"ignore_ad_cache_prices,omitempty"`
+
+			// IgnoreFloorForMediators Mediators for which auction price floor is disabled
+			IgnoreFloorForMediators *[]string `json:"ignore_floor_for_mediators,omitempty"`
+
+			// IgnorePrevAuctionPrice Do not raise price floor to previous auction price reported by custom mediators
+			IgnorePrevAuctionPrice *bool `json:"ignore_prev_auction_price,omitempty"`
+		} `json:"floor_policy,omitempty"`
+
+		// Id Variant identifier, unique within the experiment. Stamped into events of users assigned to the variant
+		Id string `json:"id"`
+
+		// Pricefloor Price floor override
+		Pricefloor *float64 `json:"pricefloor,omitempty"`
+
+		// Timeout Auction timeout override
+		Timeout *int32 `json:"timeout,omitempty"`
+
+		// Weight Share of traffic assigned to the variant relative to other variants
+		Weight int32 `json:"weight"`
+	} `json:"variants"`
+}
+
+// CreateExperimentJSONBodyVariantsBidding defines parameters for CreateExperiment.
+type CreateExperimentJSONBodyVariantsBidding string
+
+// CreateExperimentJSONBodyVariantsDemands defines parameters for CreateExperiment.
+type CreateExperimentJSONBodyVariantsDemands string
+
+// UpdateExperimentJSONBody defines parameters for UpdateExperiment.
+type UpdateExperimentJSONBody struct {
+	// AppId A positive integer ID
+	AppId *int `json:"app_id,omitempty"`
+
+	// AuctionConfigurationId A positive integer ID
+	AuctionConfigurationId *int `json:"auction_configuration_id,omitempty"`
+
+	// Id A positive integer primary ID, read-only
+	Id *int `json:"id,omitempty"`
+
+	// Name Name of the experiment
+	Name *string `json:"name,omitempty"`
+
+	// Variants Weighted variants of the experiment
+	Variants *[]struct {
+		// AdUnitIds Ad unit IDs override
+		AdUnitIds *[]int64 `json:"ad_unit_ids,omitempty"`
+
+		// Bidding Bidding adapters override
+		Bidding *[]UpdateExperimentJSONBodyVariantsBidding `json:"bidding,omitempty"`
+
+		// Demands CPM adapters override
+		Demands *[]UpdateExperimentJSONBodyVariantsDemands `json:"demands,omitempty"`
+
+		// FloorPolicy Controls how auction price floor is calculated
+		FloorPolicy *struct {
+			// DynamicFloor Raise price floor to the one recommended from historical bids
+			DynamicFloor *bool `json:"dynamic_floor,omitempty"`
+
+			// DynamicFloorTargetFillRate Share of auctions that recommended floor should keep filled. Defaults to 0.9
+			DynamicFloorTargetFillRate *float32 `json:"dynamic_floor_target_fill_rate,omitempty"`
+
+			// IgnoreAdCachePrices Do not raise price floor to prices of cached ads
+			IgnoreAdCachePrices *bool `json:"ignore_ad_cache_prices,omitempty"`
+
+			// IgnoreFloorForMediators Mediators for which auction price floor is disabled
+			IgnoreFloorForMediators *[]string `json:"ignore_floor_for_mediators,omitempty"`
+
+			// IgnorePrevAuctionPrice Do not raise price floor to previous auction price reported by custom mediators
+			IgnorePrevAuctionPrice *bool `json:"ignore_prev_auction_price,omitempty"`
+		} `json:"floor_policy,omitempty"`
+
+		// Id Variant identifier, unique within the experiment. Stamped into events of users assigned to the variant
+		Id string `json:"id"`
+
+		// Pricefloor Price floor override
+		Pricefloor *float64 `json:"pricefloor,omitempty"`
+
+		// Timeout Auction timeout override
+		Timeout *int32 `json:"timeout,omitempty"`
+
+		// Weight Share of traffic assigned to the variant relative to other variants
+		Weight int32 `json:"weight"`
+	} `json:"variants,omitempty"`
+}
+
+// UpdateExperimentJSONBodyVariantsBidding defines parameters for UpdateExperiment.
+type UpdateExperimentJSONBodyVariantsBidding string
+
+// UpdateExperimentJSONBodyVariantsDemands defines parameters for UpdateExperiment.
+type UpdateExperimentJSONBodyVariantsDemands string
+
+// ConcludeExperimentJSONBody defines parameters for ConcludeExperiment.
+type ConcludeExperimentJSONBody struct {
+	// WinnerVariantId Optional ID of the winning variant
+	WinnerVariantId *string `json:"winner_variant_id,omitempty"`
+}
+
 // GetLineItemsParams defines parameters for GetLineItems.
 type GetLineItemsParams struct {
 	// UserId Filter by user ID
@@ -918,15 +1383,18 @@ type CreateSegmentJSONBody struct {
 
 	// Filters Filters applied to the segment
 	Filters []struct {
-		// Name The name of the filter
-		Name string `json:"name"`
+		// Filters Nested filters combined by the operator of a group filter
+		Filters *[]map[string]interface{} `json:"filters,omitempty"`
+
+		// Name The name of the filter, required for custom_string filters
+		Name *string `json:"name,omitempty"`
 
-		// Operator The operator used in the filter
+		// Operator The operator used in the filter: AND/OR for groups; ==, !=, >, >=, <, <=, BETWEEN, IN, NOT IN, MATCHES or STARTS WITH depending on the filter type
 		Operator string `json:"operator"`
 
-		// Type The type of filter
-		Type   string   `json:"type"`
-		Values []string `json:"values"`
+		// Type The type of filter: group, country, custom_string, game_level, age, total_in_apps_amount, is_paying, gender, device_os, sdk_version, app_version or session_uptime
+		Type   string    `json:"type"`
+		Values *[]string `json:"values,omitempty"`
 	} `json:"filters"`
 
 	// Id A positive integer primary ID, read-only
@@ -937,6 +1405,34 @@ type CreateSegmentJSONBody struct {
 	PublicUid *openapi_types.UUID `json:"public_uid,omitempty"`
 }
 
+// ExplainSegmentsJSONBody defines parameters for ExplainSegments.
+type ExplainSegmentsJSONBody struct {
+	AdType ExplainSegmentsJSONBodyAdType `json:"ad_type"`
+
+	// AppId ID of the app whose segments are evaluated
+	AppId      int64   `json:"app_id"`
+	AppVersion *string `json:"app_version,omitempty"`
+
+	// Country Alpha-2 country code of the sample request
+	Country  *string `json:"country,omitempty"`
+	DeviceOs *string `json:"device_os,omitempty"`
+
+	// Ext Segment ext of the sample request, as sent by SDK
+	Ext        *string `json:"ext,omitempty"`
+	SdkVersion *string `json:"sdk_version,omitempty"`
+
+	// SessionUptime Session uptime in milliseconds
+	SessionUptime *int `json:"session_uptime,omitempty"`
+}
+
+// ExplainSegmentsJSONBodyAdType defines parameters for ExplainSegments.
+type ExplainSegmentsJSONBodyAdType string
+
+// GetSegmentOverlapsParams defines parameters for GetSegmentOverlaps.
+type GetSegmentOverlapsParams struct {
+	AppId int64 `form:"app_id" json:"app_id"`
+}
+
 // UpdateSegmentJSONBody defines parameters for UpdateSegment.
 type UpdateSegmentJSONBody struct {
 	// AppId A positive integer ID
@@ -950,15 +1446,18 @@ type UpdateSegmentJSONBody struct {
 
 	// Filters Filters applied to the segment
 	Filters []struct {
-		// Name The name of the filter
-		Name string `json:"name"`
+		// Filters Nested filters combined by the operator of a group filter
+		Filters *[]map[string]interface{} `json:"filters,omitempty"`
+
+		// Name The name of the filter, required for custom_string filters
+		Name *string `json:"name,omitempty"`
 
-		// Operator The operator used in the filter
+		// Operator The operator used in the filter: AND/OR for groups; ==, !=, >, >=, <, <=, BETWEEN, IN, NOT IN, MATCHES or STARTS WITH depending on the filter type
 		Operator string `json:"operator"`
 
-		// Type The type of filter
-		Type   string   `json:"type"`
-		Values []string `json:"values"`
+		// Type The type of filter: group, country, custom_string, game_level, age, total_in_apps_amount, is_paying, gender, device_os, sdk_version, app_version or session_uptime
+		Type   string    `json:"type"`
+		Values *[]string `json:"values,omitempty"`
 	} `json:"filters"`
 
 	// Id A positive integer primary ID, read-only
@@ -998,12 +1497,39 @@ type CreateAuctionConfigurationV2JSONBody struct {
 	// Bidding List of bidding sources
 	Bidding *[]CreateAuctionConfigurationV2JSONBodyBidding `json:"bidding,omitempty"`
 
+	// BiddingTimeouts Limits how long bidding adapters are waited for
+	BiddingTimeouts *struct {
+		// Adapters Timeouts of individual adapters in milliseconds, keyed by adapter key. Capped by tmax
+		Adapters *map[string]int32 `json:"adapters,omitempty"`
+
+		// Tmax Deadline of the bidding round in milliseconds, also sent to DSPs as tmax. Default is used if not set
+		Tmax *int32 `json:"tmax,omitempty"`
+	} `json:"bidding_timeouts,omitempty"`
+
 	// Demands List of demand sources
 	Demands *[]CreateAuctionConfigurationV2JSONBodyDemands `json:"demands,omitempty"`
 
 	// ExternalWinNotifications Whether external win notifications are enabled
 	ExternalWinNotifications *bool `json:"external_win_notifications,omitempty"`
 
+	// FloorPolicy Controls how auction price floor is calculated
+	FloorPolicy *struct {
+		// DynamicFloor Raise price floor to the one recommended from historical bids
+		DynamicFloor *bool `json:"dynamic_floor,omitempty"`
+
+		// DynamicFloorTargetFillRate Share of auctions that recommended floor should keep filled. Defaults to 0.9
+		DynamicFloorTargetFillRate *float32 `json:"dynamic_floor_target_fill_rate,omitempty"`
+
+		// IgnoreAdCachePrices Do not raise price floor to prices of cached ads
+		IgnoreAdCachePrices *bool `json:"ignore_ad_cache_prices,omitempty"`
+
+		// IgnoreFloorForMediators Mediators for which auction price floor is disabled
+		IgnoreFloorForMediators *[]string `json:"ignore_floor_for_mediators,omitempty"`
+
+		// IgnorePrevAuctionPrice Do not raise price floor to previous auction price reported by custom mediators
+		IgnorePrevAuctionPrice *bool `json:"ignore_prev_auction_price,omitempty"`
+	} `json:"floor_policy,omitempty"`
+
 	// Id A positive integer primary ID, read-only
 	Id *int `json:"id,omitempty"`
 
@@ -1045,12 +1571,39 @@ type UpdateAuctionConfigurationV2JSONBody struct {
 	// Bidding List of bidding sources
 	Bidding *[]UpdateAuctionConfigurationV2JSONBodyBidding `json:"bidding,omitempty"`
 
+	// BiddingTimeouts Limits how long bidding adapters are waited for
+	BiddingTimeouts *struct {
+		// Adapters Timeouts of individual adapters in milliseconds, keyed by adapter key. Capped by tmax
+		Adapters *map[string]int32 `json:"adapters,omitempty"`
+
+		// Tmax Deadline of the bidding round in milliseconds, also sent to DSPs as tmax. Default is used if not set
+		Tmax *int32 `json:"tmax,omitempty"`
+	} `json:"bidding_timeouts,omitempty"`
+
 	// Demands List of demand sources
 	Demands *[]UpdateAuctionConfigurationV2JSONBodyDemands `json:"demands,omitempty"`
 
 	// ExternalWinNotifications Whether external win notifications are enabled
 	ExternalWinNotifications *bool `json:"external_win_notifications,omitempty"`
 
+	// FloorPolicy Controls how auction price floor is calculated
+	FloorPolicy *struct {
+		// DynamicFloor Raise price floor to the one recommended from historical bids
+		DynamicFloor *bool `json:"dynamic_floor,omitempty"`
+
+		// DynamicFloorTargetFillRate Share of auctions that recommended floor should keep filled. Defaults to 0.9
+		DynamicFloorTargetFillRate *float32 `json:"dynamic_floor_target_fill_rate,omitempty"`
+
+		// IgnoreAdCachePrices Do not raise price floor to prices of cached ads
+		IgnoreAdCachePrices *bool `json:"ignore_ad_cache_prices,omitempty"`
+
+		// IgnoreFloorForMediators Mediators for which auction price floor is disabled
+		IgnoreFloorForMediators *[]string `json:"ignore_floor_for_mediators,omitempty"`
+
+		// IgnorePrevAuctionPrice Do not raise price floor to previous auction price reported by custom mediators
+		IgnorePrevAuctionPrice *bool `json:"ignore_prev_auction_price,omitempty"`
+	} `json:"floor_policy,omitempty"`
+
 	// Id A positive integer primary ID, read-only
 	Id *int `json:"id,omitempty"`
 
@@ -1079,6 +1632,12 @@ type UpdateAuctionConfigurationV2JSONBodyBidding string
 // UpdateAuctionConfigurationV2JSONBodyDemands defines parameters for UpdateAuctionConfigurationV2.
 type UpdateAuctionConfigurationV2JSONBodyDemands string
 
+// GetAuctionConfigurationV2FloorRecommendationParams defines parameters for GetAuctionConfigurationV2FloorRecommendation.
+type GetAuctionConfigurationV2FloorRecommendationParams struct {
+	// Country Alpha-2 country code
+	Country string `form:"country" json:"country"`
+}
+
 // GetAuctionConfigurationsCollectionV2Params defines parameters for GetAuctionConfigurationsCollectionV2.
 type GetAuctionConfigurationsCollectionV2Params struct {
 	// UserId Filter by user ID
@@ -1121,6 +1680,12 @@ type LogInJSONBody struct {
 	Password string `json:"password"`
 }
 
+// CreateAdapterInitOverrideJSONRequestBody defines body for CreateAdapterInitOverride for application/json ContentType.
+type CreateAdapterInitOverrideJSONRequestBody CreateAdapterInitOverrideJSONBody
+
+// UpdateAdapterInitOverrideJSONRequestBody defines body for UpdateAdapterInitOverride for application/json ContentType.
+type UpdateAdapterInitOverrideJSONRequestBody UpdateAdapterInitOverrideJSONBody
+
 // CreateAppDemandProfileJSONRequestBody defines body for CreateAppDemandProfile for application/json ContentType.
 type CreateAppDemandProfileJSONRequestBody CreateAppDemandProfileJSONBody
 
@@ -1145,6 +1710,12 @@ type CreateCountryJSONRequestBody CreateCountryJSONBody
 // UpdateCountryJSONRequestBody defines body for UpdateCountry for application/json ContentType.
 type UpdateCountryJSONRequestBody UpdateCountryJSONBody
 
+// CreateDealJSONRequestBody defines body for CreateDeal for application/json ContentType.
+type CreateDealJSONRequestBody CreateDealJSONBody
+
+// UpdateDealJSONRequestBody defines body for UpdateDeal for application/json ContentType.
+type UpdateDealJSONRequestBody UpdateDealJSONBody
+
 // CreateDemandSourceAccountJSONRequestBody defines body for CreateDemandSourceAccount for application/json ContentType.
 type CreateDemandSourceAccountJSONRequestBody CreateDemandSourceAccountJSONBody
 
@@ -1157,6 +1728,15 @@ type CreateDemandSourceJSONRequestBody CreateDemandSourceJSONBody
 // UpdateDemandSourceJSONRequestBody defines body for UpdateDemandSource for application/json ContentType.
 type UpdateDemandSourceJSONRequestBody UpdateDemandSourceJSONBody
 
+// CreateExperimentJSONRequestBody defines body for CreateExperiment for application/json ContentType.
+type CreateExperimentJSONRequestBody CreateExperimentJSONBody
+
+// UpdateExperimentJSONRequestBody defines body for UpdateExperiment for application/json ContentType.
+type UpdateExperimentJSONRequestBody UpdateExperimentJSONBody
+
+// ConcludeExperimentJSONRequestBody defines body for ConcludeExperiment for application/json ContentType.
+type ConcludeExperimentJSONRequestBody ConcludeExperimentJSONBody
+
 // CreateLineItemJSONRequestBody defines body for CreateLineItem for application/json ContentType.
 type CreateLineItemJSONRequestBody CreateLineItemJSONBody
 
@@ -1169,6 +1749,9 @@ type UpdateLineItemJSONRequestBody UpdateLineItemJSONBody
 // CreateSegmentJSONRequestBody defines body for CreateSegment for application/json ContentType.
 type CreateSegmentJSONRequestBody CreateSegmentJSONBody
 
+// ExplainSegmentsJSONRequestBody defines body for ExplainSegments for application/json ContentType.
+type ExplainSegmentsJSONRequestBody ExplainSegmentsJSONBody
+
 // UpdateSegmentJSONRequestBody defines body for UpdateSegment for application/json ContentType.
 type UpdateSegmentJSONRequestBody UpdateSegmentJSONBody
 
@@ -1192,6 +1775,21 @@ type LogInJSONRequestBody LogInJSONBody
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List adapter init overrides
+	// (GET /api/adapter_init_overrides)
+	GetAdapterInitOverrides(ctx echo.Context) error
+	// Create adapter init override
+	// (POST /api/adapter_init_overrides)
+	CreateAdapterInitOverride(ctx echo.Context) error
+	// Delete adapter init override
+	// (DELETE /api/adapter_init_overrides/{id})
+	DeleteAdapterInitOverride(ctx echo.Context, id IdParam) error
+	// Get adapter init override
+	// (GET /api/adapter_init_overrides/{id})
+	GetAdapterInitOverride(ctx echo.Context, id IdParam) error
+	// Update adapter init override
+	// (PATCH /api/adapter_init_overrides/{id})
+	UpdateAdapterInitOverride(ctx echo.Context, id IdParam) error
 	// List API keys
 	// (GET /api/api_keys)
 	GetApiKeys(ctx echo.Context) error
@@ -1270,6 +1868,21 @@ type ServerInterface interface {
 	// Update country
 	// (PATCH /api/countries/{id})
 	UpdateCountry(ctx echo.Context, id IdParam) error
+	// List deals
+	// (GET /api/deals)
+	GetDeals(ctx echo.Context) error
+	// Create deal
+	// (POST /api/deals)
+	CreateDeal(ctx echo.Context) error
+	// Delete deal
+	// (DELETE /api/deals/{id})
+	DeleteDeal(ctx echo.Context, id IdParam) error
+	// Get deal
+	// (GET /api/deals/{id})
+	GetDeal(ctx echo.Context, id IdParam) error
+	// Update deal
+	// (PATCH /api/deals/{id})
+	UpdateDeal(ctx echo.Context, id IdParam) error
 	// List demand source accounts
 	// (GET /api/demand_source_accounts)
 	GetDemandSourceAccounts(ctx echo.Context) error
@@ -1300,6 +1913,30 @@ type ServerInterface interface {
 	// Update demand source
 	// (PATCH /api/demand_sources/{id})
 	UpdateDemandSource(ctx echo.Context, id IdParam) error
+	// List experiments
+	// (GET /api/experiments)
+	GetExperiments(ctx echo.Context) error
+	// Create experiment
+	// (POST /api/experiments)
+	CreateExperiment(ctx echo.Context) error
+	// Delete experiment
+	// (DELETE /api/experiments/{id})
+	DeleteExperiment(ctx echo.Context, id IdParam) error
+	// Get experiment
+	// (GET /api/experiments/{id})
+	GetExperiment(ctx echo.Context, id IdParam) error
+	// Update experiment
+	// (PATCH /api/experiments/{id})
+	UpdateExperiment(ctx echo.Context, id IdParam) error
+	// Conclude experiment
+	// (POST /api/experiments/{id}/conclude)
+	ConcludeExperiment(ctx echo.Context, id IdParam) error
+	// Pause experiment
+	// (POST /api/experiments/{id}/pause)
+	PauseExperiment(ctx echo.Context, id IdParam) error
+	// Resume experiment
+	// (POST /api/experiments/{id}/resume)
+	ResumeExperiment(ctx echo.Context, id IdParam) error
 	// List line items
 	// (GET /api/line_items)
 	GetLineItems(ctx echo.Context, params GetLineItemsParams) error
@@ -1333,6 +1970,12 @@ type ServerInterface interface {
 	// Create segment
 	// (POST /api/segments)
 	CreateSegment(ctx echo.Context) error
+	// Explain segment matching
+	// (POST /api/segments/explain)
+	ExplainSegments(ctx echo.Context) error
+	// List shadowed segments
+	// (GET /api/segments/overlaps)
+	GetSegmentOverlaps(ctx echo.Context, params GetSegmentOverlapsParams) error
 	// Delete segment
 	// (DELETE /api/segments/{id})
 	DeleteSegment(ctx echo.Context, id IdParam) error
@@ -1378,6 +2021,9 @@ type ServerInterface interface {
 	// Update auction configuration V2
 	// (PATCH /api/v2/auction_configurations/{id})
 	UpdateAuctionConfigurationV2(ctx echo.Context, id IdParam) error
+	// Get dynamic price floor recommendation
+	// (GET /api/v2/auction_configurations/{id}/floor_recommendation)
+	GetAuctionConfigurationV2FloorRecommendation(ctx echo.Context, id IdParam, params GetAuctionConfigurationV2FloorRecommendationParams) error
 	// List auction configurations V2
 	// (GET /api/v2/auction_configurations_collection)
 	GetAuctionConfigurationsCollectionV2(ctx echo.Context, params GetAuctionConfigurationsCollectionV2Params) error
@@ -1397,6 +2043,72 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAdapterInitOverrides converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdapterInitOverrides(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdapterInitOverrides(ctx)
+	return err
+}
+
+// CreateAdapterInitOverride converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateAdapterInitOverride(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateAdapterInitOverride(ctx)
+	return err
+}
+
+// DeleteAdapterInitOverride converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdapterInitOverride(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdapterInitOverride(ctx, id)
+	return err
+}
+
+// GetAdapterInitOverride converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdapterInitOverride(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdapterInitOverride(ctx, id)
+	return err
+}
+
+// UpdateAdapterInitOverride converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateAdapterInitOverride(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateAdapterInitOverride(ctx, id)
+	return err
+}
+
 // GetApiKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApiKeys(ctx echo.Context) error {
 	var err error
@@ -1824,6 +2536,72 @@ func (w *ServerInterfaceWrapper) UpdateCountry(ctx echo.Context) error {
 	return err
 }
 
+// GetDeals converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDeals(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDeals(ctx)
+	return err
+}
+
+// CreateDeal converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDeal(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDeal(ctx)
+	return err
+}
+
+// DeleteDeal converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDeal(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDeal(ctx, id)
+	return err
+}
+
+// GetDeal converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDeal(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDeal(ctx, id)
+	return err
+}
+
+// UpdateDeal converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDeal(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDeal(ctx, id)
+	return err
+}
+
 // GetDemandSourceAccounts converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDemandSourceAccounts(ctx echo.Context) error {
 	var err error
@@ -1956,6 +2734,120 @@ func (w *ServerInterfaceWrapper) UpdateDemandSource(ctx echo.Context) error {
 	return err
 }
 
+// GetExperiments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetExperiments(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetExperiments(ctx)
+	return err
+}
+
+// CreateExperiment converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateExperiment(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateExperiment(ctx)
+	return err
+}
+
+// DeleteExperiment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteExperiment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteExperiment(ctx, id)
+	return err
+}
+
+// GetExperiment converts echo context to params.
+func (w *ServerInterfaceWrapper) GetExperiment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetExperiment(ctx, id)
+	return err
+}
+
+// UpdateExperiment converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateExperiment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateExperiment(ctx, id)
+	return err
+}
+
+// ConcludeExperiment converts echo context to params.
+func (w *ServerInterfaceWrapper) ConcludeExperiment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConcludeExperiment(ctx, id)
+	return err
+}
+
+// PauseExperiment converts echo context to params.
+func (w *ServerInterfaceWrapper) PauseExperiment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PauseExperiment(ctx, id)
+	return err
+}
+
+// ResumeExperiment converts echo context to params.
+func (w *ServerInterfaceWrapper) ResumeExperiment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResumeExperiment(ctx, id)
+	return err
+}
+
 // GetLineItems converts echo context to params.
 func (w *ServerInterfaceWrapper) GetLineItems(ctx echo.Context) error {
 	var err error
@@ -2180,6 +3072,33 @@ func (w *ServerInterfaceWrapper) CreateSegment(ctx echo.Context) error {
 	return err
 }
 
+// ExplainSegments converts echo context to params.
+func (w *ServerInterfaceWrapper) ExplainSegments(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExplainSegments(ctx)
+	return err
+}
+
+// GetSegmentOverlaps converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSegmentOverlaps(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSegmentOverlapsParams
+	// ------------- Required query parameter "app_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "app_id", ctx.QueryParams(), &params.AppId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSegmentOverlaps(ctx, params)
+	return err
+}
+
 // DeleteSegment converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteSegment(ctx echo.Context) error {
 	var err error
@@ -2378,6 +3297,31 @@ func (w *ServerInterfaceWrapper) UpdateAuctionConfigurationV2(ctx echo.Context)
 	return err
 }
 
+// GetAuctionConfigurationV2FloorRecommendation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuctionConfigurationV2FloorRecommendation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id IdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionConfigurationV2FloorRecommendationParams
+	// ------------- Required query parameter "country" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "country", ctx.QueryParams(), &params.Country)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter country: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuctionConfigurationV2FloorRecommendation(ctx, id, params)
+	return err
+}
+
 // GetAuctionConfigurationsCollectionV2 converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAuctionConfigurationsCollectionV2(ctx echo.Context) error {
 	var err error
@@ -2493,6 +3437,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/api/adapter_init_overrides", wrapper.GetAdapterInitOverrides)
+	router.POST(baseURL+"/api/adapter_init_overrides", wrapper.CreateAdapterInitOverride)
+	router.DELETE(baseURL+"/api/adapter_init_overrides/:id", wrapper.DeleteAdapterInitOverride)
+	router.GET(baseURL+"/api/adapter_init_overrides/:id", wrapper.GetAdapterInitOverride)
+	router.PATCH(baseURL+"/api/adapter_init_overrides/:id", wrapper.UpdateAdapterInitOverride)
 	router.GET(baseURL+"/api/api_keys", wrapper.GetApiKeys)
 	router.POST(baseURL+"/api/api_keys", wrapper.CreateApiKey)
 	router.DELETE(baseURL+"/api/api_keys/:uuid", wrapper.DeleteApiKey)
@@ -2519,6 +3468,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/countries/:id", wrapper.DeleteCountry)
 	router.GET(baseURL+"/api/countries/:id", wrapper.GetCountry)
 	router.PATCH(baseURL+"/api/countries/:id", wrapper.UpdateCountry)
+	router.GET(baseURL+"/api/deals", wrapper.GetDeals)
+	router.POST(baseURL+"/api/deals", wrapper.CreateDeal)
+	router.DELETE(baseURL+"/api/deals/:id", wrapper.DeleteDeal)
+	router.GET(baseURL+"/api/deals/:id", wrapper.GetDeal)
+	router.PATCH(baseURL+"/api/deals/:id", wrapper.UpdateDeal)
 	router.GET(baseURL+"/api/demand_source_accounts", wrapper.GetDemandSourceAccounts)
 	router.POST(baseURL+"/api/demand_source_accounts", wrapper.CreateDemandSourceAccount)
 	router.DELETE(baseURL+"/api/demand_source_accounts/:id", wrapper.DeleteDemandSourceAccount)
@@ -2529,6 +3483,14 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/demand_sources/:id", wrapper.DeleteDemandSource)
 	router.GET(baseURL+"/api/demand_sources/:id", wrapper.GetDemandSource)
 	router.PATCH(baseURL+"/api/demand_sources/:id", wrapper.UpdateDemandSource)
+	router.GET(baseURL+"/api/experiments", wrapper.GetExperiments)
+	router.POST(baseURL+"/api/experiments", wrapper.CreateExperiment)
+	router.DELETE(baseURL+"/api/experiments/:id", wrapper.DeleteExperiment)
+	router.GET(baseURL+"/api/experiments/:id", wrapper.GetExperiment)
+	router.PATCH(baseURL+"/api/experiments/:id", wrapper.UpdateExperiment)
+	router.POST(baseURL+"/api/experiments/:id/conclude", wrapper.ConcludeExperiment)
+	router.POST(baseURL+"/api/experiments/:id/pause", wrapper.PauseExperiment)
+	router.POST(baseURL+"/api/experiments/:id/resume", wrapper.ResumeExperiment)
 	router.GET(baseURL+"/api/line_items", wrapper.GetLineItems)
 	router.POST(baseURL+"/api/line_items", wrapper.CreateLineItem)
 	router.POST(baseURL+"/api/line_items/import", wrapper.ImportLineItems)
@@ -2540,6 +3502,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/rest/resources", wrapper.GetResources)
 	router.GET(baseURL+"/api/segments", wrapper.GetSegments)
 	router.POST(baseURL+"/api/segments", wrapper.CreateSegment)
+	router.POST(baseURL+"/api/segments/explain", wrapper.ExplainSegments)
+	router.GET(baseURL+"/api/segments/overlaps", wrapper.GetSegmentOverlaps)
 	router.DELETE(baseURL+"/api/segments/:id", wrapper.DeleteSegment)
 	router.GET(baseURL+"/api/segments/:id", wrapper.GetSegment)
 	router.PATCH(baseURL+"/api/segments/:id", wrapper.UpdateSegment)
@@ -2555,6 +3519,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/v2/auction_configurations/:id", wrapper.DeleteAuctionConfigurationV2)
 	router.GET(baseURL+"/api/v2/auction_configurations/:id", wrapper.GetAuctionConfigurationV2)
 	router.PATCH(baseURL+"/api/v2/auction_configurations/:id", wrapper.UpdateAuctionConfigurationV2)
+	router.GET(baseURL+"/api/v2/auction_configurations/:id/floor_recommendation", wrapper.GetAuctionConfigurationV2FloorRecommendation)
 	router.GET(baseURL+"/api/v2/auction_configurations_collection", wrapper.GetAuctionConfigurationsCollectionV2)
 	router.POST(baseURL+"/auth/authorize", wrapper.AuthorizeUser)
 	router.POST(baseURL+"/auth/login", wrapper.LogIn)
@@ -2565,110 +3530,167 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9/W/cNrL/CqFX4HrArp04fQWegQLd2G6xvSQ2/JHee03gciV6lxeJUkXKjhv4f3/g",
-	"pyiJ+lpL2s31fkm8EsUZzgzngxwOv3h+HCUxQYRR7/iLl8AURoihVPyCvh9nhC0D/iNA1E9xwnBMvGPv",
-	"JxwylILVI1CNwPLUm3mYv/sjQ+mjN/MIjJB3rHu5xYE386i/QRHk/d3FaQSZd+xhwr7/zpt57DFB8ida",
-	"o9R7eprpT6/Fm3YURA/NSKgmORoKKmUpJmsJNGiFFzSCCjpBSZIWuiZJA02TZBt6BiiCJLiKs9RHzdBl",
-	"S0BF03o8ZLNb2WwbjHBwwUWuisqCAByA+A5AkCLZv8YhgWyToyCApuiPDKco8I5ZmiEbiW9SdOcde/91",
-	"mAv6oXxr/ud4C1zoaxwEnEENhFnJJoAyyDJaQxZMb1U7lxSs4jhEkCiYp+gOZiFrgmkatUINVGctUEMc",
-	"YQfEd1m0QiknOmYooiBBKUjguk7QZS8OUDaDZdv6sYn37v7Vq6ZZJLCr9H4B1wgQMZiartWoGjHPKEqb",
-	"JwlvUT83+Nv+U+KJCzNNYkKR0MFnaRqnl+oJf+DHhCEiuAeTJMQ+5Hgd/oty5L70lHzEe5dQK9NPvAOx",
-	"72dpioIDQRP1XQ6I3sJgrkf1xfsGB0IDqkcHstGBQG7mfaOR8zaMJfT4UGA9V43idH0YpPCOHR69OHox",
-	"f3mksPTKuP0k+uZiyjYIrCAhKAWQExqRLPKOf/NeL969O7v0Zt6bs8Xp2eXr88Ul59Lby7MTb+YtThcX",
-	"18v3Z97HmccwCzkDXoteFsH56l/IZzljtKzN7PEyZR/MaPmDocaqxyDHJWSL22OGGYahUHUPMA1QYGO/",
-	"CMC1NDkNaMOEoXT+CT3aqJuH47CKixHJIpCiJEUUEcZVp4IKPqFHCu7iFNzDFMcZ5XaVIPYQp5+oRQgY",
-	"RPHKm3kwgn8K3LjYx/eY/7nCQQT9DSZI/FjHMOCf+huYslUcU87JgKHP/gYSMePXMBIkjeIV5n+kMTHG",
-	"JUKMjyASkzEVxObNQnSXUfE+DmM/5vOZwZRh/heDWZrRz97MywhmjxL6/Sf1f0bWIf/wEZIAfS4yTJDg",
-	"H+ixkWcJLvFLPhiKV0kaJyhlWGoaHHTVGlnGLebMCyFlt9D3EaUouIUOi3K9QYDhCFEGowQ8bBARc3Zx",
-	"seTcBw+QAt4J16R8/hr1GECG5vxDF3nuYZghNyzdsWgCvo1J+AhSxLKUoECC91MEpRQCgh54479XYTzZ",
-	"HsVvnDI27xJc4FssVUaBb4nNs2QofsEwPL/zjn/rxiWYJHPOYeo9zb4UxpOEkHFSS+u0ySJIbpWxTaD/",
-	"Ca6R/pnbMO5xcln8+FQgRVIa91x6hBzwHQ5RgQyld7ujShGPGiIZF9vh4xZCmlJoEUAGhbgUpaNItVPR",
-	"54UiUiMJ5wFiEIcoaKKlabQvRBXkLOoXRaeuSkb1J8k+1x8/lfixVW+e8u66fsznM9cJ3Vl6qnnWzFop",
-	"eU18FS3G0feWEPeIk0ry7tb4jwkSoZuKyyGlsY8h4zoYsw1gG0x1hKnlRdjdN4is2cY7funQ+mo+9kJV",
-	"zMVqaBkEmP8JQ8AbONBDVewqyr6iFnph1r19kuIIpo9z+V2SrULs32Y9vhdfzDMV5NZJ7IUQxWajVhJO",
-	"OvfjMES+JGu9ENvtxhFlzWcRtvaY1bWqNp/sME3hI/8tvMOOXefjnYvPbMK/wQSBpYivT3LytRLebQN2",
-	"rPQdWv42QWmEKcUx6cwJvcAzx4QySHw0tzvpqaxF2w7a2q2gHRp5TBWsnKqqigIZwX9kSDizPETiOokT",
-	"3KEYVzC4r/bwOoz9TygAMLjn8ChKQRBHEBMZcp0niFxevwbf+nEUwTlFCUy5Bvy7G4J0aGsgJMk2ffqu",
-	"eMGBtQ8ZWscpRlsAyb91kJhPbG6llovXQC2q2MBk6xUPFXLiGwVTAVVWF5ZX7bSR4v08RTCAqxCJZTC9",
-	"olHD574mpuDKO3FQLboAt0OGroZHfTKI5eJBd5xqO1sdipouOECE4TssVzHVeAAm4j/RAwXfooP1wQws",
-	"kiRE/F9wxZ+D5ekM/BzH6xCBixA+mqdOwZLIZGlYxeYUp8hn4ObyDWCxRuFvVELXy6mVDnWg1W/F2lJo",
-	"7fY7kwbJj8kdXmcpLJlt1+sdGRYXKjVRmopTTbCWb76kcUbEMkySYh/dhXGctoRlEuyJDRV8e4qSFPlS",
-	"w7RR02WmG9vtEX1dAZtaxhgmQnJQVxvhnlQu2+j6RiNZ7cDEPp1ooxaMt4xhto0U3Gr/na3qJeFAUQ5a",
-	"AzFrPlV3Xvg7IF7mbksNGPTZDzOK79FbTHCURXrvLtI/Xxjgai9nACuitELVG1BIGq1hLH0p0hABg6OD",
-	"N5iKLQnVwIxedGj3V7IW9j5r9asWVkSYLGW/L6seiMtUXvJ+5ZZVS9cMRyjOXEu68kURVW5iIxyGmCI/",
-	"lgQ0bHzp3Pgtra/ODGVz0B8d1qxhxK5O+5uHBnUlTGxRV/W1t/P7IyG3uzSk90ctttTQyCZNmy7fhhRf",
-	"s73knNyhyexE3txQ7tzwBbcZwewWN6lOGHA3noHlaUEBdzeVZQ24jbld1aWAaCxNAohU2X0xtfdhHSh3",
-	"tC8jQUefGUoJDG8fMLklMY+kZHqBA6FfN4htUAr0N+ABE1D4BsAUAUR4fBvk6sHkoWzv2liJLhW0liTg",
-	"8BEF+E4uNGOqVnRlCo3v1loWWtpz6uUH7cSboWgdoS2W8CliDJO1mtR6NfzCnuzVjBAQwYRLYIGAwHTl",
-	"0P+tPoTcpq36DnaazKsjd+ZYq6XubZFq1rOd2nU/V7Sbg9K9WtR2M+D+qAcPCo33ng22u7NPnCh3Zoge",
-	"YoLmfODzEFM211lp4xCaxQyGt2aDuKQu+EuV0JdnJ2KZS+Lbo+yScqoplZPnrUz8aSBRRlhq5eGoB7tx",
-	"RxXwGie+kM0Bw2QDb49u/TjIf76SPxvXwk7UiKtEKK++FB6PtN5ij8K5+ru8OgevXn7//fwlEI3nR4A3",
-	"NjGqZiD3cGCUiCHeXPEgFX7WJv6oEBIfuXagbfJ1w+NVFzwWRUReFRB55UDkGXsLTgwIZigAV4y7Te0r",
-	"A9t5bU8V4Wo10sWcDSNxhce7mYLF3JQuEzHBMnuqadKdWtn5tXSY22k0VXro1/tAF4VKDX3y1DKdOFXN",
-	"syrk0aPPLIXdKbjIM4aa0asuQjS32yPSOlYgps+O6rDh7uCLayGjSXxamDOi+Xleoo+U2qYcJEyk18Kj",
-	"KW0oisdvNLcduvIZIXTtSkceQj+oGF+veWCq43mFaZ7HVYeqFVc/N9ptTzhrIl2LVXveBqxDwPuZt2Yp",
-	"HzUFBbtTUOx06oYMuTx7vpm+z/BZekLaWWpdJ+vvEJhWSZGndIxsiJ/jSIOBVHzs9njF0SSgYotyoMUj",
-	"Wkqdx7Pkd/p1W/K96l83r9r/Uns5BIvUAlwTebFl+nEw0kEYkMQUM3yPgCKR2YXLd8kUwuJFlZ4aXROX",
-	"O0L13fgm+UpBJ1dY7cWtcHArl0/tPScVwTfn2CuZ6OARvsEELTmtXAR0uH7Vdzsm6VQplkNl5w+6A6a5",
-	"53IWc04ZF0upR/5zMK45V8fvYEjRzIsJUiyt7JOp3S6X46c3ucC33IdaBFG8moH/FUfE2o8iWV07tsfL",
-	"iOQHMytImHlWOTOpTkku312fXV5dL6+XizfezHu/PD0792be5dmvi8vTs1MLvJUWF8ZMng6rgLwKYwZu",
-	"bsywxWG+9vHmPRrV0GHcf8bEnSr4fzFBOenVOcJ2LHR/HUAnIfRRhFzLmBf6lQT/Ggdv5dlFNwJtkARp",
-	"akmtB/kar+NFQGfg/T8WAe1I8LqhNs6GyhwIY98kGVbkT7+UWJ6YA5vOdMsIBbimq/NEhVGmDTBFLLrM",
-	"JoNkH946yZ6zV9P+LWJwBm4IZo+SBeJA6Ay8FadKf8oomoFrcYL0n+2MKQDvgCxN6sQjscTj9PpMHY7t",
-	"IBpJ3BW4tjJOBJbqpUFimcZEusIdzmJaPY/BMn341ymHtVr9xlbpTX00MTXvv8PAWmxMESFxcHlo48Lg",
-	"2p2ODdcG8hWDKVuet4NWnTX6cmfCzjtdgFL0XHrxlZ+f63A2btKM1Nxxd2KvQhqwwoHKBBWZEoUthxcH",
-	"L14WznsjH0fixH31DEftbovK+S/ssHDOi/1BV1e5o9grqEFa7qw6F92orD543vKHPaSvcOljoGjPERW0",
-	"rpsYFrozO5yv9yiPwBGn7lPiQBivMRfZPzJEre2owuORVqkiiB0nb24oSv9GgXgLYBCkiNKC1skoSn9U",
-	"Pw/8OLI1kOzTleYFKX2I06AWnmlgg4oerccGiPWs2RxqbMwHtvjH6yW5VGTvwJ+8dFCRQWOmc8iiILcs",
-	"/oQcPvsvv16DOAUUieUHIFqJEiBCjd9lqdh0gBnbIMJUNmGBuujxl83qZx+f41+WN38uX77DS7okl//t",
-	"nyy/X35K/vn+5Jf/OTg4qDto1Xtnq7xvKXVUPsIqdxTRG9hjH48zvLEeDl1PCMd8MkASpHHB0aqWnbFs",
-	"Q46YeTbZ+qgCKQ7mccM4j0n4WFoy5c/P+WOVbam5cCG/bVlJtYxYPlDzbKiBmtmvljFq6d68RmcwbGw2",
-	"1hZoiBiqzwHmbooohraBFMjGIEcqPyqiMAcac+c2YZYEsDMw2XhbYOWpLSHP9HitaX2petOx64XFmYZJ",
-	"bnjVzMnRGSiqHnWlqWzcRFMn3/hc7AiBN+3Zf4lVAthMj8vBqJ4McnBl3CXkXotptRu0n9CjDhMs2ilD",
-	"SVUZI1XHiaMiM1Nvyznwzue390feTDjJt9JtddmM52xFFHYgSvz9JCps2S0+OmuwFBa2BAAhTGbyWz2A",
-	"hw1KkaAVi5N5iO5RKAhoitPRouLgIYzwIbYTLZWcnwuWerCbDSUFvPnol03PmXcnCl3KUnz6EIeK5xrX",
-	"aa7UwKu0cGy4ld/slDpjn+hSlHFtZxX5UyHPyEVIehdWsieeSzFZT7SC0jRuXcXQ0tZ2tsd0aeUlOS2T",
-	"FuSaUq4UiEKqKNAFG3JMa04B16/j2As3Emz7eHnPkNUtp+m3MkBSSe9du25fY+zakziqQwtrGNVOZaOt",
-	"MO1+sFetCimVZYhnUOx5bHjoE/ZlKegs9oMuwjkVekWN51OjqqRaV9doJkJgxwpD+c0km/Gl3S+JQgcd",
-	"IgWI66kHSIH67i4L231QDcOmnXzUJf43CxGCZPzXbkwfh1zjFchVqEZbf0OLIbXVW2FsYxowsxzYvpj3",
-	"7LMLfLytMyMrrChk2XiLJjc3YomjssSgsb2xV0DMWiMnBLmLxSaeKUgcYQIWF0uuR1FKJYAXBy8PXigT",
-	"RWCCvWPv1cGLg1diZZJtBPUPYYIPVYajeLBGwuc182rJifAzYrJuLvVKBc6PXrzoVda8Z40/7D7r7Kh4",
-	"DkJ1xFplvVLp6Jhzxi54ZiSHxTrtolZ6FnEJ0me3Ta8zj8G1iM3Mo49c/cfUQbcTEeCaksMlyr0cvCC8",
-	"IZizJLxCeDDCyNGZbp2UeZoVRezwCxfyp+KyVJFop+J5HdG+c2yYqzxn2WFgWYFwuMFKrJoHO2uZPc+d",
-	"PPsnAj8j1kYS+1Ka377UoqSVoeOOEKUWn3dLiKx6/vQxF8hEpUHe6kKnzeqvWHB1akXYo9hpk3KESVIq",
-	"jjuwnnQBsATD8bZde5bqbUtJQJS9joPHEeZPtRh20XXk0vfkVuZlXJ9z88eBQa2l+Lp7plcZoe4QGk7x",
-	"O0C0sbph9h1+6WgXHOLQaiEqyExiLLYg0KyzBprGmDTonc5iN6Ct2YqiJQvkwiBvcqjvtOJxUgKZv6ly",
-	"40bsNO1cMem4r4t6evEXUk+SPQOrp9tiDlJnP6GQjdNPEtUVVjyub2kpr6Pr0tDcCdihcem+uQ5fiPq4",
-	"HdrJu8f4DNulD1XIK+sXYyYJULUfL3bkRpXA21lfBTlu82p34chu6bkOT+MSUTs5o+Or+R4O5zigXdra",
-	"G955rBLfltvu/mAPF3ASn88lVA0zcDpHrs1zG9pVc86u0ZyxCfyvvg7XVzg9c+epYXq6Ek6aDY2jzN/E",
-	"lqdndb1GW+SqiU2dNsbd0qKsu0GrHXLX8B1V/t1153dkquqRqc4OZwXzoc1ZTZn0Vj43z6muRrBOHFqt",
-	"ogutaezktgSb9VIykxjXFtWyA6kUBnh7Co9lpPdEa+3Yju+N7tK2fhzd1XXFxOUa7NmqSXAtD1y2thQ7",
-	"Vh3a5RfHf+2rKq2lmPstrChRKzmKw8b9XX2yIhLuFRZZkRM3bx6emEZTssbPq8B2Z0A+nkFp7lsU0GTO",
-	"qdLm7Z6YsqdjmgpDr924tAXwZfZYvBzSa83rybqYUpHxjt6oza42B1S1ncTlbB7trGX6TpK9MakQcCex",
-	"jSYjeYKTzujdunuTslR5dN3mdbE0qtoaajRkjkKZ09q0lhrAvUyds9TowHavBkbOl1N3gzaL6CqVPO5c",
-	"qikZtxtb2YBMmdvugrID29HaKrptXG6eih3tbZ0wtNleJ1aTWOKt6TXro5qmMNhtCml6keRW/Rn0Hcng",
-	"74nC2q0rsH/CovyFYfVXZxdih77DM1yFMV2EWtegn0sw6dTqMaVG9gQ6zKBRLX8T89wzZQsLv4Vpn9yk",
-	"N0txF9Uwue2eUnYqJrpl1o9vkvdVYfwbMd1larsoDKuER4NZ1VX16Ne1X9IrH1U17to3pq/VHSnTbol0",
-	"KfzX5GyYipEDOxpWv7nYvckftjkYphj/uLrCqp2/G5eihECVTbqg58CuRKH4qYs9VY1wiKMkTgV4zblK",
-	"kUN5zw8iQRJjwgCLQZaEMQwABCdX74E4DHMXp0B2hcnaEpSDD+QDOZP1iMDvfpaGv4OMwjU65i9+//33",
-	"FaSbD4S/APMMwCDChP4IkyQOEAwP/Dg61jUQwXy+ghT74MOHD2T+E5A1DH54efQK8F+mgKh+kl9x9ANn",
-	"vP7Mp/c//JgT4MCn90Cfwl7hICbzdXxgI+CmmED+AzEVKLSkL8VbW5nWi3qUhQwnMGWHouafLhKaC1tT",
-	"wWHH7YOnuuaEXolx3dtj8abLhZl2ieBGkEnCJeNhg/0yHLBCYUzWHcH59N4Ny8gapyDEhAtaCRCLlRAW",
-	"YK0wgXIpu1IOoNctWCVgMJVir3o4aC8YYapuFKrd8gE7brXpoLkcbvu7GJxIAZsJfDnRHiBVZCl58AfP",
-	"VUDIz1LMHoWTIObmImMb7/i3j9xe5upJzglgVZi9S+OI41ZWVMt6RdUtzimYmDZiGd04SXzTqp1nrb7Z",
-	"FDGNy/mYxorxoKYDkUYKZqZ2TnYbxEzuoqjgZQsXpWMWmDG5X2vm10SRzNedLeYu6b7n0VGlqnou5qqw",
-	"zoEmi5LuIu6XiGUpkdUpzxNEFhdL8MvV+TtAE+TjO0VaU0V1cbE8qPilPyOmPr1KkP9cQ1JX07RSEsnh",
-	"1ZTv78nHM6gl0R0XaGRx5obhkPsuOS9SRNlhoSBsna65RHSY7Yc+9VJps1ibZqB0s9twEu4GkZM0J0tO",
-	"VFX+rpGcV7rNlJqkUpK0lxIxwxqUwDQnhKapoU3b4sqVqTM4pvtiCqXuZmWlAL7Mm7yI66CrKnkBRwdP",
-	"ymLeMUyxmdUWpai2k8QojWOdtU3gKbRhddpOIQrcoLTQZqSwZNJpvduYZNLJreKRjpObMUzWnIH5XTCN",
-	"XLvIL3ppn+C6MdA45TVI3bmZWZpyhSDK6dtXypgRSHStEfCmjSb4hspK39PZ30xVDu1uc+UgBjW4mRq2",
-	"cQrF7zZTeyOr6Y85IdXVMzsxsjnsMic0z4Y0r+pqgjIHCpJ7KAst16bXywlh2DKynqqjz4k1L4dNsbc7",
-	"biNVNwfETavv3JdcTeJ61Axu1qiydsnuxfCMrqXBSM7FRIpst27FRPxTxrlljt4fDVX74v3RHlS/uD/a",
-	"UQEMIIY/Qg0MRdapz5PfH+1VIQyDzx7VwujI8taZ9oyKGK4591esifF83TOQytmnwhjdVdJ0tTF2ps32",
-	"sEDGrnRaU42MoXTaMJUyBDL/qZXx1dXKuD/6CstluEW/tWJGxjbinzjFfyI7bbEo7AvdZIIQp3gR9q4S",
-	"Ooq3PbuYrkgiFU/uhcxAqvaXxYJiABkEkARA3nAsb2fmAvHdCM4n4rLjQvaGGCYHM4DJPQxxAPwUBYgw",
-	"DMPhRHRJaYaKg7XFsnAHtSWDgt718ieugf4ryF3lFjAHM9WtWCBv828rTWK5TMpGRymKM9YoRufZNPtq",
-	"W/JxUKrFGWsk29PT/wcAAP//UW1JprHMAAA=",
+	"H4sIAAAAAAAC/+y9/3PbtrIo/q/gw8+ZuT0zlOw4vb1z/aYzx7HdHrVN4rGd9L7XZFSYhCWckAQLgHLU",
+	"jP/3N/hKkAS/SKYkp+f9EkckiF3sLnYXi8XiSxCRNCcZyjgLTr8EOaQwRRxR+QtGESkyPovFjxixiOKc",
+	"Y5IFp8EPOOGIgrs10I3A7CIIAyze/VEgug7CIIMpCk5NL3McB2HAoiVKoejvntAU8uA0wBn/7tsgDPg6",
+	"R+onWiAaPD6G5tNb+aYfBdlDNxK6SYmGhso4xdlCAY174cWdoOJBUPK8h6553kHTPN+GnjFKYRbfkIJG",
+	"qBu6agmYbNqOh2o2V822wQjHV0LkmqicZQDHgNwDCChS/RsccsiXJQoSKEV/FJiiODjltEAuEn+j6D44",
+	"Df7/o1LQj9Rb+1fgLXFhr3AcCwZ1EOZONQGMQ16wFrJgNtftfFJwR0iCYKZhXqB7WCS8C6Zt1As11p31",
+	"QE1wij0Q3xTpHaKC6JijlIEcUZDDRZugq148oFwGq7btY5Pv/f3rV12zSGLX6P0KLhDI5GBautaj6sS8",
+	"YIh2TxLRon1uiLebT4lHIcwsJxlDUgdfUkrotX4iHkQk4yiT3IN5nuAICryO/sUEcl82lHwkeldQG9NP",
+	"vgMkigpKUTyVNNHflYDYHMYTM6ovwd9wLDWgfjRVjaYSuTD4m0EuWHKes9MjifVENyJ0cRRTeM+PTo5P",
+	"jicvTjSWQR23H2TfQkz5EoE7mGWIAigIjbIiDU5/C16dvXlzeR2EwS+XZxeX16/enl0LLr2+vjwPwuDs",
+	"4uzqdvb+MvgYBhzzRDDglezlLH579y8U8SCsy1rojpdr+2BHKx6MNVYzBjUuKVvCHnPMMUykqnuANEax",
+	"i/1ZDG6VyelAG+Yc0QnOMJ+QFaIUx5VBeF6PNSSYJG/vg9PfhsmkF5VJTknOgsfwi6PrfzN28KMdOFH8",
+	"e6wQR/Y3yzB/a8bdR5hJjDjECYp7KGTbPSNSSSIJciHKsVIiMM+HagTRVFAHLVKtZYZ8ZZo/Pm7IiQtD",
+	"516OKAHoYYdsNBYvajRUAJnHVdFvAEW8oBmKAc7AzcXPICLZPV5MwWWa8zVIMOMgRTBjICPCqeEUR7KL",
+	"MJD2djCL9NA/oXVQ0htSCuVvPSc28H2E0oF3WtqrY5tlsbAwiAGs1K0hNcAMmK/Cho8RBsMxyClOIV1P",
+	"FCY55NHSUDzGAg2YXNU44X3eFL2G4cAoiRlIEV1IJnECNC2BECPNLxaCT2iNYuXpq9eC1GGAPsM0T5Ax",
+	"vWSFpclNUYwhJ1SYERyTzDcL7IzakDWPnbPnSs4JHzCPqDSmzie03o2BFs5DVqSAopwihjIuHGaHlAzc",
+	"EwpWkGJSMLGayhB/IPQTc8wfjFNyF4QBTOGfEjdL8TC4w3EKoyXOkPyxIDAWn0ZLSPkdIYwLjDj6HC1h",
+	"Jv28BUylIU3JHRb/oSSzS4oUcTGCVLpgVJpY0SxB9wWT70lCIhKEAeOQciz+x2FBC/Y5CIMiw3ytoK8+",
+	"6b9FtkjEh2uYxehz0NR/P6N1yTOPpc5xjV85HpNXVbU2XByLQs3QBDI+h1GEGEPxHHrWEbdLBDhOEeMw",
+	"zcHDEmVSdZxdzQT3wQNkQHQi/Oc4CEunOIYcTcSHPvKsYFIgPyzTsWwCviFZsi51sQQfUQSVFIIMPYjG",
+	"f2/CeAwrvgWuulg5rvDNM9e0kdU8yw/kEuR5i6+UJ5ALUqs1ybJIYTbXS6wcRp/gApmf5cpF2BIhix8r",
+	"Zly7CA5EFQcQgO9xgipkqL07HFWqeHQ7lKEvslEJZNUCSjHksMcNzfML2eeVJlInCX0OaHuj50JUn+up",
+	"6DRUyej+FNkn5uPHGj+26i3Qa/qNPOHHTVjqdWbbJK+Lr7t0Y0sh3shDrMi7X+OvcyQDdqolgIyRCEMu",
+	"dDDmS8CXmJm4opEXaXd/QdmCL4PTFx6tv40zK+eix0s3XiMQDTzooSZ2HoeyphY2wmxrp7i4S3A0Lzb4",
+	"Xn4xKeo+ZE1i+x3IhnCySUSSBKmVS4cQu+12I8qGz5stnjpUrWcxJb3DgV2X453Iz1zC/4IzBGYCUXBu",
+	"m/UT3m8DDqz0PVp+niOaYsYwyQZzwoT1JzhjHGYRmridbKisZdsB2tqvoD0aeZcqWDtVTRUFigz/USDp",
+	"zIolktBJguAexXgH41Wzh1cJiT6hGMB4JeAxREFMUogzteR6m6Ps+vYV+CYiaQonDOWQCg34dz+EPO+A",
+	"kOfb9BlB3tFniXUEOVoQitEWQMpvPSQWE1tYqdnZK6BD6S4w1fpOLBVK4lsF0wBVVxeOV+21kfL9hCIY",
+	"i8iJ3PwwcewWPm9qYiquvBcH3WIIcHfJMNTw6E9GsVxhwDihxs42h6KnC45RxvE9RtQZjwjCiT+yBwa+",
+	"QdPFNARneZ4g8S+4Ec/B7CIEPxKySBC4SuDaPvUKlkKmoEkTmwtMUcTBu+tfACcGhf9gCrrZRGt0aBZa",
+	"WwaE8rzffhfKIKnQVkFhzWz7Xh/IsPhQaVml6XWqXayVW+6UFJkMw+QUR+g+IYT2LMsU2HMXKvjmAuUU",
+	"RUrD9FHTZ6Y72z0j+o6wV/C4KXWNEd6QynUb3d5oV/F/u/YZFqRX24RbrmG2XSn41f4bV9UrwoGqHPQu",
+	"xJz51NxvF++AfFm6LS1g0OcoKRheodc4w2mRmoyN1Pw8tsD1Dv4IVkRrhaY3oJG0WsNa+tpKQy4YPB38",
+	"gpnciNYN7Ohlh25/NWvhZtc0v+phRYqzmer3RdMD8ZnKa9GvSlTo6ZrjFJHCF9JVL6qoChOb4iTBDEVE",
+	"EdCy8YU33acWXw0tZUvQHz3WrGPEvk43Nw8d6kqa2Kqu2tTeTlYnUm4PaUhXJz221NLIJU2fLt+GFF+z",
+	"vRScPKDJHETe0lAe3PDFc7FBNsddqhPGwo0X+aNs041wHI+1/33XlvhnsLRpf0plj7xlr3ufax04uFv9",
+	"3cR+9xgOtVU7Ggn6zBHNYDJ/wNk8I2JVphLUPAj9ukR8iSgw34AHnIHKNwBS1JllINXUPCcJjtZDRyC/",
+	"mehvnuBqOemWfWkTmAHMdIRZftFwi5pDM57cRn7ZQbyr7TIbxHdc7Mn25HTUQ2QpzIUUVwgIbFcee9Tr",
+	"06ht46Yv4yZrvjzx5y/3eg4bW8iW+LpX2z/PCHv3IvlZBdn9DFidbMCD1cnXxAbX/XpOnGhYsqf4L3W7",
+	"l2LOwJI8gIRkC2vKTUKfNDIPEHMUi+VNZ+Jfi5pqqoqudVDo10NMJt5nMV7huIBJiV9NM/nT06bgHOa5",
+	"esxT+NmrCcVzz2IUxgnObIDA0Me7xAsBTBgBDGUccAIubq4YgExCnAJzSgEzmdojbF9GuFDOQdhBouNO",
+	"zarPZBgidQlRXSLtzBXDm4jZM0kw4xOTYL+b2coJh8ncZj3UeC1e6rMJ5UELrBKkIneqDDk9Y2hUzrHX",
+	"Kputg0RFxqmTXKYfHGaNpYG3rEwrKUowyZdwfjKPSFz+fKl+dgZ4z/WIm0SohxQrj3cURHRH4d3SmN28",
+	"BS9ffPfd5AWQjScnQDS2gRfDQCcbNXh3I6YT/Gz8xJNKnOfEl1bhkm8YHi+H4HFWReRlBZGXHkSesGHm",
+	"xSCTavyGQ45Yf7hrO9f/sSFcvZ5ejGBSCpr4dZgJJyBvkfkmPus9Z3EhxlgbsyfWU3l8OCqMckpinKS0",
+	"nZy1ENzwhY0cCaiyZMcJD1tEZOYt2w1CL2jnQTgrQC6BjVaQvHVVwvH0+EUlyRhFOJVtmokDcjZ78wZa",
+	"1DJTS1H9mUVArvYhXSChjDjxnACBSVJ+5+L7m9LmP74Sk806/Bsp97pTb2avl5AS3dkFgAuKPKlwSkpD",
+	"5fDhDOA0n+ZpPhWfVfAO8lSkJsFk8sJH2qflzQ0/nmKov5OjKRQTivna60InQhSZot8SL0Rcy7SXCwwK",
+	"M5nickdWCBAZ95IfULSANE4Qk6IkRXkq013sDppstoQrVHZ4vKk/PcLxEzHGAXbO1W2OgnEeH0rnu4nF",
+	"QxzOHKvU924tWx6ob6XDxM2BbtLDvH4OdNGotNCnPBdgst6brkLl6Dv6zCkcTsGzMt27Gz2fV9HV7hmR",
+	"1uN37D+1fUC2pIcvfneiXXx6mLNDh+OJ1kZKbVcCOc6U9hXq2SyIqhUzDLc9uvIJ+w2t21SlHXzQmyq6",
+	"qWMLNaaY9aHqmMqnbg30nxboIl3P6u1p2XMeAd/MvHVL+U7daezPH3bPwnUcbyiPPnbT9wlr8w0hbTsl",
+	"SI4yyu+GfqybT8TcGGPrq5Z70uo+eCSuV9RUZQ4rXPLnbsTJQqo+9oeGZDkSufQJfF5mihiDi9bvzOu+",
+	"o5e6f9O86UDU2qshOKSW4DrJ+zlHFNtlt6KxfXYYf6GEPzTtVa0Q5pUtHvVKHK6GGWfdztdlSQUvbcTm",
+	"kdxQdrfBvK93I5oPOMsQnevBeNewb3Ntk2cXRvmIr4Tp0595pa1JgfNyqIPkxuOEel4eWpJGCXMpNsdt",
+	"p71xikAJUR7vtl8MPt6tK1s1en/HzBYdZAwvMhlQMaxlQJ71fljipIICZoAWWaYXIbqiQPkkh/rkeYnm",
+	"Rw9KA6TvvXoHoiVhKFNnzTtI4ZHDAXPT53c3dIVPCJ9ZSK9VXT2TzGhn1njrD2iN2sxjQnixFI5WKZWe",
+	"DjfavHeYqDutpsGeeNJgm4LT72Q0wfjkSL/bTbEQU8tEUs2bNS4XLoXSA44OEBQ2mIGyF76EXOoLvfUM",
+	"PiGUqxwf1pcD30h8bE9fPCvTFm1NHJfLvdu3rVmAnvNx9YwFH8ARkvdaUwfPr17vHPh4qXx+FV0eEgvN",
+	"uTGxMNJb747vJ3YP09wUCEIrpGd0pwRWQuERyTglSXUv9LtvRztj4XCgNK6kuEuQPwxcpvq15sKZkxC6",
+	"gRfEsEDzg9SGTQg3SzEpxQSk8P4eR22UBBQlkOMVEs9VoNyq3nCzRBvf2QCNntfQvq87jE2NWZG48XKU",
+	"zpXEqCwlo51yh+WYgQgmUZFAjuKGporXGUxx1LZhdg0xQ5XuNMlJhgBFEUlTlMUiQkRJCpaYcUJxpLc0",
+	"vHGhCsC52uqa3+MkmVPIUQfz9di0mq7AloixJSmSWOls0R+KbVoRE1gfT/9bTSvL9E55x4tMHNmE8TyC",
+	"0RLNJQ18B3KINBfURyj1jUBe9hED2EIUDUzR5J7QuSkM5gH42rySxu1hiaNlG99jzMxeVrkf2HOMp34o",
+	"SGGWU7QyeYGKFJtSAq1Uya4KphTlhHKVehYVjJMUlCMPffVnzdT7QfR8pSZT76SzwlKeYR3Jlx3FLzWS",
+	"3VVW10o/uWOIrlAM4oKas+ayJNZ/gRiu2ZD0L+kvDIMmA4Ci9Uhwi+gT4sNAi2zEJVI7ooyX2/XtZ+1c",
+	"Og5AJsWOKNfmv68MX31qOAlxzU3rbrVqzi/4nVZZnBexpo7z6g7hf0QcxVso0QepMSlKIc60zlQc74Bc",
+	"asg2k+F862iBKTgGRcZxAlBGioWWKkht7iKKfUB8BsLDK1crXFdne4d2wE4EBsc7qmgIcsKw9Eq07Nnj",
+	"lKX3odGfXZTYuhvwGl2bi+pJTz1MtMiCH7YtroOPZYKOe3hQz9juYmk6vDtgd1jkkYslr5eAnghc892B",
+	"SbqvWjljlVkb9Sij4Z4vgFVyym63mrgDp3A0rnnz9e9hwlAYkAxplrat+7uW/eAb4bmdiTKlIfjfstZn",
+	"f01Jp+um3Dckpayr3kDCzrNGyXNd5Hz25vby+uZ2djs7+yUIg/ezi8u3QRhcX/56dn1xeeENeLKEcFXm",
+	"s2l7EsLBu3d22LIqa/94yx6tahgw7j9J5q/58n/EgsXioAvC9mNh+hsAOk9ghMy2UG0Fbl4p8K9w/FoV",
+	"of37kMhuA5IkTSupzSBf4QU5i1kI3v98FrOBBG8baudsaMyBhETW027In3mpsDy3lXe9dXPUasDbld2+",
+	"sW2AvYNmyGyySG7CWy/ZS/Ya2ouzFCEQKeVrxQJZ2TcEr2V54B8KhkJwK0sB/08/YyrAByDL8jbxyB3x",
+	"uLi91FWOB4hGToYCN1bGi8BMv7RIzCjJ1K52PxJuz7tgmani7JXDVq3+LsPD+uhiatn/gIH12JgqQrIC",
+	"9djGhcOFPykYLizkGw4pn73tB60769ls5hT6XYDaFlbtxVdeCHVAkdO9lhbaILNeNrML9i1z6ttOGOkg",
+	"fOVUkeC8PBPn66p0FDda1CAjd841NcOorD94WiqUO6QdpUHtMotppNWeZ1XQuztpWeg/Eu99/YwOYHvW",
+	"qc/pxHVCFliI7B8FYs62b+XxbqiJUogTf6bHfzAg3wIYxxSx6gkTsQH3D/1zGpHU1UCqT9+eGmTsgdC4",
+	"FZ5t4IJK185jC8R51m0ODTb2A1f8yWKWXWuyD+BPefNXlUG7PMKsbneYc/IJeXz2n369BYQChmT4AchW",
+	"6sC3UOP3BdVRUb5EGdelXCrUReuflnc/Rvgt/mn27s/Zizd4xmbZ9X9G57PvZp/y/3l//tN/T6fTtoqZ",
+	"G2e5188wBGF1hE3uaKJ3sKeWSzreVuBr+AnVU7T1dNSn+5cILFCGKI5sXdyT6Xdmg34KblGaJzIZPIVr",
+	"WeQW4gx8+WLOmXwojo9fRp/QWv4HPT6G4mWeN18AgcOXL9qx9LxPYUQJEztAwhPWcecy18Kb2A2kQQ5l",
+	"cdZqwXVdmD2LbVEo2bSpQLI4J9i3Rpf1V/XwddkmQ7wBx4GXCMY9hR5482CbC/+fqgehvtTGtotAaCgD",
+	"KbJYei0gTvNOLKrk0DfF+Q4smNvxVNeA4T9N4QgdQQqBnLJJAlhEEVK5Nu6Nc5Id5s651q3PFY4R8WMg",
+	"X2kE2r+dpzj1bcy+nr2+lA61lCe3r1D9Okrzb9WFpebmy6HVmvvvi5qlOVVKjjlTUFBI3wDLpuBM/0+K",
+	"Pik4wPYjm4Vkvy0leS0Sz7QwD73vz4dwDtcJgbE3ZwjcY5TE5U04nMhbySAD+qtTAOMUfKNJ93chCuhz",
+	"Y5p7szXxIoOJ/6KFTsjlh6dDYbUuVJ15Lo6lcrjAsbcDY8Zq0VWBY7Pbn9HaaA75IcCZUVNSPTlZapqv",
+	"oW5YkULPMMQemJ9cuiNNNqHgNWgmM9g4OZXZR9O7Yo1ogeOSZ6F64SGl4KYgyyAqP/Zm2BuVq5WTYzK1",
+	"DXqlDGGHyXRLg1t3xnk49g2amLAgDGAWU4L9Gb6e5VSJmH22ty1FDVIWJRdryYnIbq7tMornb8VjXdnN",
+	"cOFKfduz+eis+8qB2mdjDdQ6zDry30r37m0ti2Fns12dIEwQR+17/mKOipkHlpAB1RiUSJVlcjXmwGDu",
+	"tYBFHsPBwFTjbYHVvWEFOTTjdab1te7NhHuvHM50THLLq25O7pyB8sa3oTRVjbto6uWbmIsDIYimG/Zf",
+	"Y5UEFppxeRi1IYM8XNntrutG+0+t5xs/KfNco51eWzJdyEbfYdd2RKrt+Xx1EoQyrjRXTqTPZjxl9979",
+	"ts5fdcWo2+Jjr4N6IwFIYbKT3+lBnEehSPsT+SRBK5RIAtqLOVlVcWQwRdNga9FyC9lIwdIPDpODoYF3",
+	"n6Vz6RkG9/Jqd3UNqcm5HHLF9I0eeJMWnhyV+puDUmfX1aw1ZXwZIIYO6HOeQF8osqXB873eobZ6vHBv",
+	"o3lYEoaAHpIubCzCATqfe0Cmo4CzQtQcxizDaS+mJ9OX7fWMfBdN2RJGsoHag9HIMtmtWZI068x5IiAr",
+	"HKE5YVWs8Nublk0cz562IotYEfrRCOXaUTS5W4ulZAWtLx8CuEAfgtOT/ww/BJjNc7jG2eKDcpEffViw",
+	"+JOflsfT/5oee79Qa/p5kcsTjJ4hyPdAvfdUE+45nNC4rKA5iS7VPBgQO66qvsaEembnAiuE9Neosk+s",
+	"eKih9Mf0hpeO0l32VY8yNqLR4Q/qhU78tcdaSkxbEp5bO3yj402634ikdzgrY8CiD8iJyrcGC0qKXLf1",
+	"BMHaE6DbNzXdXUzVcQiMyEqfQyX7zxWxQWk8G0wwqPrh2IGoorGZA/AUnL25OHp7LcHJIbL/Bb7/PgT/",
+	"3/chUMEM89c8iMzf70Pw6vL218vLNyGYvQnBm7e38u/rs9vzf17eiPjIze3Z9e0N+HV2+08Qoxxl8pgd",
+	"cTEAehdz0ImLrmwAMyA5itCo3rBKxBAsYIrm0lMLAVygEKhysjgTxpbNYSq+C4HVcqHYC4gFa6weDoGj",
+	"3mSc3fxwtm2MIusdmYpZV3Y/m4NUjTwMHEq5lutcNPGt/Gx4OczYp4Xrs2KwFhp1h97rujYc1lJTNS1J",
+	"79Y7K+T+mGf7sf5mL5m6tdQ4hcIAla7kRgi+OImvv7svkv7VtoHh0k49GrI5aHcpJcnEr8M4+QJyy/pH",
+	"bVF3rmresWrw0OmtMrZd+hM2V6B/p//JxXzFeHtnRlGJnRbF7sLDItvaF0w12L6bXTTJIMaDs3u5F2da",
+	"nsUpzkRhqCAMrNMbHE9fTI9NESWY4+A0eDk9lguJHPKlpP4RzPGR3leeY5FbaM4Fy9d6P8POspkgyY+I",
+	"n6kvZhnm9ly+3MlSM0d+e3J8HMgqQ/IKVu06JjpbQJJMPDO0/LLdUXOB8sSg3JGG8+i5ZySx9xPJvoDo",
+	"C5TDl1/Y2198SNnhHslqRFZvCGCsSIUEmlt5WmDIvS8Z0zrzNxBbgjlhHi6cyzChhxGB0nOI8VckXm/E",
+	"gq0prwhcah+5LGvIw4t9IlPjduZnwWhcVuxoATKAzY9h11w8+oLjx+quRVUaLuTzNmmocOFb3y6+Byu9",
+	"6RE7VjVZj0YvhfD29Ao30U1PVU1jaKQDyOSPiD+FwPaYBmt1R8omRzi+Ej+kf5FDHi2bzHkn96Keicoy",
+	"XtMQxXX8F1ZciikjKC5VDrHbbcjxz2i9Z08hx/6CNF0uga6xObITYHt1yGoe9Rp6SbpgHzbVEMwrjBrh",
+	"se2m6dZLmbqIHX0pimH2sIVoPhOowO3F6HUONuyZPXuxZXsVAWGmekhSM0VtKJkVFc5kLjdfmquC7dqq",
+	"qunDDelSqGDNx1Igc33Qeq4TXHvUX64qxF6ZxvtVhOL6BgF+opHddr3USOsde7HkAeAIhudtv/asUn7X",
+	"DkeD1BsskOq4XjsRuk2xnFrUmihVohotnkeD1MHj2AumJog+VnfMvqHrJJ849FoITzb7PlZImxMoHKyB",
+	"9mNMOvTOYLEbc0m0DUV3tRg6tGLaeBn0b6KezLJoVPU0r55yHOwnVM77bSaJInw/i+XmQE9LmOcDG6pT",
+	"PsMax05J/GFf5HCBhrRLcIrVdsYhfajKydXN1ph5DhSbwdWB3KgaePdcaUWO+7zaQziyW3qu49O4RtRB",
+	"zuju1fzBIvJ57uWB1NbB+M5jk/iu3A73BzdwAffi8/mEqmMG7s+R6/PcxnbVvLNrZ87YHvyvw8ad9zI9",
+	"S+epY3r68vO7DY364rz6wV4tj/fG/S1tka+mKPPaGH9Lh7L+Br12yEPPXcu/j4AHM1XtyDRnh4/Eo5sz",
+	"L5ABfO6eU0ONYJs49FpFH1r7sZPbEizcSMnsxbj2qJYDSKU0wNtTeFdG+plorQPb8Weju4yt343uGhox",
+	"8bkGzyxqEt+q40S9LeWO1YB2mOl7E776qIpPmJ8QWNGiVnMUx133D/XJqkj4IyyVW+nbRPzcNtona8wB",
+	"s40YUI5nVJpHDgUMmUuq9Hm753okuzUVll6HcWkr4OvscXg5ptcaWcL6mNKQ8YHeqMuuPgdUt92Ly9k9",
+	"2rBn+u4le2OvQiCcxD6a7MgT3OuMPqy7t1eWao9u2LyOEUw67daFbLBPmyVQ2jIoo0YzqtWK9fgNERU9",
+	"+qyVaLVjwRaIHcpOlbDrnFBvxrVQsSJmnQMVER5olixf+mySaLgXg9QyuLBzQu7DDNWm4c6ZLSxRKzF2",
+	"ZIL2NE0Pa3z2NFm12emdrDKjQlWtmetUhB4DVGYgnJn2+7VHnqt4tjZQnnqro1ssLwyXJ94G/TatwYid",
+	"zx3vJUiHsnmtyDTnlYfA41tFH5ABXO6eioMNqV8Y+u2qB6s9Gdot6RVuopr2Y5m7FdL+RVLZ7q3puzPr",
+	"/iwU1qGt/3MTFusojKm/BrsQB/QdnuAq7NJFaHUNNnMJ9jq1NphSO/YEBsygnVr+Lub5Z8oWFn4L0753",
+	"k94txUNUw95t9z5lp2Gie2b97k3yc1UYfyGm+0ztEIUhLrumWBbC7LKrl06zfVrVEr0tl+Hu+EY1rKhC",
+	"EUNnl06uSa1tg0ntzgAEtMgyUeeu7G0K/FlaEcwAh58QyCHlAGcAAobFJZi2D0JBDmXZt7I3ADmAgGNV",
+	"S9hn2EuMdzxLS6QOZc29wuRNxHFxHdeiI5fcfqnxTM2BhrzGyz4zXjbfiw0fMPRwgALah+0+qKQI+z2I",
+	"ViNY7tqVTdKIMF1XkYIVpBhmXN73A92xAr6EsjprRrjQU1FSxChuqhjV4QFUzGG9gIOKj/YEnqBojgxH",
+	"BSJPEDKv7fsBZ5gtEasYKXmvVURorIrgP2Bl0bT8TcGv1QdG8pzyvt40LwALTlLIcQSTZO2xgHqchxBQ",
+	"RWOmk0H/8lJpSP0kuZTuzS6E8oaTnAHIGF5IOSsYokyIldGAbV6ZvBWKrlAMIBM/HpY4ccconimnrCl9",
+	"V+L5v5Fdk+N9Ev8pYkW6EwG4lj0Lj7zhQk/BOykNkCItIU5BcWEorZWEDNyhe6Kv+ZAdNbmuIP0bsV0N",
+	"eBO+O7e+dCxJzd3F7OvKGd/oTL5uPLRvzMTNb7L47F7Twodcr9y1SLf3co+8Rnf6LWXul/JhX9DbiNiO",
+	"vQJLvkMtjGsINNmkKTb2YrhyxbyPPU2NcITTnFAJ3q/J3zGhfuXFEeqaRKGrizwhMAYQnN+8B7Ig0L26",
+	"kpFQLux9KSjTD9mH7FLdQQJ+jwqa/A4KBhfoVLz4/fff7yBbfsjECzApAIxTnLF/wDwnMYKJuAr71Nw0",
+	"DSaTO8hwBD58+JBNfgCqGPz3L05eAvHLXtNunmA2v1PT93vBePNZxFbf/6MkwDRiK2DKWd/hmGSTBZm6",
+	"CPgpJpH/kDXs0Uy+dZVpu6inRcJxDik/ktdEmjtHS2FrXF2th+gv3+/cyqNaCvtKIgy5ub64cmk/mw6/",
+	"nGcAyDwXkvGwxFEdDrhDCckWA8FFbOWHZWVNX/ssBK0GiBMthBVYdziDdF0Cc+qqWxHpqrj/4Nx65wCD",
+	"VIm97mHaX3m/vAin5KQacLNY/RDN5YlBvSHgXAlYCLgmmrggQJGlFpGaPlUBoaigmK+lkyDn5lnBl8Hp",
+	"bx8fP7rqSc0J4Nzjf09JKnCrK6pZu6IaFrKrmJg+YlnduJd4Xa92Dnt9s304tz7nYz9WTATqBhBpRxts",
+	"+3ZODhtS27uLosNoW7goA0/CWpP7tZ5+3dNK5us+MWvldvtTsvtfHSmb5z0Bq28omf6LVaS7Hk3hBc1U",
+	"LFdcSS6K/v508/YNYDmK8L0mrb149+xq1oyT/Ii4/vQmR9FTDUnbNbhtV7K5Xk11bO54RrUkpuMKjRzO",
+	"vOM4Eb5LyQuKGD+q3CHcpmuubaM9qOoSo06xts1A5SreMSXcD6IkaUmWkqjmWtAuct6YNvvUJI1bbDdS",
+	"InZYoxKYlYQwNLW06Quu3NgL23bpvti7dQ8TWamAr/PGvhw3qsIsYT08qYv5kb7Rtz2gcqmvxmUArRBd",
+	"m+7ddTRcQJwxDmDtqli5sceW5IHpdbb5Vjz379hhNWuTFWKAk6ZZ0BevVmbgzqWncS/yDpzgWujEe1f6",
+	"6ZcgK5JE3C6ogNavaFNhjwFBC31zWv3GMs9la/pmZFPyre3iVKmS5vqiSQ+ejX5TsbZBLhbOxa7u7eUb",
+	"zbKw/5JVDdi9IX3L67o/hrVhPg5xIMwFyxIPnfuVwGzcWj96mgBWAzZQKYhrYxJYqSZbj0QwzsortN2Y",
+	"msgMiWAGMqEuFGBwhyK5+QctQjK8t8QLEafKKSYiLKMaW0VjlAhfits+iNdF1IN4a/BtLKCEZgv+KJCM",
+	"p+kbJmxYq/2Oid4pNN4qojqRtpX8MGBLGJMHFM/v1juYN52+hYa8Kyej0f0wIR4WgHPdkL74m5m6+4i+",
+	"dVrxsM813Yef33RI9+HkiKVSD212FHDbq8N62GjbXt1WHWkb6LZysWHHjswmm0oG6eDalWk4ZIKbxsDg",
+	"ZCe4H+eooFQohIIJM1ZCKkeg0HVGIJp2Li5ltsleV5aFvlx4uMZXgxhVyxd62DbcIX/3LSJFqx1PSEWd",
+	"wywfS9h1ThiejblwLBQx6xyoSO5RirqE91xNCMuWHeupNvqcO/Ny3AJabsd9pBrmgPhp9a03s4HuxfVo",
+	"GVzYqbIOye6z8RndSoMdORd7UmSHdSv2xD9tnHvm6OpkrMr270+eQW371cmBytuD9ycOlUescP/+ZMcT",
+	"oo2Qz6rMvcXnGVW6H8jy3pn2hHr3708G2cy/fMX7p+uekVTOcyp7P1wl7a/y/cG02TMsf38ondZVAX9U",
+	"nXZ0nxBC5xRFJE1RFsNaMpA/XSKnOEJAfgrspyi2eRJ+vHEmX+oisHJrTfy+w7HuMMaMU3xXmP01zECM",
+	"KBaHlu4pSb1xdb8E/yBQu64Oqu/K5yRfwsmJRS8i8rJ6X1g+qlQJ9sfl6xtWH/cgwpIhkxovvS6Vl4Hj",
+	"67x4ncEUR13gdqr9eufAOHdByAn5/26D+Opug1idfIUXQvjVf++dEAVfyn8IxX8iN4eiKuxnpskelvkJ",
+	"Wew2U2ETJCwbmkzXJFFGrPTEQ0C1OZRB9RhyqNJFZAPAySckherbXRQIEbLjQ/ZdZpkchwBnK5jgGEQU",
+	"xSjjeMxK5DPGClQdrCuWfCkARrAug5Le7fL3C1nMsn8HudNy1Cl5N6oNKNv8ZaVJhoyVbAyUIlLwTjF6",
+	"W+xnb3lLPo5KNVLwTrI9Pv7fAQBH/GjxtCMBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
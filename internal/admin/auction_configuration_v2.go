@@ -1,7 +1,12 @@
 package admin
 
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out auction_configuration_v2_mocks_test.go . AuctionConfigurationV2Repo
+
 import (
 	"context"
+	"fmt"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
 
 	"github.com/bidon-io/bidon-backend/internal/ad"
 	"github.com/bidon-io/bidon-backend/internal/adapter"
@@ -25,19 +30,36 @@ type AuctionConfigurationV2 struct {
 
 // AuctionConfigurationV2Attrs is attributes of Configuration. Used to create and update configurations
 type AuctionConfigurationV2Attrs struct {
-	Name                     string         `json:"name"`
-	AppID                    int64          `json:"app_id"`
-	AdType                   ad.Type        `json:"ad_type"`
-	AuctionKey               string         `json:"auction_key"`
-	Pricefloor               float64        `json:"pricefloor"`
-	SegmentID                *int64         `json:"segment_id"`
-	IsDefault                *bool          `json:"is_default"`
-	ExternalWinNotifications *bool          `json:"external_win_notifications"`
-	Demands                  []adapter.Key  `json:"demands"`
-	Bidding                  []adapter.Key  `json:"bidding"`
-	AdUnitIDs                []int64        `json:"ad_unit_ids"`
-	Timeout                  int32          `json:"timeout"`
-	Settings                 map[string]any `json:"settings"`
+	Name                     string           `json:"name"`
+	AppID                    int64            `json:"app_id"`
+	AdType                   ad.Type          `json:"ad_type"`
+	AuctionKey               string           `json:"auction_key"`
+	Pricefloor               float64          `json:"pricefloor"`
+	SegmentID                *int64           `json:"segment_id"`
+	IsDefault                *bool            `json:"is_default"`
+	ExternalWinNotifications *bool            `json:"external_win_notifications"`
+	Demands                  []adapter.Key    `json:"demands"`
+	Bidding                  []adapter.Key    `json:"bidding"`
+	AdUnitIDs                []int64          `json:"ad_unit_ids"`
+	Timeout                  int32            `json:"timeout"`
+	Settings                 map[string]any   `json:"settings"`
+	FloorPolicy              *FloorPolicy     `json:"floor_policy"`
+	BiddingTimeouts          *BiddingTimeouts `json:"bidding_timeouts"`
+}
+
+// FloorPolicy controls how auction price floor is calculated for configuration.
+type FloorPolicy struct {
+	IgnoreFloorForMediators    []string `json:"ignore_floor_for_mediators"`
+	IgnorePrevAuctionPrice     bool     `json:"ignore_prev_auction_price"`
+	IgnoreAdCachePrices        bool     `json:"ignore_ad_cache_prices"`
+	DynamicFloor               bool     `json:"dynamic_floor"`
+	DynamicFloorTargetFillRate float64  `json:"dynamic_floor_target_fill_rate"`
+}
+
+// BiddingTimeouts limits how long bidding adapters are waited for, in milliseconds.
+type BiddingTimeouts struct {
+	TMax     int32                 `json:"tmax"`
+	Adapters map[adapter.Key]int32 `json:"adapters"`
 }
 
 type AuctionConfigurationV2Service struct {
@@ -61,6 +83,13 @@ func NewAuctionConfigurationV2Service(store Store) *AuctionConfigurationV2Servic
 		}
 	}
 
+	s.getValidator = func(attrs *AuctionConfigurationV2Attrs) v8n.ValidatableWithContext {
+		return &auctionConfigurationV2AttrsValidator{
+			attrs:            attrs,
+			demandSourceRepo: store.DemandSources(),
+		}
+	}
+
 	return s
 }
 
@@ -155,3 +184,53 @@ func (p *auctionConfigurationV2Policy) instancePermissions(_ AuthContext, _ *Auc
 		Delete: true,
 	}
 }
+
+type auctionConfigurationV2AttrsValidator struct {
+	attrs *AuctionConfigurationV2Attrs
+
+	demandSourceRepo DemandSourceRepo
+}
+
+func (v *auctionConfigurationV2AttrsValidator) ValidateWithContext(ctx context.Context) error {
+	keys, err := demandSourceKeys(ctx, v.demandSourceRepo)
+	if err != nil {
+		return v8n.NewInternalError(err)
+	}
+
+	return v8n.ValidateStructWithContext(ctx, v.attrs,
+		v8n.Field(&v.attrs.FloorPolicy),
+		v8n.Field(&v.attrs.BiddingTimeouts, v8n.By(func(value any) error {
+			timeouts, _ := value.(*BiddingTimeouts)
+			if timeouts == nil {
+				return nil
+			}
+
+			return timeouts.validate(keys)
+		})),
+	)
+}
+
+func (p FloorPolicy) Validate() error {
+	return v8n.ValidateStruct(&p,
+		v8n.Field(&p.IgnoreFloorForMediators, v8n.Each(v8n.Required)),
+		v8n.Field(&p.DynamicFloorTargetFillRate, v8n.Min(0.0), v8n.Max(1.0)),
+	)
+}
+
+func (t BiddingTimeouts) validate(keys map[adapter.Key]bool) error {
+	return v8n.ValidateStruct(&t,
+		v8n.Field(&t.TMax, v8n.Min(int32(0))),
+		v8n.Field(&t.Adapters, v8n.By(func(value any) error {
+			adapters, _ := value.(map[adapter.Key]int32)
+			for key, timeout := range adapters {
+				if err := isDemandSourceKey(keys)(key); err != nil {
+					return err
+				}
+				if timeout <= 0 {
+					return fmt.Errorf("timeout of %s must be positive", key)
+				}
+			}
+			return nil
+		})),
+	)
+}
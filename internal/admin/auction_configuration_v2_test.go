@@ -0,0 +1,98 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+)
+
+func Test_auctionConfigurationV2AttrsValidator_ValidateWithContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   *AuctionConfigurationV2Attrs
+		wantErr bool
+	}{
+		{
+			"valid floor policy",
+			&AuctionConfigurationV2Attrs{
+				FloorPolicy: &FloorPolicy{
+					IgnoreFloorForMediators: []string{"max", "level_play"},
+					IgnoreAdCachePrices:     true,
+				},
+			},
+			false,
+		},
+		{
+			"without floor policy",
+			&AuctionConfigurationV2Attrs{},
+			false,
+		},
+		{
+			"empty mediator",
+			&AuctionConfigurationV2Attrs{
+				FloorPolicy: &FloorPolicy{IgnoreFloorForMediators: []string{"max", ""}},
+			},
+			true,
+		},
+		{
+			"valid bidding timeouts",
+			&AuctionConfigurationV2Attrs{
+				BiddingTimeouts: &BiddingTimeouts{
+					TMax:     1500,
+					Adapters: map[adapter.Key]int32{adapter.MetaKey: 800},
+				},
+			},
+			false,
+		},
+		{
+			"negative tmax",
+			&AuctionConfigurationV2Attrs{
+				BiddingTimeouts: &BiddingTimeouts{TMax: -1},
+			},
+			true,
+		},
+		{
+			"adapter timeout of unknown adapter",
+			&AuctionConfigurationV2Attrs{
+				BiddingTimeouts: &BiddingTimeouts{Adapters: map[adapter.Key]int32{"unknown": 800}},
+			},
+			true,
+		},
+		{
+			"adapter timeout of generic OpenRTB demand source",
+			&AuctionConfigurationV2Attrs{
+				BiddingTimeouts: &BiddingTimeouts{Adapters: map[adapter.Key]int32{"acme": 800}},
+			},
+			false,
+		},
+		{
+			"adapter timeout of adapter without demand source",
+			&AuctionConfigurationV2Attrs{
+				BiddingTimeouts: &BiddingTimeouts{Adapters: map[adapter.Key]int32{adapter.BidmachineKey: 800}},
+			},
+			true,
+		},
+		{
+			"zero adapter timeout",
+			&AuctionConfigurationV2Attrs{
+				BiddingTimeouts: &BiddingTimeouts{Adapters: map[adapter.Key]int32{adapter.MetaKey: 0}},
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &auctionConfigurationV2AttrsValidator{
+				attrs:            tt.attrs,
+				demandSourceRepo: newDemandSourceRepoMock(adapter.MetaKey, "acme"),
+			}
+
+			err := v.ValidateWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWithContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
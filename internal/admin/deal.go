@@ -0,0 +1,230 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/shopspring/decimal"
+)
+
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out deal_mocks_test.go . DealRepo
+
+const DealResourceKey = "deal"
+
+type DealResource struct {
+	*Deal
+	Permissions ResourceInstancePermissions `json:"_permissions"`
+}
+
+// Deal is a private marketplace deal of an app with a demand source. Deals matching segment and country of the auction
+// are offered to the demand source bidder in imp.pmp.deals, and bids made for them are ranked by deal priority first.
+type Deal struct {
+	ID int64 `json:"id"`
+	DealAttrs
+	App          App          `json:"app"`
+	DemandSource DemandSource `json:"demand_source"`
+	Segment      *Segment     `json:"segment"`
+}
+
+type DealAttrs struct {
+	AppID          int64 `json:"app_id"`
+	DemandSourceID int64 `json:"demand_source_id"`
+	// DealID is the deal ID agreed with the demand source.
+	DealID   string           `json:"deal_id"`
+	BidFloor *decimal.Decimal `json:"bid_floor"`
+	Priority int32            `json:"priority"`
+	// SegmentID targets the deal to a segment. Empty value means all segments.
+	SegmentID *int64 `json:"segment_id"`
+	// Countries target the deal to alpha-2 country codes. Empty list means all countries.
+	Countries []string `json:"countries"`
+	Enabled   *bool    `json:"enabled"`
+}
+
+type DealService struct {
+	*ResourceService[DealResource, Deal, DealAttrs]
+}
+
+func NewDealService(store Store) *DealService {
+	s := &DealService{
+		ResourceService: &ResourceService[DealResource, Deal, DealAttrs]{},
+	}
+
+	s.resourceKey = DealResourceKey
+
+	s.repo = store.Deals()
+	s.policy = newDealPolicy(store)
+
+	s.prepareResource = func(authCtx AuthContext, deal *Deal) DealResource {
+		return DealResource{
+			Deal:        deal,
+			Permissions: s.policy.instancePermissions(authCtx, deal),
+		}
+	}
+
+	s.getValidator = func(attrs *DealAttrs) v8n.ValidatableWithContext {
+		return &dealAttrsValidator{
+			attrs: attrs,
+		}
+	}
+
+	return s
+}
+
+// Create checks that attributes identifying the deal are present. The rest is handled by ResourceService.
+func (s *DealService) Create(ctx context.Context, authCtx AuthContext, attrs *DealAttrs) (*Deal, error) {
+	err := v8n.ValidateStruct(attrs,
+		v8n.Field(&attrs.AppID, v8n.Required),
+		v8n.Field(&attrs.DemandSourceID, v8n.Required),
+		v8n.Field(&attrs.DealID, v8n.Required),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ResourceService.Create(ctx, authCtx, attrs)
+}
+
+type DealRepo interface {
+	AllResourceQuerier[Deal]
+	OwnedResourceQuerier[Deal]
+	ResourceManipulator[Deal, DealAttrs]
+}
+
+type dealPolicy struct {
+	repo DealRepo
+
+	appPolicy          *appPolicy
+	demandSourcePolicy *demandSourcePolicy
+	segmentPolicy      *segmentPolicy
+}
+
+func newDealPolicy(store Store) *dealPolicy {
+	return &dealPolicy{
+		repo: store.Deals(),
+
+		appPolicy:          newAppPolicy(store),
+		demandSourcePolicy: newDemandSourcePolicy(store),
+		segmentPolicy:      newSegmentPolicy(store),
+	}
+}
+
+func (p *dealPolicy) getReadScope(authCtx AuthContext) resourceScope[Deal] {
+	return &ownedResourceScope[Deal]{
+		repo:    p.repo,
+		authCtx: authCtx,
+	}
+}
+
+func (p *dealPolicy) getManageScope(authCtx AuthContext) resourceScope[Deal] {
+	return &ownedResourceScope[Deal]{
+		repo:    p.repo,
+		authCtx: authCtx,
+	}
+}
+
+func (p *dealPolicy) authorizeCreate(ctx context.Context, authCtx AuthContext, attrs *DealAttrs) error {
+	// Check if user can manage the app.
+	_, err := p.appPolicy.getManageScope(authCtx).find(ctx, attrs.AppID)
+	if err != nil {
+		return err
+	}
+
+	// Check if user can read the demand source.
+	_, err = p.demandSourcePolicy.getReadScope(authCtx).find(ctx, attrs.DemandSourceID)
+	if err != nil {
+		return err
+	}
+
+	if attrs.SegmentID != nil {
+		// Check if user can read the segment and it targets the deal app.
+		return p.authorizeSegment(ctx, authCtx, *attrs.SegmentID, attrs.AppID)
+	}
+
+	return nil
+}
+
+func (p *dealPolicy) authorizeUpdate(ctx context.Context, authCtx AuthContext, deal *Deal, attrs *DealAttrs) error {
+	// If user tries to change the app and app is not the same as before, check if user can manage the new app.
+	if attrs.AppID != 0 && attrs.AppID != deal.AppID {
+		_, err := p.appPolicy.getManageScope(authCtx).find(ctx, attrs.AppID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// If user tries to change the demand source and demand source is not the same as before, check if user can read the new demand source.
+	if attrs.DemandSourceID != 0 && attrs.DemandSourceID != deal.DemandSourceID {
+		_, err := p.demandSourcePolicy.getReadScope(authCtx).find(ctx, attrs.DemandSourceID)
+		if err != nil {
+			return err
+		}
+	}
+
+	appID, segmentID := deal.AppID, deal.SegmentID
+	if attrs.AppID != 0 {
+		appID = attrs.AppID
+	}
+	if attrs.SegmentID != nil {
+		segmentID = attrs.SegmentID
+	}
+
+	// If user tries to change the segment or the app, check if user can read the segment and it targets the deal app.
+	segmentChanged := attrs.SegmentID != nil && (deal.SegmentID == nil || *attrs.SegmentID != *deal.SegmentID)
+	if segmentID != nil && (segmentChanged || appID != deal.AppID) {
+		return p.authorizeSegment(ctx, authCtx, *segmentID, appID)
+	}
+
+	return nil
+}
+
+func (p *dealPolicy) authorizeSegment(ctx context.Context, authCtx AuthContext, segmentID, appID int64) error {
+	segment, err := p.segmentPolicy.getReadScope(authCtx).find(ctx, segmentID)
+	if err != nil {
+		return err
+	}
+
+	if segment.AppID != appID {
+		return v8n.Errors{"segment_id": errors.New("must be a segment of the app")}
+	}
+
+	return nil
+}
+
+func (p *dealPolicy) authorizeDelete(_ context.Context, _ AuthContext, _ *Deal) error {
+	return nil
+}
+
+func (p *dealPolicy) permissions(_ AuthContext) ResourcePermissions {
+	return ResourcePermissions{
+		Read:   true,
+		Create: true,
+	}
+}
+
+func (p *dealPolicy) instancePermissions(_ AuthContext, _ *Deal) ResourceInstancePermissions {
+	return ResourceInstancePermissions{
+		Update: true,
+		Delete: true,
+	}
+}
+
+type dealAttrsValidator struct {
+	attrs *DealAttrs
+}
+
+func (v *dealAttrsValidator) ValidateWithContext(ctx context.Context) error {
+	return v8n.ValidateStructWithContext(ctx, v.attrs,
+		v8n.Field(&v.attrs.BidFloor, v8n.By(func(value any) error {
+			bidFloor, _ := value.(*decimal.Decimal)
+			if bidFloor != nil && bidFloor.IsNegative() {
+				return errors.New("must not be negative")
+			}
+
+			return nil
+		})),
+		v8n.Field(&v.attrs.Priority, v8n.Min(int32(0))),
+		v8n.Field(&v.attrs.Countries, v8n.Each(v8n.Required, is.CountryCode2)),
+	)
+}
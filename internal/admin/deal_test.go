@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/shopspring/decimal"
+)
+
+func Test_dealAttrsValidator_ValidateWithContext(t *testing.T) {
+	bidFloor := decimal.RequireFromString("1.5")
+	negativeBidFloor := decimal.RequireFromString("-0.1")
+
+	tests := []struct {
+		name    string
+		attrs   *DealAttrs
+		wantErr bool
+	}{
+		{
+			"valid deal",
+			&DealAttrs{
+				DealID:    "pmp-1",
+				BidFloor:  &bidFloor,
+				Priority:  10,
+				Countries: []string{"US", "GB"},
+			},
+			false,
+		},
+		{
+			"empty deal",
+			&DealAttrs{},
+			false,
+		},
+		{
+			"negative bid floor",
+			&DealAttrs{BidFloor: &negativeBidFloor},
+			true,
+		},
+		{
+			"negative priority",
+			&DealAttrs{Priority: -1},
+			true,
+		},
+		{
+			"invalid country code",
+			&DealAttrs{Countries: []string{"USA"}},
+			true,
+		},
+		{
+			"empty country code",
+			&DealAttrs{Countries: []string{""}},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &dealAttrsValidator{attrs: tt.attrs}
+
+			err := v.ValidateWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWithContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func newDealStoreMock(deal *Deal) *StoreMock {
+	apps := map[int64]*App{1: {ID: 1}, 2: {ID: 2}}
+	segments := map[int64]*Segment{
+		10: {ID: 10, SegmentAttrs: SegmentAttrs{AppID: 1}},
+		20: {ID: 20, SegmentAttrs: SegmentAttrs{AppID: 2}},
+	}
+
+	return &StoreMock{
+		AppsFunc: func() AppRepo {
+			return &AppRepoMock{
+				FindFunc: func(_ context.Context, id int64) (*App, error) {
+					if app, ok := apps[id]; ok {
+						return app, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+		UsersFunc: func() UserRepo {
+			return &UserRepoMock{}
+		},
+		DemandSourcesFunc: func() DemandSourceRepo {
+			return &DemandSourceRepoMock{
+				FindFunc: func(_ context.Context, id int64) (*DemandSource, error) {
+					return &DemandSource{ID: id}, nil
+				},
+			}
+		},
+		SegmentsFunc: func() SegmentRepo {
+			return &SegmentRepoMock{
+				FindFunc: func(_ context.Context, id int64) (*Segment, error) {
+					if segment, ok := segments[id]; ok {
+						return segment, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+		DealsFunc: func() DealRepo {
+			return &DealRepoMock{
+				FindFunc: func(_ context.Context, id int64) (*Deal, error) {
+					return deal, nil
+				},
+				CreateFunc: func(_ context.Context, attrs *DealAttrs) (*Deal, error) {
+					return &Deal{ID: 1, DealAttrs: *attrs}, nil
+				},
+				UpdateFunc: func(_ context.Context, id int64, attrs *DealAttrs) (*Deal, error) {
+					return &Deal{ID: id, DealAttrs: *attrs}, nil
+				},
+			}
+		},
+	}
+}
+
+func TestDealService_Create(t *testing.T) {
+	authCtx := &AuthContextMock{
+		IsAdminFunc: func() bool {
+			return true
+		},
+	}
+	segmentID := func(id int64) *int64 {
+		return &id
+	}
+
+	tests := []struct {
+		name    string
+		attrs   DealAttrs
+		wantV8n bool
+	}{
+		{
+			name:  "valid deal",
+			attrs: DealAttrs{AppID: 1, DemandSourceID: 3, DealID: "pmp-1", SegmentID: segmentID(10)},
+		},
+		{
+			name:    "missing deal id",
+			attrs:   DealAttrs{AppID: 1, DemandSourceID: 3},
+			wantV8n: true,
+		},
+		{
+			name:    "missing demand source",
+			attrs:   DealAttrs{AppID: 1, DealID: "pmp-1"},
+			wantV8n: true,
+		},
+		{
+			name:    "segment of another app",
+			attrs:   DealAttrs{AppID: 1, DemandSourceID: 3, DealID: "pmp-1", SegmentID: segmentID(20)},
+			wantV8n: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewDealService(newDealStoreMock(nil))
+
+			_, err := service.Create(context.Background(), authCtx, &tt.attrs)
+
+			var validationErr v8n.Errors
+			if isV8n := errors.As(err, &validationErr); isV8n != tt.wantV8n || (err != nil && !isV8n) {
+				t.Errorf("Create() error = %v, want validation error: %v", err, tt.wantV8n)
+			}
+		})
+	}
+}
+
+func TestDealService_Update(t *testing.T) {
+	authCtx := &AuthContextMock{
+		IsAdminFunc: func() bool {
+			return true
+		},
+	}
+	segmentID := func(id int64) *int64 {
+		return &id
+	}
+	deal := &Deal{ID: 1, DealAttrs: DealAttrs{AppID: 1, DemandSourceID: 3, DealID: "pmp-1", SegmentID: segmentID(10)}}
+
+	tests := []struct {
+		name    string
+		attrs   DealAttrs
+		wantV8n bool
+	}{
+		{
+			name:  "change deal id",
+			attrs: DealAttrs{DealID: "pmp-2"},
+		},
+		{
+			name:  "change app and segment",
+			attrs: DealAttrs{AppID: 2, SegmentID: segmentID(20)},
+		},
+		{
+			name:    "change segment to segment of another app",
+			attrs:   DealAttrs{SegmentID: segmentID(20)},
+			wantV8n: true,
+		},
+		{
+			name:    "change app keeping segment of previous app",
+			attrs:   DealAttrs{AppID: 2},
+			wantV8n: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewDealService(newDealStoreMock(deal))
+
+			_, err := service.Update(context.Background(), authCtx, deal.ID, &tt.attrs)
+
+			var validationErr v8n.Errors
+			if isV8n := errors.As(err, &validationErr); isV8n != tt.wantV8n || (err != nil && !isV8n) {
+				t.Errorf("Update() error = %v, want validation error: %v", err, tt.wantV8n)
+			}
+		})
+	}
+}
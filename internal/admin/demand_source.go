@@ -1,6 +1,17 @@
 package admin
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+)
 
 //go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out demand_source_mocks_test.go . DemandSourceRepo
 
@@ -19,8 +30,13 @@ type DemandSource struct {
 type DemandSourceAttrs struct {
 	HumanName string `json:"human_name"`
 	ApiKey    string `json:"api_key"`
+	// OpenRTB makes the demand source requested by the generic OpenRTB adapter if set.
+	OpenRTB *OpenRTBBidder `json:"openrtb,omitempty"`
 }
 
+// OpenRTBBidder defines how the generic OpenRTB adapter requests a demand source.
+type OpenRTBBidder adapter.OpenRTBBidder
+
 type DemandSourceService struct {
 	*ResourceService[DemandSourceResource, DemandSource, DemandSourceAttrs]
 }
@@ -42,6 +58,10 @@ func NewDemandSourceService(store Store) *DemandSourceService {
 		}
 	}
 
+	s.getValidator = func(attrs *DemandSourceAttrs) v8n.ValidatableWithContext {
+		return &demandSourceAttrsValidator{attrs: attrs}
+	}
+
 	return s
 }
 
@@ -102,3 +122,43 @@ func (p *demandSourcePolicy) instancePermissions(authCtx AuthContext, _ *DemandS
 		Delete: authCtx.IsAdmin(),
 	}
 }
+
+type demandSourceAttrsValidator struct {
+	attrs *DemandSourceAttrs
+}
+
+func (v *demandSourceAttrsValidator) ValidateWithContext(ctx context.Context) error {
+	return v8n.ValidateStructWithContext(ctx, v.attrs,
+		v8n.Field(&v.attrs.OpenRTB),
+	)
+}
+
+var (
+	openRTBTokenTargetRegexp = regexp.MustCompile(`^(user\.buyeruid|(user|imp)\.ext\.\w+)$`)
+	openRTBBidFieldRegexp    = regexp.MustCompile(`^ext\.\w+$`)
+)
+
+func (b OpenRTBBidder) Validate() error {
+	return v8n.ValidateStruct(&b,
+		// Macros are replaced with a valid value to validate the rest of the template
+		v8n.Field(&b.Endpoint, v8n.Required, v8n.By(func(value any) error {
+			return is.URL.Validate(adapter.OpenRTBMacroRegexp.ReplaceAllString(value.(string), "macro"))
+		})),
+		v8n.Field(&b.Imps, v8n.Required, v8n.By(func(value any) error {
+			for adType, imp := range value.(map[string]adapter.OpenRTBImp) {
+				if !slices.Contains([]ad.Type{ad.BannerType, ad.InterstitialType, ad.RewardedType}, ad.Type(adType)) {
+					return fmt.Errorf("unknown ad type %q", adType)
+				}
+				if !imp.Banner && !imp.Video {
+					return fmt.Errorf("%s impression must have banner or video", adType)
+				}
+			}
+			return nil
+		})),
+		v8n.Field(&b.Token, v8n.By(func(value any) error {
+			return v8n.Validate(value.(adapter.OpenRTBToken).Target, v8n.Match(openRTBTokenTargetRegexp))
+		})),
+		v8n.Field(&b.Payload, v8n.When(b.Payload != "adm", v8n.Match(openRTBBidFieldRegexp))),
+		v8n.Field(&b.Signaldata, v8n.Match(openRTBBidFieldRegexp)),
+	)
+}
@@ -167,10 +167,19 @@ func (v *demandSourceAccountValidator) ValidateWithContext(ctx context.Context)
 	}
 
 	return v8n.ValidateStruct(v.attrs,
-		v8n.Field(&v.attrs.Extra, v.extraRule(demandSource)),
+		v8n.Field(&v.attrs.Extra, v.extraRule(demandSource), bidCacheRule),
 	)
 }
 
+// bidCacheRule validates optional bid_cache override of adapter cache policy, common for all demand sources.
+var bidCacheRule = v8n.Map(
+	v8n.Key("bid_cache", v8n.NilOrNotEmpty, isMap, v8n.Map(
+		v8n.Key("cacheable", isBool).Optional(),
+		v8n.Key("max_ttl", isFloat, v8n.Min(0.0)).Optional(),
+		v8n.Key("cross_format", isBool).Optional(),
+	)).Optional(),
+).AllowExtraKeys()
+
 func (v *demandSourceAccountValidator) extraRule(demandSource *DemandSource) v8n.Rule {
 	var rule v8n.MapRule
 
@@ -230,6 +239,15 @@ func (v *demandSourceAccountValidator) extraRule(demandSource *DemandSource) v8n
 		// Authentication is via ssp-id in endpoint URL
 		// oauth_token field exists in UI but is optional (for reporting APIs)
 		rule = v8n.Map()
+	default:
+		if demandSource.OpenRTB != nil {
+			// Account extra must have values of account macros used in templates of generic OpenRTB bidder
+			var keys []*v8n.KeyRules
+			for _, key := range (*adapter.OpenRTBBidder)(demandSource.OpenRTB).MacroKeys(adapter.OpenRTBMacroAccount) {
+				keys = append(keys, v8n.Key(key, v8n.Required, isString))
+			}
+			rule = v8n.Map(keys...)
+		}
 	}
 
 	return rule.AllowExtraKeys()
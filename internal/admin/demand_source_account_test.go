@@ -169,6 +169,62 @@ func Test_demandSourceAccountValidator_ValidateWithContext(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid bid_cache override",
+			&DemandSourceAccountAttrs{
+				DemandSourceID: 1,
+				Extra: map[string]any{
+					"account_id": "account_id",
+					"bid_cache": map[string]any{
+						"cacheable":    true,
+						"max_ttl":      120.0,
+						"cross_format": false,
+					},
+				},
+			},
+			&DemandSource{
+				DemandSourceAttrs: DemandSourceAttrs{
+					ApiKey: string(adapter.VungleKey),
+				},
+			},
+			false,
+		},
+		{
+			"invalid bid_cache override when cacheable is not a boolean",
+			&DemandSourceAccountAttrs{
+				DemandSourceID: 1,
+				Extra: map[string]any{
+					"account_id": "account_id",
+					"bid_cache": map[string]any{
+						"cacheable": "yes",
+					},
+				},
+			},
+			&DemandSource{
+				DemandSourceAttrs: DemandSourceAttrs{
+					ApiKey: string(adapter.VungleKey),
+				},
+			},
+			true,
+		},
+		{
+			"invalid bid_cache override when max_ttl is negative",
+			&DemandSourceAccountAttrs{
+				DemandSourceID: 1,
+				Extra: map[string]any{
+					"account_id": "account_id",
+					"bid_cache": map[string]any{
+						"max_ttl": -1.0,
+					},
+				},
+			},
+			&DemandSource{
+				DemandSourceAttrs: DemandSourceAttrs{
+					ApiKey: string(adapter.VungleKey),
+				},
+			},
+			true,
+		},
 		{
 			"valid nil Extra",
 			&DemandSourceAccountAttrs{
@@ -277,6 +333,42 @@ func Test_demandSourceAccountValidator_ValidateWithContext(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid generic OpenRTB",
+			&DemandSourceAccountAttrs{
+				DemandSourceID: 1,
+				Extra:          map[string]any{"region": "eu", "seat_id": "1", "token": "secret"},
+			},
+			&DemandSource{
+				DemandSourceAttrs: DemandSourceAttrs{
+					ApiKey: "acme",
+					OpenRTB: &OpenRTBBidder{
+						Endpoint: "https://{{account.region}}.acme.test/bid?seat={{account.seat_id}}",
+						Headers:  map[string]string{"Authorization": "Bearer {{account.token}}"},
+						TagID:    "{{ad_unit.placement_id}}",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid generic OpenRTB when account macro value is missing",
+			&DemandSourceAccountAttrs{
+				DemandSourceID: 1,
+				Extra:          map[string]any{"region": "eu", "seat_id": "1"},
+			},
+			&DemandSource{
+				DemandSourceAttrs: DemandSourceAttrs{
+					ApiKey: "acme",
+					OpenRTB: &OpenRTBBidder{
+						Endpoint: "https://{{account.region}}.acme.test/bid?seat={{account.seat_id}}",
+						Headers:  map[string]string{"Authorization": "Bearer {{account.token}}"},
+						TagID:    "{{ad_unit.placement_id}}",
+					},
+				},
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/admin/resource"
+)
+
+func Test_demandSourceAttrsValidator_ValidateWithContext(t *testing.T) {
+	valid := func() *OpenRTBBidder {
+		return &OpenRTBBidder{
+			Endpoint: "https://{{account.region}}.acme.test/bid",
+			Imps: map[string]adapter.OpenRTBImp{
+				"banner":   {Banner: true},
+				"rewarded": {Video: true},
+			},
+			Token:      adapter.OpenRTBToken{Target: "imp.ext.bid_token"},
+			Payload:    "ext.payload",
+			Signaldata: "ext.signaldata",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(b *OpenRTBBidder)
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			modify: func(b *OpenRTBBidder) {},
+		},
+		{
+			name:    "endpoint is not URL",
+			modify:  func(b *OpenRTBBidder) { b.Endpoint = "not a url {{account.region}}" },
+			wantErr: true,
+		},
+		{
+			name:    "no imps",
+			modify:  func(b *OpenRTBBidder) { b.Imps = nil },
+			wantErr: true,
+		},
+		{
+			name:    "unknown ad type",
+			modify:  func(b *OpenRTBBidder) { b.Imps["native"] = adapter.OpenRTBImp{Banner: true} },
+			wantErr: true,
+		},
+		{
+			name:    "imp without banner and video",
+			modify:  func(b *OpenRTBBidder) { b.Imps["interstitial"] = adapter.OpenRTBImp{} },
+			wantErr: true,
+		},
+		{
+			name:    "unknown token target",
+			modify:  func(b *OpenRTBBidder) { b.Token.Target = "device.ifa" },
+			wantErr: true,
+		},
+		{
+			name:    "unknown payload field",
+			modify:  func(b *OpenRTBBidder) { b.Payload = "nurl" },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bidder := valid()
+			tt.modify(bidder)
+
+			v := &demandSourceAttrsValidator{
+				attrs: &DemandSourceAttrs{HumanName: "Acme", ApiKey: "acme", OpenRTB: bidder},
+			}
+			if err := v.ValidateWithContext(context.Background()); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWithContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// newDemandSourceRepoMock returns repo listing demand sources with given API keys.
+func newDemandSourceRepoMock(keys ...adapter.Key) *DemandSourceRepoMock {
+	return &DemandSourceRepoMock{
+		ListFunc: func(_ context.Context, _ map[string][]string) (*resource.Collection[DemandSource], error) {
+			sources := make([]DemandSource, len(keys))
+			for i, key := range keys {
+				sources[i] = DemandSource{ID: int64(i + 1), DemandSourceAttrs: DemandSourceAttrs{ApiKey: string(key)}}
+			}
+
+			return &resource.Collection[DemandSource]{Items: sources}, nil
+		},
+	}
+}
@@ -98,10 +98,13 @@ type appDemandProfileServiceHandler = resourceServiceHandler[admin.AppDemandProf
 type auctionConfigurationServiceHandler = resourceServiceHandler[admin.AuctionConfigurationResource, admin.AuctionConfiguration, admin.AuctionConfigurationAttrs]
 type auctionConfigurationV2ServiceHandler = resourceServiceHandler[admin.AuctionConfigurationV2Resource, admin.AuctionConfigurationV2, admin.AuctionConfigurationV2Attrs]
 type countryServiceHandler = resourceServiceHandler[admin.CountryResource, admin.Country, admin.CountryAttrs]
+type dealServiceHandler = resourceServiceHandler[admin.DealResource, admin.Deal, admin.DealAttrs]
 type demandSourceServiceHandler = resourceServiceHandler[admin.DemandSourceResource, admin.DemandSource, admin.DemandSourceAttrs]
 type demandSourceAccountServiceHandler = resourceServiceHandler[admin.DemandSourceAccountResource, admin.DemandSourceAccount, admin.DemandSourceAccountAttrs]
+type experimentServiceHandler = resourceServiceHandler[admin.ExperimentResource, admin.Experiment, admin.ExperimentAttrs]
 type lineItemServiceHandler = resourceServiceHandler[admin.LineItemResource, admin.LineItem, admin.LineItemAttrs]
 type segmentServiceHandler = resourceServiceHandler[admin.SegmentResource, admin.Segment, admin.SegmentAttrs]
+type adapterInitOverrideServiceHandler = resourceServiceHandler[admin.AdapterInitOverrideResource, admin.AdapterInitOverride, admin.AdapterInitOverrideAttrs]
 type userServiceHandler = resourceServiceHandler[admin.UserResource, admin.User, admin.UserAttrs]
 type settingsServiceHandler struct {
 	service *admin.SettingsService
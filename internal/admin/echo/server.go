@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	session "github.com/spazzymoto/echo-scs-session"
@@ -26,11 +27,14 @@ type Server struct {
 	AucCfgHandler              *auctionConfigurationServiceHandler
 	AucCfgV2Handler            *auctionConfigurationV2ServiceHandler
 	CountryHandler             *countryServiceHandler
+	DealHandler                *dealServiceHandler
 	DemandSourceHandler        *demandSourceServiceHandler
 	DemandSourceAccountHandler *demandSourceAccountServiceHandler
+	ExperimentHandler          *experimentServiceHandler
 	LineItemHandler            *lineItemServiceHandler
 	LineItemImportHandler      *lineItemImportHandler
 	SegmentHandler             *segmentServiceHandler
+	AdapterInitOverrideHandler *adapterInitOverrideServiceHandler
 	UserHandler                *userHandler
 	SettingsHandler            *settingsServiceHandler
 }
@@ -43,11 +47,14 @@ func NewServer(service *admin.Service, authService *auth.Service) *Server {
 	aucHandler := &auctionConfigurationServiceHandler{service.AuctionConfigurationService}
 	aucV2Handler := &auctionConfigurationV2ServiceHandler{service.AuctionConfigurationV2Service}
 	countryHandler := &countryServiceHandler{service.CountryService}
+	dealHandler := &dealServiceHandler{service.DealService}
 	demandSourceHandler := &demandSourceServiceHandler{service.DemandSourceService}
 	demandSourceAccountHandler := &demandSourceAccountServiceHandler{service.DemandSourceAccountService}
+	experimentHandler := &experimentServiceHandler{service.ExperimentService}
 	lineItemHandler := &lineItemServiceHandler{service.LineItemService}
 	liImportHandler := &lineItemImportHandler{service.LineItemService}
 	segmentHandler := &segmentServiceHandler{service.SegmentService}
+	adapterInitOverrideHandler := &adapterInitOverrideServiceHandler{service.AdapterInitOverrideService}
 	usrHandler := &userHandler{
 		userServiceHandler: &userServiceHandler{service.UserService},
 	}
@@ -61,11 +68,14 @@ func NewServer(service *admin.Service, authService *auth.Service) *Server {
 		AucCfgHandler:              aucHandler,
 		AucCfgV2Handler:            aucV2Handler,
 		CountryHandler:             countryHandler,
+		DealHandler:                dealHandler,
 		DemandSourceHandler:        demandSourceHandler,
 		DemandSourceAccountHandler: demandSourceAccountHandler,
+		ExperimentHandler:          experimentHandler,
 		LineItemHandler:            lineItemHandler,
 		LineItemImportHandler:      liImportHandler,
 		SegmentHandler:             segmentHandler,
+		AdapterInitOverrideHandler: adapterInitOverrideHandler,
 		UserHandler:                usrHandler,
 		SettingsHandler:            settingsHandler,
 	}
@@ -171,6 +181,25 @@ func (s *Server) DeleteAuctionConfigurationV2(c echo.Context, _ api.IdParam) err
 	return s.AucCfgV2Handler.delete(c)
 }
 
+func (s *Server) GetAuctionConfigurationV2FloorRecommendation(c echo.Context, id api.IdParam, params api.GetAuctionConfigurationV2FloorRecommendationParams) error {
+	authCtx, err := getAuthContext(c)
+	if err != nil {
+		return err
+	}
+
+	recommendation, err := s.FloorRecommendationService.Get(c.Request().Context(), authCtx, int64(id), params.Country)
+	if err != nil {
+		var validationError v8n.Errors
+		if errors.As(err, &validationError) {
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, validationError.Error())
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusOK, recommendation)
+}
+
 // Country handlers
 
 func (s *Server) GetCountries(c echo.Context) error {
@@ -259,6 +288,88 @@ func (s *Server) DeleteSegment(c echo.Context, _ api.IdParam) error {
 	return s.SegmentHandler.delete(c)
 }
 
+func (s *Server) ExplainSegments(c echo.Context) error {
+	authCtx, err := getAuthContext(c)
+	if err != nil {
+		return err
+	}
+
+	req := new(admin.SegmentExplainRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	explanation, err := s.SegmentDebugService.Explain(c.Request().Context(), authCtx, req)
+	if err != nil {
+		var validationError v8n.Errors
+		if errors.As(err, &validationError) {
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, validationError.Error())
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusOK, explanation)
+}
+
+func (s *Server) GetSegmentOverlaps(c echo.Context, params api.GetSegmentOverlapsParams) error {
+	authCtx, err := getAuthContext(c)
+	if err != nil {
+		return err
+	}
+
+	shadowings, err := s.SegmentDebugService.Overlaps(c.Request().Context(), authCtx, params.AppId)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, shadowings)
+}
+
+// AdapterInitOverride handlers
+
+func (s *Server) GetAdapterInitOverrides(c echo.Context) error {
+	return s.AdapterInitOverrideHandler.list(c)
+}
+
+func (s *Server) CreateAdapterInitOverride(c echo.Context) error {
+	return s.AdapterInitOverrideHandler.create(c)
+}
+
+func (s *Server) GetAdapterInitOverride(c echo.Context, _ api.IdParam) error {
+	return s.AdapterInitOverrideHandler.get(c)
+}
+
+func (s *Server) UpdateAdapterInitOverride(c echo.Context, _ api.IdParam) error {
+	return s.AdapterInitOverrideHandler.update(c)
+}
+
+func (s *Server) DeleteAdapterInitOverride(c echo.Context, _ api.IdParam) error {
+	return s.AdapterInitOverrideHandler.delete(c)
+}
+
+// Deal handlers
+
+func (s *Server) GetDeals(c echo.Context) error {
+	return s.DealHandler.list(c)
+}
+
+func (s *Server) CreateDeal(c echo.Context) error {
+	return s.DealHandler.create(c)
+}
+
+func (s *Server) GetDeal(c echo.Context, _ api.IdParam) error {
+	return s.DealHandler.get(c)
+}
+
+func (s *Server) UpdateDeal(c echo.Context, _ api.IdParam) error {
+	return s.DealHandler.update(c)
+}
+
+func (s *Server) DeleteDeal(c echo.Context, _ api.IdParam) error {
+	return s.DealHandler.delete(c)
+}
+
 // User handlers
 
 type userHandler struct {
@@ -315,6 +426,72 @@ func (s *Server) DeleteUser(c echo.Context, _ api.IdParam) error {
 	return s.UserHandler.delete(c)
 }
 
+// Experiment handlers
+
+func (s *Server) GetExperiments(c echo.Context) error {
+	return s.ExperimentHandler.list(c)
+}
+
+func (s *Server) CreateExperiment(c echo.Context) error {
+	return s.ExperimentHandler.create(c)
+}
+
+func (s *Server) GetExperiment(c echo.Context, _ api.IdParam) error {
+	return s.ExperimentHandler.get(c)
+}
+
+func (s *Server) UpdateExperiment(c echo.Context, _ api.IdParam) error {
+	return s.ExperimentHandler.update(c)
+}
+
+func (s *Server) DeleteExperiment(c echo.Context, _ api.IdParam) error {
+	return s.ExperimentHandler.delete(c)
+}
+
+func (s *Server) PauseExperiment(c echo.Context, id api.IdParam) error {
+	return s.changeExperimentStatus(c, func(ctx context.Context, authCtx admin.AuthContext) (*admin.Experiment, error) {
+		return s.ExperimentService.Pause(ctx, authCtx, int64(id))
+	})
+}
+
+func (s *Server) ResumeExperiment(c echo.Context, id api.IdParam) error {
+	return s.changeExperimentStatus(c, func(ctx context.Context, authCtx admin.AuthContext) (*admin.Experiment, error) {
+		return s.ExperimentService.Resume(ctx, authCtx, int64(id))
+	})
+}
+
+func (s *Server) ConcludeExperiment(c echo.Context, id api.IdParam) error {
+	var body api.ConcludeExperimentJSONRequestBody
+	if c.Request().ContentLength != 0 {
+		if err := c.Bind(&body); err != nil {
+			return err
+		}
+	}
+
+	return s.changeExperimentStatus(c, func(ctx context.Context, authCtx admin.AuthContext) (*admin.Experiment, error) {
+		return s.ExperimentService.Conclude(ctx, authCtx, int64(id), body.WinnerVariantId)
+	})
+}
+
+func (s *Server) changeExperimentStatus(c echo.Context, change func(context.Context, admin.AuthContext) (*admin.Experiment, error)) error {
+	authCtx, err := getAuthContext(c)
+	if err != nil {
+		return err
+	}
+
+	experiment, err := change(c.Request().Context(), authCtx)
+	if err != nil {
+		var validationError v8n.Errors
+		if errors.As(err, &validationError) {
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, validationError.Error())
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusOK, experiment)
+}
+
 // LineItem handlers
 
 func (s *Server) GetLineItems(c echo.Context, _ api.GetLineItemsParams) error {
@@ -365,10 +542,13 @@ func (s *Server) GetResources(c echo.Context) error {
 		s.AppDemandProfileService,
 		s.AuctionConfigurationV2Service,
 		s.CountryService,
+		s.DealService,
 		s.DemandSourceService,
 		s.DemandSourceAccountService,
+		s.ExperimentService,
 		s.LineItemService,
 		s.SegmentService,
+		s.AdapterInitOverrideService,
 		s.UserService,
 		s.APIKeyService,
 	}
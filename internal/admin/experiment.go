@@ -0,0 +1,298 @@
+package admin
+
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out experiment_mocks_test.go . ExperimentRepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+)
+
+const ExperimentResourceKey = "experiment"
+
+type ExperimentStatus string
+
+const (
+	ExperimentStatusRunning   ExperimentStatus = "running"
+	ExperimentStatusPaused    ExperimentStatus = "paused"
+	ExperimentStatusConcluded ExperimentStatus = "concluded"
+)
+
+type ExperimentResource struct {
+	*Experiment
+	Permissions ResourceInstancePermissions `json:"_permissions"`
+}
+
+// Experiment splits traffic of an auction configuration between weighted variants.
+// SDK API assigns users to variants by IDFV or session ID while experiment is running,
+// paused and concluded experiments serve auction configuration as is.
+type Experiment struct {
+	ID int64 `json:"id"`
+	ExperimentAttrs
+	Status          ExperimentStatus `json:"status"`
+	WinnerVariantID *string          `json:"winner_variant_id"`
+	ConcludedAt     *time.Time       `json:"concluded_at"`
+	App             App              `json:"app"`
+}
+
+type ExperimentAttrs struct {
+	Name                   string              `json:"name"`
+	AppID                  int64               `json:"app_id"`
+	AuctionConfigurationID int64               `json:"auction_configuration_id"`
+	Variants               []ExperimentVariant `json:"variants"`
+}
+
+// ExperimentVariant overrides auction configuration for users assigned to it.
+// Overrides that are not set keep values of the auction configuration, so control variant has none.
+type ExperimentVariant struct {
+	ID          string        `json:"id"`
+	Weight      int32         `json:"weight"`
+	Demands     []adapter.Key `json:"demands"`
+	Bidding     []adapter.Key `json:"bidding"`
+	AdUnitIDs   []int64       `json:"ad_unit_ids"`
+	Pricefloor  *float64      `json:"pricefloor"`
+	Timeout     *int32        `json:"timeout"`
+	FloorPolicy *FloorPolicy  `json:"floor_policy"`
+}
+
+type ExperimentService struct {
+	*ResourceService[ExperimentResource, Experiment, ExperimentAttrs]
+
+	experimentRepo ExperimentRepo
+}
+
+func NewExperimentService(store Store) *ExperimentService {
+	s := &ExperimentService{
+		ResourceService: &ResourceService[ExperimentResource, Experiment, ExperimentAttrs]{},
+		experimentRepo:  store.Experiments(),
+	}
+
+	s.resourceKey = ExperimentResourceKey
+
+	s.repo = store.Experiments()
+	s.policy = newExperimentPolicy(store)
+
+	s.prepareResource = func(authCtx AuthContext, experiment *Experiment) ExperimentResource {
+		return ExperimentResource{
+			Experiment:  experiment,
+			Permissions: s.policy.instancePermissions(authCtx, experiment),
+		}
+	}
+
+	s.getValidator = func(attrs *ExperimentAttrs) v8n.ValidatableWithContext {
+		return &experimentAttrsValidator{
+			attrs:            attrs,
+			demandSourceRepo: store.DemandSources(),
+		}
+	}
+
+	return s
+}
+
+// Create checks that attributes needed to start an experiment are present. The rest is handled by ResourceService.
+func (s *ExperimentService) Create(ctx context.Context, authCtx AuthContext, attrs *ExperimentAttrs) (*Experiment, error) {
+	err := v8n.ValidateStruct(attrs,
+		v8n.Field(&attrs.Name, v8n.Required),
+		v8n.Field(&attrs.AppID, v8n.Required),
+		v8n.Field(&attrs.AuctionConfigurationID, v8n.Required),
+		v8n.Field(&attrs.Variants, v8n.Required),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ResourceService.Create(ctx, authCtx, attrs)
+}
+
+// Pause stops assigning users to variants of a running experiment.
+func (s *ExperimentService) Pause(ctx context.Context, authCtx AuthContext, id int64) (*Experiment, error) {
+	return s.changeStatus(ctx, authCtx, id, ExperimentStatusPaused, nil)
+}
+
+// Resume restarts a paused experiment. Users get the same variants they had before the pause.
+func (s *ExperimentService) Resume(ctx context.Context, authCtx AuthContext, id int64) (*Experiment, error) {
+	return s.changeStatus(ctx, authCtx, id, ExperimentStatusRunning, nil)
+}
+
+// Conclude finishes experiment, optionally recording the winning variant. Concluded experiments can't be changed.
+// Winning variant is not applied to auction configuration, it has to be updated separately.
+func (s *ExperimentService) Conclude(ctx context.Context, authCtx AuthContext, id int64, winnerVariantID *string) (*Experiment, error) {
+	return s.changeStatus(ctx, authCtx, id, ExperimentStatusConcluded, winnerVariantID)
+}
+
+var experimentStatusTransitions = map[ExperimentStatus][]ExperimentStatus{
+	ExperimentStatusRunning: {ExperimentStatusPaused, ExperimentStatusConcluded},
+	ExperimentStatusPaused:  {ExperimentStatusRunning, ExperimentStatusConcluded},
+}
+
+func (s *ExperimentService) changeStatus(ctx context.Context, authCtx AuthContext, id int64, status ExperimentStatus, winnerVariantID *string) (*Experiment, error) {
+	experiment, err := s.policy.getManageScope(authCtx).find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(experimentStatusTransitions[experiment.Status], status) {
+		return nil, v8n.Errors{
+			"status": fmt.Errorf("cannot change from %s to %s", experiment.Status, status),
+		}
+	}
+
+	if winnerVariantID != nil {
+		isVariant := func(v ExperimentVariant) bool { return v.ID == *winnerVariantID }
+		if !slices.ContainsFunc(experiment.Variants, isVariant) {
+			return nil, v8n.Errors{
+				"winner_variant_id": errors.New("must be one of experiment variants"),
+			}
+		}
+	}
+
+	return s.experimentRepo.UpdateStatus(ctx, id, status, winnerVariantID)
+}
+
+type ExperimentRepo interface {
+	AllResourceQuerier[Experiment]
+	OwnedResourceQuerier[Experiment]
+	ResourceManipulator[Experiment, ExperimentAttrs]
+	UpdateStatus(ctx context.Context, id int64, status ExperimentStatus, winnerVariantID *string) (*Experiment, error)
+}
+
+type experimentPolicy struct {
+	repo ExperimentRepo
+
+	appPolicy                    *appPolicy
+	auctionConfigurationV2Policy *auctionConfigurationV2Policy
+}
+
+func newExperimentPolicy(store Store) *experimentPolicy {
+	return &experimentPolicy{
+		repo: store.Experiments(),
+
+		appPolicy:                    newAppPolicy(store),
+		auctionConfigurationV2Policy: newAuctionConfigurationV2Policy(store),
+	}
+}
+
+func (p *experimentPolicy) getReadScope(authCtx AuthContext) resourceScope[Experiment] {
+	return &ownedResourceScope[Experiment]{
+		repo:    p.repo,
+		authCtx: authCtx,
+	}
+}
+
+func (p *experimentPolicy) getManageScope(authCtx AuthContext) resourceScope[Experiment] {
+	return &ownedResourceScope[Experiment]{
+		repo:    p.repo,
+		authCtx: authCtx,
+	}
+}
+
+func (p *experimentPolicy) authorizeCreate(ctx context.Context, authCtx AuthContext, attrs *ExperimentAttrs) error {
+	// Check if user can manage the app.
+	_, err := p.appPolicy.getManageScope(authCtx).find(ctx, attrs.AppID)
+	if err != nil {
+		return err
+	}
+
+	// Check if user can read the auction configuration.
+	config, err := p.auctionConfigurationV2Policy.getReadScope(authCtx).find(ctx, attrs.AuctionConfigurationID)
+	if err != nil {
+		return err
+	}
+
+	if config.AppID != attrs.AppID {
+		return v8n.Errors{
+			"auction_configuration_id": errors.New("must belong to the app"),
+		}
+	}
+
+	return nil
+}
+
+func (p *experimentPolicy) authorizeUpdate(_ context.Context, _ AuthContext, experiment *Experiment, attrs *ExperimentAttrs) error {
+	errs := v8n.Errors{}
+
+	if experiment.Status == ExperimentStatusConcluded {
+		errs["status"] = errors.New("concluded experiment cannot be changed")
+	}
+	if attrs.AppID != 0 && attrs.AppID != experiment.AppID {
+		errs["app_id"] = errors.New("cannot be changed")
+	}
+	if attrs.AuctionConfigurationID != 0 && attrs.AuctionConfigurationID != experiment.AuctionConfigurationID {
+		errs["auction_configuration_id"] = errors.New("cannot be changed")
+	}
+
+	return errs.Filter()
+}
+
+func (p *experimentPolicy) authorizeDelete(_ context.Context, _ AuthContext, _ *Experiment) error {
+	return nil
+}
+
+func (p *experimentPolicy) permissions(_ AuthContext) ResourcePermissions {
+	return ResourcePermissions{
+		Read:   true,
+		Create: true,
+	}
+}
+
+func (p *experimentPolicy) instancePermissions(_ AuthContext, experiment *Experiment) ResourceInstancePermissions {
+	return ResourceInstancePermissions{
+		Update: experiment.Status != ExperimentStatusConcluded,
+		Delete: true,
+	}
+}
+
+type experimentAttrsValidator struct {
+	attrs *ExperimentAttrs
+
+	demandSourceRepo DemandSourceRepo
+}
+
+func (v *experimentAttrsValidator) ValidateWithContext(ctx context.Context) error {
+	keys, err := demandSourceKeys(ctx, v.demandSourceRepo)
+	if err != nil {
+		return v8n.NewInternalError(err)
+	}
+
+	return v8n.ValidateStructWithContext(ctx, v.attrs,
+		v8n.Field(&v.attrs.Variants,
+			v8n.When(v.attrs.Variants != nil, v8n.Length(2, 0), v8n.By(hasUniqueVariantIDs)),
+			v8n.Each(v8n.By(func(value any) error {
+				variant, _ := value.(ExperimentVariant)
+				return variant.validate(keys)
+			})),
+		),
+	)
+}
+
+func hasUniqueVariantIDs(value any) error {
+	variants, _ := value.([]ExperimentVariant)
+
+	seen := make(map[string]bool, len(variants))
+	for _, variant := range variants {
+		if seen[variant.ID] {
+			return fmt.Errorf("variant id %q is not unique", variant.ID)
+		}
+		seen[variant.ID] = true
+	}
+
+	return nil
+}
+
+func (v ExperimentVariant) validate(keys map[adapter.Key]bool) error {
+	return v8n.ValidateStruct(&v,
+		v8n.Field(&v.ID, v8n.Required, v8n.Length(1, 64)),
+		v8n.Field(&v.Weight, v8n.Required, v8n.Min(int32(1))),
+		v8n.Field(&v.Demands, v8n.Each(v8n.By(isDemandSourceKey(keys)))),
+		v8n.Field(&v.Bidding, v8n.Each(v8n.By(isDemandSourceKey(keys)))),
+		v8n.Field(&v.Pricefloor, v8n.Min(0.0)),
+		v8n.Field(&v.Timeout, v8n.Min(int32(0))),
+		v8n.Field(&v.FloorPolicy),
+	)
+}
@@ -0,0 +1,284 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/admin"
+	"github.com/bidon-io/bidon-backend/internal/admin/resource"
+)
+
+func newExperimentStoreMock(user admin.User, experiment *admin.Experiment, statusUpdates *[]admin.ExperimentStatus) *admin.StoreMock {
+	app := admin.App{ID: 1, AppAttrs: admin.AppAttrs{UserID: user.ID}}
+	config := admin.AuctionConfigurationV2{
+		ID:                          5,
+		AuctionConfigurationV2Attrs: admin.AuctionConfigurationV2Attrs{AppID: app.ID},
+	}
+
+	return &admin.StoreMock{
+		AppsFunc: func() admin.AppRepo {
+			return &admin.AppRepoMock{
+				FindOwnedByUserFunc: func(_ context.Context, userID int64, id int64) (*admin.App, error) {
+					if app.ID == id && user.ID == userID {
+						return &app, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+		UsersFunc: func() admin.UserRepo {
+			return &admin.UserRepoMock{}
+		},
+		SegmentsFunc: func() admin.SegmentRepo {
+			return nil
+		},
+		AuctionConfigurationsV2Func: func() admin.AuctionConfigurationV2Repo {
+			return &admin.AuctionConfigurationV2RepoMock{
+				FindOwnedByUserFunc: func(_ context.Context, userID int64, id int64) (*admin.AuctionConfigurationV2, error) {
+					if config.ID == id && user.ID == userID {
+						return &config, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+		DemandSourcesFunc: func() admin.DemandSourceRepo {
+			return &admin.DemandSourceRepoMock{
+				ListFunc: func(_ context.Context, _ map[string][]string) (*resource.Collection[admin.DemandSource], error) {
+					return &resource.Collection[admin.DemandSource]{Items: []admin.DemandSource{
+						{ID: 1, DemandSourceAttrs: admin.DemandSourceAttrs{ApiKey: string(adapter.BidmachineKey)}},
+						{ID: 2, DemandSourceAttrs: admin.DemandSourceAttrs{ApiKey: "acme"}},
+					}}, nil
+				},
+			}
+		},
+		ExperimentsFunc: func() admin.ExperimentRepo {
+			return &admin.ExperimentRepoMock{
+				FindOwnedByUserFunc: func(_ context.Context, userID int64, id int64) (*admin.Experiment, error) {
+					if experiment != nil && experiment.ID == id && user.ID == userID {
+						return experiment, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+				CreateFunc: func(_ context.Context, attrs *admin.ExperimentAttrs) (*admin.Experiment, error) {
+					return &admin.Experiment{ID: 1, ExperimentAttrs: *attrs, Status: admin.ExperimentStatusRunning}, nil
+				},
+				UpdateStatusFunc: func(_ context.Context, id int64, status admin.ExperimentStatus, winnerVariantID *string) (*admin.Experiment, error) {
+					*statusUpdates = append(*statusUpdates, status)
+
+					updated := *experiment
+					updated.Status = status
+					updated.WinnerVariantID = winnerVariantID
+					return &updated, nil
+				},
+			}
+		},
+	}
+}
+
+func TestExperimentService_Create(t *testing.T) {
+	user := admin.User{ID: 1, IsAdmin: ptr(false)}
+	validVariants := []admin.ExperimentVariant{
+		{ID: "control", Weight: 1},
+		{ID: "higher_floor", Weight: 1, Pricefloor: ptr(0.5), Bidding: []adapter.Key{adapter.BidmachineKey}},
+	}
+
+	tests := []struct {
+		name      string
+		attrs     admin.ExperimentAttrs
+		wantV8n   bool
+		wantOther bool
+	}{
+		{
+			name:  "valid experiment",
+			attrs: admin.ExperimentAttrs{Name: "Floor", AppID: 1, AuctionConfigurationID: 5, Variants: validVariants},
+		},
+		{
+			name:    "missing name",
+			attrs:   admin.ExperimentAttrs{AppID: 1, AuctionConfigurationID: 5, Variants: validVariants},
+			wantV8n: true,
+		},
+		{
+			name:    "single variant",
+			attrs:   admin.ExperimentAttrs{Name: "Floor", AppID: 1, AuctionConfigurationID: 5, Variants: validVariants[:1]},
+			wantV8n: true,
+		},
+		{
+			name: "duplicate variant ids",
+			attrs: admin.ExperimentAttrs{Name: "Floor", AppID: 1, AuctionConfigurationID: 5, Variants: []admin.ExperimentVariant{
+				{ID: "a", Weight: 1},
+				{ID: "a", Weight: 1},
+			}},
+			wantV8n: true,
+		},
+		{
+			name: "variant without weight",
+			attrs: admin.ExperimentAttrs{Name: "Floor", AppID: 1, AuctionConfigurationID: 5, Variants: []admin.ExperimentVariant{
+				{ID: "a", Weight: 1},
+				{ID: "b"},
+			}},
+			wantV8n: true,
+		},
+		{
+			name: "variant with unknown adapter",
+			attrs: admin.ExperimentAttrs{Name: "Floor", AppID: 1, AuctionConfigurationID: 5, Variants: []admin.ExperimentVariant{
+				{ID: "a", Weight: 1},
+				{ID: "b", Weight: 1, Demands: []adapter.Key{"unknown"}},
+			}},
+			wantV8n: true,
+		},
+		{
+			name: "variant with adapter of generic OpenRTB demand source",
+			attrs: admin.ExperimentAttrs{Name: "Floor", AppID: 1, AuctionConfigurationID: 5, Variants: []admin.ExperimentVariant{
+				{ID: "a", Weight: 1},
+				{ID: "b", Weight: 1, Bidding: []adapter.Key{"acme"}},
+			}},
+		},
+		{
+			name: "variant with adapter without demand source",
+			attrs: admin.ExperimentAttrs{Name: "Floor", AppID: 1, AuctionConfigurationID: 5, Variants: []admin.ExperimentVariant{
+				{ID: "a", Weight: 1},
+				{ID: "b", Weight: 1, Bidding: []adapter.Key{adapter.MetaKey}},
+			}},
+			wantV8n: true,
+		},
+		{
+			name:      "auction configuration of another user",
+			attrs:     admin.ExperimentAttrs{Name: "Floor", AppID: 1, AuctionConfigurationID: 6, Variants: validVariants},
+			wantOther: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := admin.NewExperimentService(newExperimentStoreMock(user, nil, nil))
+
+			_, err := service.Create(context.Background(), userContext{user: user}, &tt.attrs)
+
+			var validationErr v8n.Errors
+			isV8n := errors.As(err, &validationErr)
+			if isV8n != tt.wantV8n || (err != nil && !isV8n) != tt.wantOther {
+				t.Errorf("Create() error = %v, want validation error: %v, other error: %v", err, tt.wantV8n, tt.wantOther)
+			}
+		})
+	}
+}
+
+func TestExperimentService_ChangeStatus(t *testing.T) {
+	user := admin.User{ID: 1, IsAdmin: ptr(false)}
+
+	tests := []struct {
+		name       string
+		status     admin.ExperimentStatus
+		change     func(*admin.ExperimentService, admin.AuthContext) (*admin.Experiment, error)
+		wantStatus admin.ExperimentStatus
+		wantErr    bool
+	}{
+		{
+			name:   "pause running experiment",
+			status: admin.ExperimentStatusRunning,
+			change: func(s *admin.ExperimentService, authCtx admin.AuthContext) (*admin.Experiment, error) {
+				return s.Pause(context.Background(), authCtx, 1)
+			},
+			wantStatus: admin.ExperimentStatusPaused,
+		},
+		{
+			name:   "resume paused experiment",
+			status: admin.ExperimentStatusPaused,
+			change: func(s *admin.ExperimentService, authCtx admin.AuthContext) (*admin.Experiment, error) {
+				return s.Resume(context.Background(), authCtx, 1)
+			},
+			wantStatus: admin.ExperimentStatusRunning,
+		},
+		{
+			name:   "conclude paused experiment with winner",
+			status: admin.ExperimentStatusPaused,
+			change: func(s *admin.ExperimentService, authCtx admin.AuthContext) (*admin.Experiment, error) {
+				return s.Conclude(context.Background(), authCtx, 1, ptr("b"))
+			},
+			wantStatus: admin.ExperimentStatusConcluded,
+		},
+		{
+			name:   "pause paused experiment",
+			status: admin.ExperimentStatusPaused,
+			change: func(s *admin.ExperimentService, authCtx admin.AuthContext) (*admin.Experiment, error) {
+				return s.Pause(context.Background(), authCtx, 1)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "resume concluded experiment",
+			status: admin.ExperimentStatusConcluded,
+			change: func(s *admin.ExperimentService, authCtx admin.AuthContext) (*admin.Experiment, error) {
+				return s.Resume(context.Background(), authCtx, 1)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "conclude with unknown winner",
+			status: admin.ExperimentStatusRunning,
+			change: func(s *admin.ExperimentService, authCtx admin.AuthContext) (*admin.Experiment, error) {
+				return s.Conclude(context.Background(), authCtx, 1, ptr("c"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			experiment := &admin.Experiment{
+				ID: 1,
+				ExperimentAttrs: admin.ExperimentAttrs{
+					AppID:    1,
+					Variants: []admin.ExperimentVariant{{ID: "a", Weight: 1}, {ID: "b", Weight: 1}},
+				},
+				Status: tt.status,
+			}
+			var statusUpdates []admin.ExperimentStatus
+			service := admin.NewExperimentService(newExperimentStoreMock(user, experiment, &statusUpdates))
+
+			got, err := tt.change(service, userContext{user: user})
+			if tt.wantErr {
+				var validationErr v8n.Errors
+				if !errors.As(err, &validationErr) {
+					t.Errorf("error = %v, want validation error", err)
+				}
+				if len(statusUpdates) != 0 {
+					t.Errorf("status updated to %v, want no updates", statusUpdates)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("error = %v, want nil", err)
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("status = %v, want %v", got.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestExperimentService_Update_Concluded(t *testing.T) {
+	user := admin.User{ID: 1, IsAdmin: ptr(false)}
+	experiment := &admin.Experiment{
+		ID:              1,
+		ExperimentAttrs: admin.ExperimentAttrs{AppID: 1},
+		Status:          admin.ExperimentStatusConcluded,
+	}
+	service := admin.NewExperimentService(newExperimentStoreMock(user, experiment, nil))
+
+	_, err := service.Update(context.Background(), userContext{user: user}, 1, &admin.ExperimentAttrs{Name: "Renamed"})
+
+	var validationErr v8n.Errors
+	if !errors.As(err, &validationErr) {
+		t.Errorf("Update() error = %v, want validation error", err)
+	}
+}
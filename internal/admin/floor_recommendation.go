@@ -0,0 +1,78 @@
+package admin
+
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out floor_recommendation_mocks_test.go . FloorStatsRepo
+
+import (
+	"context"
+	"strings"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/bidon-io/bidon-backend/internal/floor"
+)
+
+// FloorStatsRepo gives access to bid price distributions collected by SDK API.
+type FloorStatsRepo interface {
+	FetchDistribution(ctx context.Context, key floor.Key) (floor.Distribution, error)
+}
+
+// FloorRecommendation is the dynamic price floor SDK API applies for an auction configuration in a country,
+// together with the data it is derived from.
+type FloorRecommendation struct {
+	AuctionConfigurationID int64  `json:"auction_configuration_id"`
+	Country                string `json:"country"`
+	// DynamicFloor reports whether auction configuration applies recommended floor.
+	DynamicFloor bool `json:"dynamic_floor"`
+	floor.Recommendation
+}
+
+type FloorRecommendationService struct {
+	repo                        FloorStatsRepo
+	auctionConfigurationsPolicy *auctionConfigurationV2Policy
+}
+
+func NewFloorRecommendationService(store Store) *FloorRecommendationService {
+	return &FloorRecommendationService{
+		repo:                        store.FloorStats(),
+		auctionConfigurationsPolicy: newAuctionConfigurationV2Policy(store),
+	}
+}
+
+func (s *FloorRecommendationService) Get(ctx context.Context, authCtx AuthContext, configID int64, country string) (*FloorRecommendation, error) {
+	country = strings.ToUpper(country)
+	err := v8n.Validate(country, v8n.Required, v8n.Length(2, 2))
+	if err != nil {
+		return nil, v8n.Errors{"country": err}
+	}
+
+	config, err := s.auctionConfigurationsPolicy.getReadScope(authCtx).find(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := floor.Key{
+		AppID:                  config.AppID,
+		AdType:                 config.AdType,
+		Country:                country,
+		AuctionConfigurationID: config.ID,
+	}
+
+	distribution, err := s.repo.FetchDistribution(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendation := &FloorRecommendation{
+		AuctionConfigurationID: config.ID,
+		Country:                country,
+	}
+
+	var targetFillRate float64
+	if config.FloorPolicy != nil {
+		recommendation.DynamicFloor = config.FloorPolicy.DynamicFloor
+		targetFillRate = config.FloorPolicy.DynamicFloorTargetFillRate
+	}
+	recommendation.Recommendation = floor.Recommend(distribution, targetFillRate)
+
+	return recommendation, nil
+}
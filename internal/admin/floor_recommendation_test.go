@@ -0,0 +1,168 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/admin"
+	"github.com/bidon-io/bidon-backend/internal/floor"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+)
+
+func TestFloorRecommendationService_Get(t *testing.T) {
+	user := admin.User{ID: 1, IsAdmin: ptr(false)}
+	config := admin.AuctionConfigurationV2{
+		ID: 5,
+		AuctionConfigurationV2Attrs: admin.AuctionConfigurationV2Attrs{
+			AppID:     1,
+			AdType:    ad.InterstitialType,
+			SegmentID: ptr(int64(7)),
+			FloorPolicy: &admin.FloorPolicy{
+				DynamicFloor:               true,
+				DynamicFloorTargetFillRate: 0.5,
+			},
+		},
+	}
+
+	distribution := floor.Distribution{Auctions: 200}
+	distribution.Buckets[2] = 100
+	distribution.Buckets[8] = 100
+
+	var fetchedKey floor.Key
+	store := &admin.StoreMock{
+		AppsFunc: func() admin.AppRepo {
+			return &admin.AppRepoMock{}
+		},
+		UsersFunc: func() admin.UserRepo {
+			return &admin.UserRepoMock{}
+		},
+		SegmentsFunc: func() admin.SegmentRepo {
+			return nil
+		},
+		AuctionConfigurationsV2Func: func() admin.AuctionConfigurationV2Repo {
+			return &admin.AuctionConfigurationV2RepoMock{
+				FindOwnedByUserFunc: func(_ context.Context, userID int64, id int64) (*admin.AuctionConfigurationV2, error) {
+					if config.ID == id && user.ID == userID {
+						return &config, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+		FloorStatsFunc: func() admin.FloorStatsRepo {
+			return &admin.FloorStatsRepoMock{
+				FetchDistributionFunc: func(_ context.Context, key floor.Key) (floor.Distribution, error) {
+					fetchedKey = key
+					return distribution, nil
+				},
+			}
+		},
+	}
+	service := admin.NewFloorRecommendationService(store)
+	authCtx := userContext{user: user}
+
+	got, err := service.Get(context.Background(), authCtx, config.ID, "us")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	wantKey := floor.Key{AppID: 1, AdType: ad.InterstitialType, Country: "US", AuctionConfigurationID: 5}
+	if fetchedKey != wantKey {
+		t.Errorf("FetchDistribution() key = %v, want %v", fetchedKey, wantKey)
+	}
+	if got.AuctionConfigurationID != config.ID || got.Country != "US" || !got.DynamicFloor {
+		t.Errorf("Get() = %+v, want recommendation of config %d in US with dynamic floor", got, config.ID)
+	}
+	if got.Floor != 1 || got.TargetFillRate != 0.5 {
+		t.Errorf("Get() floor, target fill rate = %v, %v; want %v, %v", got.Floor, got.TargetFillRate, 1, 0.5)
+	}
+
+	if _, err := service.Get(context.Background(), authCtx, config.ID, "USA"); err == nil {
+		t.Errorf("Get() error = nil, want validation error for invalid country")
+	}
+
+	if _, err := service.Get(context.Background(), authCtx, 6, "US"); err == nil {
+		t.Errorf("Get() error = nil, want error for configuration not owned by user")
+	}
+}
+
+type floorRecorderFunc func(ctx context.Context, distributions map[floor.Key]floor.Distribution) error
+
+func (f floorRecorderFunc) Record(ctx context.Context, distributions map[floor.Key]floor.Distribution) error {
+	return f(ctx, distributions)
+}
+
+// Recommendation of a configuration without segment must be derived from auctions the configuration served
+// to requests of any segment, the same distribution SDK API applies.
+func TestFloorRecommendationService_Get_CollectedKey(t *testing.T) {
+	user := admin.User{ID: 1, IsAdmin: ptr(true)}
+	config := admin.AuctionConfigurationV2{
+		ID: 5,
+		AuctionConfigurationV2Attrs: admin.AuctionConfigurationV2Attrs{
+			AppID:  1,
+			AdType: ad.InterstitialType,
+		},
+	}
+
+	var collectedKeys []floor.Key
+	collector := &floor.Collector{
+		Recorder: floorRecorderFunc(func(_ context.Context, distributions map[floor.Key]floor.Distribution) error {
+			for key := range distributions {
+				collectedKeys = append(collectedKeys, key)
+			}
+			return nil
+		}),
+	}
+	collector.Observe(&event.AdEvent{
+		EventType:              "auction_request",
+		AppID:                  config.AppID,
+		AdType:                 string(config.AdType),
+		AuctionID:              "a1",
+		AuctionConfigurationID: config.ID,
+		CountryCode:            "US",
+		SegmentID:              "9",
+	})
+	if err := collector.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	var fetchedKey floor.Key
+	store := &admin.StoreMock{
+		AppsFunc: func() admin.AppRepo {
+			return &admin.AppRepoMock{}
+		},
+		UsersFunc: func() admin.UserRepo {
+			return &admin.UserRepoMock{}
+		},
+		SegmentsFunc: func() admin.SegmentRepo {
+			return nil
+		},
+		AuctionConfigurationsV2Func: func() admin.AuctionConfigurationV2Repo {
+			return &admin.AuctionConfigurationV2RepoMock{
+				FindFunc: func(_ context.Context, id int64) (*admin.AuctionConfigurationV2, error) {
+					return &config, nil
+				},
+			}
+		},
+		FloorStatsFunc: func() admin.FloorStatsRepo {
+			return &admin.FloorStatsRepoMock{
+				FetchDistributionFunc: func(_ context.Context, key floor.Key) (floor.Distribution, error) {
+					fetchedKey = key
+					return floor.Distribution{}, nil
+				},
+			}
+		},
+	}
+	service := admin.NewFloorRecommendationService(store)
+
+	if _, err := service.Get(context.Background(), userContext{user: user}, config.ID, "US"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if len(collectedKeys) != 1 || collectedKeys[0] != fetchedKey {
+		t.Errorf("FetchDistribution() key = %v, want collected key %v", fetchedKey, collectedKeys)
+	}
+}
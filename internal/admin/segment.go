@@ -4,9 +4,13 @@ package admin
 import (
 	"context"
 
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+
 	"github.com/bidon-io/bidon-backend/internal/segment"
 )
 
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out segment_mocks_test.go . SegmentRepo
+
 const SegmentResourceKey = "segment"
 
 type SegmentResource struct {
@@ -51,6 +55,12 @@ func NewSegmentService(store Store) *SegmentService {
 		}
 	}
 
+	s.getValidator = func(attrs *SegmentAttrs) v8n.ValidatableWithContext {
+		return &segmentAttrsValidator{
+			attrs: attrs,
+		}
+	}
+
 	return s
 }
 
@@ -127,3 +137,21 @@ func (p *segmentPolicy) instancePermissions(_ AuthContext, _ *Segment) ResourceI
 		Delete: true,
 	}
 }
+
+type segmentAttrsValidator struct {
+	attrs *SegmentAttrs
+}
+
+func (v *segmentAttrsValidator) ValidateWithContext(ctx context.Context) error {
+	return v8n.ValidateStructWithContext(ctx, v.attrs,
+		v8n.Field(&v.attrs.Filters, v8n.By(func(value any) error {
+			filters, _ := value.([]segment.Filter)
+			// Filters are omitted on partial update.
+			if filters == nil {
+				return nil
+			}
+
+			return segment.ValidateFilters(filters)
+		})),
+	)
+}
@@ -0,0 +1,126 @@
+package admin
+
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out segment_debug_mocks_test.go . SegmentDebugRepo
+
+import (
+	"context"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/segment"
+)
+
+// SegmentDebugRepo gives access to the same segment matching and auction configuration selection
+// that SDK API performs at request time.
+type SegmentDebugRepo interface {
+	EvaluateSegments(ctx context.Context, params *segment.Params) ([]segment.Evaluation, error)
+	FetchSegments(ctx context.Context, appID int64) ([]segment.Segment, error)
+	// MatchAuctionConfiguration returns nil if no auction configuration matches.
+	MatchAuctionConfiguration(ctx context.Context, appID int64, adType ad.Type, segmentID int64) (*AuctionConfigurationMatch, error)
+}
+
+// AuctionConfigurationMatch is an auction configuration picked for a segment.
+type AuctionConfigurationMatch struct {
+	ID  int64  `json:"id"`
+	UID string `json:"uid"`
+}
+
+// SegmentExplainRequest is a sample request payload to evaluate segments of an app against.
+type SegmentExplainRequest struct {
+	AppID         int64   `json:"app_id"`
+	AdType        ad.Type `json:"ad_type"`
+	Country       string  `json:"country"`
+	Ext           string  `json:"ext"`
+	DeviceOS      string  `json:"device_os"`
+	SDKVersion    string  `json:"sdk_version"`
+	AppVersion    string  `json:"app_version"`
+	SessionUptime int     `json:"session_uptime"`
+}
+
+// SegmentExplanation describes how every segment of an app was evaluated, which one wins
+// and which auction configuration it leads to.
+type SegmentExplanation struct {
+	Evaluations          []segment.Evaluation       `json:"evaluations"`
+	MatchedSegment       *segment.Segment           `json:"matched_segment"`
+	AuctionConfiguration *AuctionConfigurationMatch `json:"auction_configuration"`
+}
+
+type SegmentDebugService struct {
+	repo      SegmentDebugRepo
+	appPolicy *appPolicy
+}
+
+func NewSegmentDebugService(store Store) *SegmentDebugService {
+	return &SegmentDebugService{
+		repo:      store.SegmentDebug(),
+		appPolicy: newAppPolicy(store),
+	}
+}
+
+// Explain evaluates every enabled segment of the app against the sample request in priority order.
+func (s *SegmentDebugService) Explain(ctx context.Context, authCtx AuthContext, req *SegmentExplainRequest) (*SegmentExplanation, error) {
+	err := v8n.ValidateStruct(req,
+		v8n.Field(&req.AppID, v8n.Required),
+		v8n.Field(&req.AdType, v8n.Required, v8n.In(ad.BannerType, ad.InterstitialType, ad.RewardedType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.appPolicy.getReadScope(authCtx).find(ctx, req.AppID); err != nil {
+		return nil, err
+	}
+
+	evaluations, err := s.repo.EvaluateSegments(ctx, &segment.Params{
+		Country:       req.Country,
+		Ext:           req.Ext,
+		AppID:         req.AppID,
+		DeviceOS:      req.DeviceOS,
+		SDKVersion:    req.SDKVersion,
+		AppVersion:    req.AppVersion,
+		SessionUptime: req.SessionUptime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &SegmentExplanation{
+		Evaluations: evaluations,
+	}
+
+	var segmentID int64
+	for i := range evaluations {
+		if evaluations[i].Matched {
+			explanation.MatchedSegment = &evaluations[i].Segment
+			segmentID = evaluations[i].Segment.ID
+			break
+		}
+	}
+
+	explanation.AuctionConfiguration, err = s.repo.MatchAuctionConfiguration(ctx, req.AppID, req.AdType, segmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return explanation, nil
+}
+
+// Overlaps returns enabled segments of the app that are fully shadowed by segments with higher priority.
+func (s *SegmentDebugService) Overlaps(ctx context.Context, authCtx AuthContext, appID int64) ([]segment.Shadowing, error) {
+	if _, err := s.appPolicy.getReadScope(authCtx).find(ctx, appID); err != nil {
+		return nil, err
+	}
+
+	sgmnts, err := s.repo.FetchSegments(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	shadowings := segment.FindShadowed(sgmnts)
+	if shadowings == nil {
+		shadowings = []segment.Shadowing{}
+	}
+
+	return shadowings, nil
+}
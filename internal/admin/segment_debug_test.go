@@ -0,0 +1,79 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/admin"
+	"github.com/bidon-io/bidon-backend/internal/segment"
+)
+
+func TestSegmentDebugService_Explain(t *testing.T) {
+	user := admin.User{ID: 1, IsAdmin: ptr(false)}
+	app := admin.App{ID: 1, AppAttrs: admin.AppAttrs{UserID: user.ID}, User: user}
+
+	countryFilter := segment.Filter{Type: segment.CountryFilterType, Operator: segment.InOperator, Values: []string{"DE"}}
+	evaluations := []segment.Evaluation{
+		{Segment: segment.Segment{ID: 10, Filters: []segment.Filter{countryFilter}}, FailedFilter: &countryFilter},
+		{Segment: segment.Segment{ID: 20}, Matched: true},
+	}
+
+	store := &admin.StoreMock{
+		AppsFunc: func() admin.AppRepo {
+			return &admin.AppRepoMock{
+				FindOwnedByUserFunc: func(_ context.Context, userID int64, id int64) (*admin.App, error) {
+					if app.ID == id && app.UserID == userID {
+						return &app, nil
+					}
+
+					return nil, errors.New("not found")
+				},
+			}
+		},
+		UsersFunc: func() admin.UserRepo {
+			return &admin.UserRepoMock{}
+		},
+		SegmentDebugFunc: func() admin.SegmentDebugRepo {
+			return &admin.SegmentDebugRepoMock{
+				EvaluateSegmentsFunc: func(_ context.Context, _ *segment.Params) ([]segment.Evaluation, error) {
+					return evaluations, nil
+				},
+				MatchAuctionConfigurationFunc: func(_ context.Context, _ int64, _ ad.Type, segmentID int64) (*admin.AuctionConfigurationMatch, error) {
+					if segmentID != 20 {
+						return nil, nil
+					}
+
+					return &admin.AuctionConfigurationMatch{ID: 5, UID: "1701972528521547776"}, nil
+				},
+			}
+		},
+	}
+	service := admin.NewSegmentDebugService(store)
+	authCtx := userContext{user: user}
+
+	got, err := service.Explain(context.Background(), authCtx, &admin.SegmentExplainRequest{AppID: app.ID, AdType: ad.BannerType, Country: "US"})
+	if err != nil {
+		t.Fatalf("Explain() error = %v", err)
+	}
+
+	want := &admin.SegmentExplanation{
+		Evaluations:          evaluations,
+		MatchedSegment:       &evaluations[1].Segment,
+		AuctionConfiguration: &admin.AuctionConfigurationMatch{ID: 5, UID: "1701972528521547776"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Explain() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := service.Explain(context.Background(), authCtx, &admin.SegmentExplainRequest{AppID: app.ID, AdType: "native"}); err == nil {
+		t.Errorf("Explain() error = nil, want validation error for unsupported ad type")
+	}
+
+	if _, err := service.Explain(context.Background(), authCtx, &admin.SegmentExplainRequest{AppID: 2, AdType: ad.BannerType}); err == nil {
+		t.Errorf("Explain() error = nil, want error for app not owned by user")
+	}
+}
@@ -0,0 +1,79 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v8n "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/bidon-io/bidon-backend/internal/segment"
+)
+
+func Test_segmentAttrsValidator_ValidateWithContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   *SegmentAttrs
+		wantErr bool
+	}{
+		{
+			"valid filters",
+			&SegmentAttrs{
+				Filters: []segment.Filter{
+					{Type: "country", Operator: "IN", Values: []string{"US"}},
+					{Type: "group", Operator: "OR", Filters: []segment.Filter{
+						{Type: "game_level", Operator: ">=", Values: []string{"10"}},
+						{Type: "is_paying", Operator: "==", Values: []string{"true"}},
+					}},
+				},
+			},
+			false,
+		},
+		{
+			"filters omitted",
+			&SegmentAttrs{Name: "new name"},
+			false,
+		},
+		{
+			"empty filters",
+			&SegmentAttrs{Filters: []segment.Filter{}},
+			true,
+		},
+		{
+			"unsupported operator",
+			&SegmentAttrs{
+				Filters: []segment.Filter{
+					{Type: "country", Operator: ">", Values: []string{"US"}},
+				},
+			},
+			true,
+		},
+		{
+			"invalid nested filter",
+			&SegmentAttrs{
+				Filters: []segment.Filter{
+					{Type: "group", Operator: "AND", Filters: []segment.Filter{
+						{Type: "age", Operator: "BETWEEN", Values: []string{"18"}},
+					}},
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &segmentAttrsValidator{attrs: tt.attrs}
+
+			err := v.ValidateWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWithContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var validationErrors v8n.Errors
+			if err != nil && !errors.As(err, &validationErrors) {
+				t.Errorf("ValidateWithContext() error = %T, want v8n.Errors", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package adminstore
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+
+	"github.com/bidon-io/bidon-backend/internal/admin"
+	"github.com/bidon-io/bidon-backend/internal/admin/resource"
+	"github.com/bidon-io/bidon-backend/internal/db"
+)
+
+type AdapterInitOverrideRepo struct {
+	*resourceRepo[admin.AdapterInitOverride, admin.AdapterInitOverrideAttrs, db.AdapterInitOverride]
+}
+
+func NewAdapterInitOverrideRepo(d *db.DB) *AdapterInitOverrideRepo {
+	return &AdapterInitOverrideRepo{
+		resourceRepo: &resourceRepo[admin.AdapterInitOverride, admin.AdapterInitOverrideAttrs, db.AdapterInitOverride]{
+			db:           d,
+			mapper:       adapterInitOverrideMapper{},
+			associations: []string{"App", "Segment"},
+		},
+	}
+}
+
+func (r *AdapterInitOverrideRepo) ListOwnedByUser(ctx context.Context, userID int64, _ map[string][]string) (*resource.Collection[admin.AdapterInitOverride], error) {
+	return r.list(ctx, func(db *gorm.DB) *gorm.DB {
+		s := db.Session(&gorm.Session{NewDB: true})
+		return db.InnerJoins("App", s.Table("App").Where(map[string]any{"user_id": userID}))
+	}, nil)
+}
+
+func (r *AdapterInitOverrideRepo) FindOwnedByUser(ctx context.Context, userID int64, id int64) (*admin.AdapterInitOverride, error) {
+	return r.find(ctx, id, func(db *gorm.DB) *gorm.DB {
+		s := db.Session(&gorm.Session{NewDB: true})
+		return db.InnerJoins("App", s.Table("App").Where(map[string]any{"user_id": userID}))
+	})
+}
+
+type adapterInitOverrideMapper struct{}
+
+//lint:ignore U1000 this method is used by generic struct
+func (m adapterInitOverrideMapper) dbModel(o *admin.AdapterInitOverrideAttrs, id int64) *db.AdapterInitOverride {
+	var segmentID *sql.NullInt64
+	if o.SegmentID != nil {
+		segmentID = &sql.NullInt64{Int64: *o.SegmentID, Valid: true}
+	}
+
+	return &db.AdapterInitOverride{
+		ID:        id,
+		AppID:     o.AppID,
+		SegmentID: segmentID,
+		Adapters:  db.AdapterKeysToStringArray(o.Adapters),
+		Patches:   o.Patches,
+		Enabled:   o.Enabled,
+	}
+}
+
+//lint:ignore U1000 this method is used by generic struct
+func (m adapterInitOverrideMapper) resource(o *db.AdapterInitOverride) admin.AdapterInitOverride {
+	var segment *admin.Segment
+	if o.Segment != nil {
+		segment = &admin.Segment{
+			ID:           o.Segment.ID,
+			SegmentAttrs: segmentMapper{}.resourceAttrs(o.Segment),
+		}
+	}
+
+	return admin.AdapterInitOverride{
+		ID:                       o.ID,
+		AdapterInitOverrideAttrs: m.resourceAttrs(o),
+		App: admin.App{
+			ID:       o.App.ID,
+			AppAttrs: appMapper{}.resourceAttrs(&o.App),
+		},
+		Segment: segment,
+	}
+}
+
+func (m adapterInitOverrideMapper) resourceAttrs(o *db.AdapterInitOverride) admin.AdapterInitOverrideAttrs {
+	var segmentID *int64
+	if o.SegmentID != nil && o.SegmentID.Valid {
+		segmentID = &o.SegmentID.Int64
+	}
+
+	return admin.AdapterInitOverrideAttrs{
+		AppID:     o.AppID,
+		SegmentID: segmentID,
+		Adapters:  db.StringArrayToAdapterKeys(&o.Adapters),
+		Patches:   o.Patches,
+		Enabled:   o.Enabled,
+	}
+}
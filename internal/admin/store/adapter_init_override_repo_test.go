@@ -0,0 +1,85 @@
+package adminstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/admin"
+	adminstore "github.com/bidon-io/bidon-backend/internal/admin/store"
+	"github.com/bidon-io/bidon-backend/internal/db"
+	"github.com/bidon-io/bidon-backend/internal/db/dbtest"
+)
+
+func TestAdapterInitOverrideRepo_Find(t *testing.T) {
+	tx := testDB.Begin()
+	defer tx.Rollback()
+
+	repo := adminstore.NewAdapterInitOverrideRepo(tx)
+
+	app := dbtest.CreateApp(t, tx)
+	sgmnt := dbtest.CreateSegment(t, tx, func(s *db.Segment) {
+		s.App = app
+	})
+	attrs := &admin.AdapterInitOverrideAttrs{
+		AppID:     app.ID,
+		SegmentID: &sgmnt.ID,
+		Adapters:  []adapter.Key{adapter.ApplovinKey},
+		Patches:   map[string]any{"applovin": map[string]any{"mediator": "Bidon"}},
+		Enabled:   ptr(true),
+	}
+
+	want, err := repo.Create(context.Background(), attrs)
+	if err != nil {
+		t.Fatalf("repo.Create(ctx, %+v) = %v, %q; want %T, %v", attrs, nil, err, want, nil)
+	}
+	want.App = adminstore.AppAttrsWithId(&app)
+	want.Segment = adminstore.SegmentAttrsWithId(&sgmnt)
+
+	got, err := repo.Find(context.Background(), want.ID)
+	if err != nil {
+		t.Fatalf("repo.Find(ctx) = %v, %q; want %+v, %v", got, err, want, nil)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("repo.Find(ctx) mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestAdapterInitOverrideRepo_Update(t *testing.T) {
+	tx := testDB.Begin()
+	defer tx.Rollback()
+
+	repo := adminstore.NewAdapterInitOverrideRepo(tx)
+
+	app := dbtest.CreateApp(t, tx)
+	attrs := &admin.AdapterInitOverrideAttrs{
+		AppID:    app.ID,
+		Adapters: []adapter.Key{adapter.BidmachineKey},
+		Enabled:  ptr(true),
+	}
+
+	override, err := repo.Create(context.Background(), attrs)
+	if err != nil {
+		t.Fatalf("repo.Create(ctx, %+v) = %v, %q; want %T, %v", attrs, nil, err, override, nil)
+	}
+
+	updateParams := &admin.AdapterInitOverrideAttrs{
+		Adapters: []adapter.Key{adapter.BidmachineKey, adapter.AdmobKey},
+		Enabled:  ptr(false),
+	}
+	got, err := repo.Update(context.Background(), override.ID, updateParams)
+	if err != nil {
+		t.Fatalf("repo.Update(ctx, %+v) = %v, %q; want %T, %v", updateParams, nil, err, got, nil)
+	}
+
+	want := override
+	want.Adapters = updateParams.Adapters
+	want.Enabled = ptr(false)
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("repo.Update(ctx) mismatch (-want, +got):\n%s", diff)
+	}
+}
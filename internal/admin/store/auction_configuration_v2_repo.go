@@ -10,6 +10,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/admin"
 	"github.com/bidon-io/bidon-backend/internal/admin/resource"
 	"github.com/bidon-io/bidon-backend/internal/db"
@@ -86,6 +87,8 @@ func (m auctionConfigurationV2Mapper) dbModel(c *admin.AuctionConfigurationV2Att
 		AdUnitIds:                c.AdUnitIDs,
 		Timeout:                  c.Timeout,
 		Settings:                 c.Settings,
+		FloorPolicy:              dbFloorPolicy(c.FloorPolicy),
+		BiddingTimeouts:          dbBiddingTimeouts(c.BiddingTimeouts),
 	}
 
 	if id == 0 {
@@ -94,6 +97,12 @@ func (m auctionConfigurationV2Mapper) dbModel(c *admin.AuctionConfigurationV2Att
 		} else {
 			model.Settings = map[string]any{"v2": true}
 		}
+		if model.FloorPolicy == nil {
+			model.FloorPolicy = &db.FloorPolicy{}
+		}
+		if model.BiddingTimeouts == nil {
+			model.BiddingTimeouts = &db.BiddingTimeouts{}
+		}
 	}
 
 	return model
@@ -144,7 +153,69 @@ func (m auctionConfigurationV2Mapper) resourceAttrs(c *db.AuctionConfiguration)
 		AdUnitIDs:                c.AdUnitIds,
 		Timeout:                  c.Timeout,
 		Settings:                 c.Settings,
+		FloorPolicy:              floorPolicy(c.FloorPolicy),
+		BiddingTimeouts:          biddingTimeouts(c.BiddingTimeouts),
+	}
+}
+
+func dbFloorPolicy(p *admin.FloorPolicy) *db.FloorPolicy {
+	if p == nil {
+		return nil
+	}
+
+	return &db.FloorPolicy{
+		IgnoreFloorForMediators:    p.IgnoreFloorForMediators,
+		IgnorePrevAuctionPrice:     p.IgnorePrevAuctionPrice,
+		IgnoreAdCachePrices:        p.IgnoreAdCachePrices,
+		DynamicFloor:               p.DynamicFloor,
+		DynamicFloorTargetFillRate: p.DynamicFloorTargetFillRate,
+	}
+}
+
+func floorPolicy(p *db.FloorPolicy) *admin.FloorPolicy {
+	if p == nil {
+		return nil
+	}
+
+	return &admin.FloorPolicy{
+		IgnoreFloorForMediators:    p.IgnoreFloorForMediators,
+		IgnorePrevAuctionPrice:     p.IgnorePrevAuctionPrice,
+		IgnoreAdCachePrices:        p.IgnoreAdCachePrices,
+		DynamicFloor:               p.DynamicFloor,
+		DynamicFloorTargetFillRate: p.DynamicFloorTargetFillRate,
+	}
+}
+
+func dbBiddingTimeouts(t *admin.BiddingTimeouts) *db.BiddingTimeouts {
+	if t == nil {
+		return nil
 	}
+
+	timeouts := &db.BiddingTimeouts{TMax: t.TMax}
+	if len(t.Adapters) > 0 {
+		timeouts.Adapters = make(map[string]int32, len(t.Adapters))
+		for key, timeout := range t.Adapters {
+			timeouts.Adapters[string(key)] = timeout
+		}
+	}
+
+	return timeouts
+}
+
+func biddingTimeouts(t *db.BiddingTimeouts) *admin.BiddingTimeouts {
+	if t == nil {
+		return nil
+	}
+
+	timeouts := &admin.BiddingTimeouts{TMax: t.TMax}
+	if len(t.Adapters) > 0 {
+		timeouts.Adapters = make(map[adapter.Key]int32, len(t.Adapters))
+		for key, timeout := range t.Adapters {
+			timeouts.Adapters[adapter.Key(key)] = timeout
+		}
+	}
+
+	return timeouts
 }
 
 type AuctionConfigurationFilters struct {
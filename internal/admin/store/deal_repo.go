@@ -0,0 +1,117 @@
+package adminstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/bidon-io/bidon-backend/internal/admin"
+	"github.com/bidon-io/bidon-backend/internal/admin/resource"
+	"github.com/bidon-io/bidon-backend/internal/db"
+)
+
+type DealRepo struct {
+	*resourceRepo[admin.Deal, admin.DealAttrs, db.Deal]
+}
+
+func NewDealRepo(d *db.DB) *DealRepo {
+	return &DealRepo{
+		resourceRepo: &resourceRepo[admin.Deal, admin.DealAttrs, db.Deal]{
+			db:           d,
+			mapper:       dealMapper{},
+			associations: []string{"App", "DemandSource", "Segment"},
+		},
+	}
+}
+
+func (r *DealRepo) ListOwnedByUser(ctx context.Context, userID int64, _ map[string][]string) (*resource.Collection[admin.Deal], error) {
+	return r.list(ctx, func(db *gorm.DB) *gorm.DB {
+		s := db.Session(&gorm.Session{NewDB: true})
+		return db.InnerJoins("App", s.Table("App").Where(map[string]any{"user_id": userID}))
+	}, nil)
+}
+
+func (r *DealRepo) FindOwnedByUser(ctx context.Context, userID int64, id int64) (*admin.Deal, error) {
+	return r.find(ctx, id, func(db *gorm.DB) *gorm.DB {
+		s := db.Session(&gorm.Session{NewDB: true})
+		return db.InnerJoins("App", s.Table("App").Where(map[string]any{"user_id": userID}))
+	})
+}
+
+type dealMapper struct{}
+
+//lint:ignore U1000 this method is used by generic struct
+func (m dealMapper) dbModel(d *admin.DealAttrs, id int64) *db.Deal {
+	bidFloor := decimal.NullDecimal{}
+	if d.BidFloor != nil {
+		bidFloor.Decimal = *d.BidFloor
+		bidFloor.Valid = true
+	}
+
+	var segmentID *sql.NullInt64
+	if d.SegmentID != nil {
+		segmentID = &sql.NullInt64{Int64: *d.SegmentID, Valid: true}
+	}
+
+	return &db.Deal{
+		ID:             id,
+		AppID:          d.AppID,
+		DemandSourceID: d.DemandSourceID,
+		DealID:         d.DealID,
+		BidFloor:       bidFloor,
+		Priority:       d.Priority,
+		SegmentID:      segmentID,
+		Countries:      d.Countries,
+		Enabled:        d.Enabled,
+	}
+}
+
+//lint:ignore U1000 this method is used by generic struct
+func (m dealMapper) resource(d *db.Deal) admin.Deal {
+	var segment *admin.Segment
+	if d.Segment != nil {
+		segment = &admin.Segment{
+			ID:           d.Segment.ID,
+			SegmentAttrs: segmentMapper{}.resourceAttrs(d.Segment),
+		}
+	}
+
+	return admin.Deal{
+		ID:        d.ID,
+		DealAttrs: m.resourceAttrs(d),
+		App: admin.App{
+			ID:       d.App.ID,
+			AppAttrs: appMapper{}.resourceAttrs(&d.App),
+		},
+		DemandSource: admin.DemandSource{
+			ID:                d.DemandSource.ID,
+			DemandSourceAttrs: demandSourceMapper{}.resourceAttrs(&d.DemandSource),
+		},
+		Segment: segment,
+	}
+}
+
+func (m dealMapper) resourceAttrs(d *db.Deal) admin.DealAttrs {
+	var bidFloor *decimal.Decimal
+	if d.BidFloor.Valid {
+		bidFloor = &d.BidFloor.Decimal
+	}
+
+	var segmentID *int64
+	if d.SegmentID != nil && d.SegmentID.Valid {
+		segmentID = &d.SegmentID.Int64
+	}
+
+	return admin.DealAttrs{
+		AppID:          d.AppID,
+		DemandSourceID: d.DemandSourceID,
+		DealID:         d.DealID,
+		BidFloor:       bidFloor,
+		Priority:       d.Priority,
+		SegmentID:      segmentID,
+		Countries:      d.Countries,
+		Enabled:        d.Enabled,
+	}
+}
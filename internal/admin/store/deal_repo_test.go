@@ -0,0 +1,96 @@
+package adminstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shopspring/decimal"
+
+	"github.com/bidon-io/bidon-backend/internal/admin"
+	adminstore "github.com/bidon-io/bidon-backend/internal/admin/store"
+	"github.com/bidon-io/bidon-backend/internal/db"
+	"github.com/bidon-io/bidon-backend/internal/db/dbtest"
+)
+
+func TestDealRepo_Find(t *testing.T) {
+	tx := testDB.Begin()
+	defer tx.Rollback()
+
+	repo := adminstore.NewDealRepo(tx)
+
+	app := dbtest.CreateApp(t, tx)
+	demandSource := dbtest.CreateDemandSource(t, tx)
+	sgmnt := dbtest.CreateSegment(t, tx, func(s *db.Segment) {
+		s.App = app
+	})
+	attrs := &admin.DealAttrs{
+		AppID:          app.ID,
+		DemandSourceID: demandSource.ID,
+		DealID:         "pmp-1",
+		BidFloor:       ptr(decimal.RequireFromString("1.5")),
+		Priority:       10,
+		SegmentID:      &sgmnt.ID,
+		Countries:      []string{"US", "GB"},
+		Enabled:        ptr(true),
+	}
+
+	want, err := repo.Create(context.Background(), attrs)
+	if err != nil {
+		t.Fatalf("repo.Create(ctx, %+v) = %v, %q; want %T, %v", attrs, nil, err, want, nil)
+	}
+	want.App = adminstore.AppAttrsWithId(&app)
+	want.DemandSource = *adminstore.DemandSourceResource(&demandSource)
+	want.Segment = adminstore.SegmentAttrsWithId(&sgmnt)
+
+	got, err := repo.Find(context.Background(), want.ID)
+	if err != nil {
+		t.Fatalf("repo.Find(ctx) = %v, %q; want %+v, %v", got, err, want, nil)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("repo.Find(ctx) mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestDealRepo_Update(t *testing.T) {
+	tx := testDB.Begin()
+	defer tx.Rollback()
+
+	repo := adminstore.NewDealRepo(tx)
+
+	app := dbtest.CreateApp(t, tx)
+	demandSource := dbtest.CreateDemandSource(t, tx)
+	attrs := &admin.DealAttrs{
+		AppID:          app.ID,
+		DemandSourceID: demandSource.ID,
+		DealID:         "pmp-1",
+		Enabled:        ptr(true),
+	}
+
+	deal, err := repo.Create(context.Background(), attrs)
+	if err != nil {
+		t.Fatalf("repo.Create(ctx, %+v) = %v, %q; want %T, %v", attrs, nil, err, deal, nil)
+	}
+
+	updateParams := &admin.DealAttrs{
+		BidFloor:  ptr(decimal.NewFromInt(2)),
+		Priority:  5,
+		Countries: []string{"DE"},
+		Enabled:   ptr(false),
+	}
+	got, err := repo.Update(context.Background(), deal.ID, updateParams)
+	if err != nil {
+		t.Fatalf("repo.Update(ctx, %+v) = %v, %q; want %T, %v", updateParams, nil, err, got, nil)
+	}
+
+	want := deal
+	want.BidFloor = updateParams.BidFloor
+	want.Priority = 5
+	want.Countries = []string{"DE"}
+	want.Enabled = ptr(false)
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("repo.Update(ctx) mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -23,6 +23,7 @@ func (m demandSourceMapper) dbModel(s *admin.DemandSourceAttrs, id int64) *db.De
 		ID:        id,
 		APIKey:    s.ApiKey,
 		HumanName: s.HumanName,
+		OpenRTB:   (*db.OpenRTBBidder)(s.OpenRTB),
 	}
 }
 
@@ -38,5 +39,6 @@ func (m demandSourceMapper) resourceAttrs(s *db.DemandSource) admin.DemandSource
 	return admin.DemandSourceAttrs{
 		ApiKey:    s.APIKey,
 		HumanName: s.HumanName,
+		OpenRTB:   (*admin.OpenRTBBidder)(s.OpenRTB),
 	}
 }
@@ -0,0 +1,154 @@
+package adminstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/admin"
+	"github.com/bidon-io/bidon-backend/internal/admin/resource"
+	"github.com/bidon-io/bidon-backend/internal/db"
+)
+
+type ExperimentRepo struct {
+	*resourceRepo[admin.Experiment, admin.ExperimentAttrs, db.Experiment]
+}
+
+func NewExperimentRepo(d *db.DB) *ExperimentRepo {
+	return &ExperimentRepo{
+		resourceRepo: &resourceRepo[admin.Experiment, admin.ExperimentAttrs, db.Experiment]{
+			db:           d,
+			mapper:       experimentMapper{},
+			associations: []string{"App"},
+		},
+	}
+}
+
+func (r *ExperimentRepo) ListOwnedByUser(ctx context.Context, userID int64, _ map[string][]string) (*resource.Collection[admin.Experiment], error) {
+	return r.list(ctx, func(db *gorm.DB) *gorm.DB {
+		s := db.Session(&gorm.Session{NewDB: true})
+		return db.InnerJoins("App", s.Table("App").Where(map[string]any{"user_id": userID}))
+	}, nil)
+}
+
+func (r *ExperimentRepo) FindOwnedByUser(ctx context.Context, userID int64, id int64) (*admin.Experiment, error) {
+	return r.find(ctx, id, func(db *gorm.DB) *gorm.DB {
+		s := db.Session(&gorm.Session{NewDB: true})
+		return db.InnerJoins("App", s.Table("App").Where(map[string]any{"user_id": userID}))
+	})
+}
+
+// UpdateStatus changes status of experiment. Winner variant and conclusion time are recorded when experiment is concluded.
+func (r *ExperimentRepo) UpdateStatus(ctx context.Context, id int64, status admin.ExperimentStatus, winnerVariantID *string) (*admin.Experiment, error) {
+	updates := map[string]any{"status": string(status)}
+	if status == admin.ExperimentStatusConcluded {
+		updates["concluded_at"] = time.Now()
+		if winnerVariantID != nil {
+			updates["winner_variant_id"] = *winnerVariantID
+		}
+	}
+
+	err := r.db.WithContext(ctx).Model(&db.Experiment{ID: id}).Updates(updates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Find(ctx, id)
+}
+
+type experimentMapper struct{}
+
+//lint:ignore U1000 this method is used by generic struct
+func (m experimentMapper) dbModel(e *admin.ExperimentAttrs, id int64) *db.Experiment {
+	var variants []db.ExperimentVariant
+	if e.Variants != nil {
+		variants = make([]db.ExperimentVariant, len(e.Variants))
+		for i, v := range e.Variants {
+			variants[i] = db.ExperimentVariant{
+				ID:          v.ID,
+				Weight:      v.Weight,
+				Demands:     adapterKeysToStrings(v.Demands),
+				Bidding:     adapterKeysToStrings(v.Bidding),
+				AdUnitIDs:   v.AdUnitIDs,
+				Pricefloor:  v.Pricefloor,
+				Timeout:     v.Timeout,
+				FloorPolicy: dbFloorPolicy(v.FloorPolicy),
+			}
+		}
+	}
+
+	experiment := &db.Experiment{
+		ID:                     id,
+		AppID:                  e.AppID,
+		AuctionConfigurationID: e.AuctionConfigurationID,
+		Name:                   e.Name,
+		Variants:               variants,
+	}
+	if id == 0 {
+		experiment.Status = string(admin.ExperimentStatusRunning)
+	}
+
+	return experiment
+}
+
+//lint:ignore U1000 this method is used by generic struct
+func (m experimentMapper) resource(e *db.Experiment) admin.Experiment {
+	var winnerVariantID *string
+	if e.WinnerVariantID.Valid {
+		winnerVariantID = &e.WinnerVariantID.String
+	}
+
+	return admin.Experiment{
+		ID:              e.ID,
+		ExperimentAttrs: m.resourceAttrs(e),
+		Status:          admin.ExperimentStatus(e.Status),
+		WinnerVariantID: winnerVariantID,
+		ConcludedAt:     e.ConcludedAt,
+		App: admin.App{
+			ID:       e.App.ID,
+			AppAttrs: appMapper{}.resourceAttrs(&e.App),
+		},
+	}
+}
+
+func (m experimentMapper) resourceAttrs(e *db.Experiment) admin.ExperimentAttrs {
+	variants := make([]admin.ExperimentVariant, len(e.Variants))
+	for i, v := range e.Variants {
+		variants[i] = admin.ExperimentVariant{
+			ID:          v.ID,
+			Weight:      v.Weight,
+			Demands:     stringsToAdapterKeys(v.Demands),
+			Bidding:     stringsToAdapterKeys(v.Bidding),
+			AdUnitIDs:   v.AdUnitIDs,
+			Pricefloor:  v.Pricefloor,
+			Timeout:     v.Timeout,
+			FloorPolicy: floorPolicy(v.FloorPolicy),
+		}
+	}
+
+	return admin.ExperimentAttrs{
+		Name:                   e.Name,
+		AppID:                  e.AppID,
+		AuctionConfigurationID: e.AuctionConfigurationID,
+		Variants:               variants,
+	}
+}
+
+func adapterKeysToStrings(keys []adapter.Key) []string {
+	if keys == nil {
+		return nil
+	}
+
+	return []string(db.AdapterKeysToStringArray(keys))
+}
+
+func stringsToAdapterKeys(keys []string) []adapter.Key {
+	if keys == nil {
+		return nil
+	}
+
+	return db.StringArrayToAdapterKeys((*pq.StringArray)(&keys))
+}
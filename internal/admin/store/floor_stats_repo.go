@@ -0,0 +1,39 @@
+package adminstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/bidon-io/bidon-backend/internal/floor"
+	floorstore "github.com/bidon-io/bidon-backend/internal/floor/store"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
+)
+
+var errFloorStatsNotConfigured = errors.New("floor statistics storage is not configured")
+
+// FloorStatsRepo reads bid price distributions SDK API collects in Redis.
+// Distributions are read bypassing the cache, so admins see the latest data.
+type FloorStatsRepo struct {
+	distributions *floorstore.DistributionRepo
+}
+
+// NewFloorStatsRepo returns repo that fails every request if rdb is nil.
+func NewFloorStatsRepo(rdb *redis.ClusterClient) *FloorStatsRepo {
+	if rdb == nil {
+		return &FloorStatsRepo{}
+	}
+
+	return &FloorStatsRepo{
+		distributions: &floorstore.DistributionRepo{Redis: rdb, Clock: clock.New()},
+	}
+}
+
+func (r *FloorStatsRepo) FetchDistribution(ctx context.Context, key floor.Key) (floor.Distribution, error) {
+	if r.distributions == nil {
+		return floor.Distribution{}, errFloorStatsNotConfigured
+	}
+
+	return r.distributions.Fetch(ctx, key)
+}
@@ -0,0 +1,63 @@
+package adminstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/admin"
+	"github.com/bidon-io/bidon-backend/internal/auction"
+	auctionstore "github.com/bidon-io/bidon-backend/internal/auction/store"
+	"github.com/bidon-io/bidon-backend/internal/db"
+	"github.com/bidon-io/bidon-backend/internal/segment"
+	segmentstore "github.com/bidon-io/bidon-backend/internal/segment/store"
+)
+
+// SegmentDebugRepo evaluates segments and matches auction configurations with the same code SDK API uses,
+// but always reads from the database, so admins see effect of their changes immediately.
+type SegmentDebugRepo struct {
+	segmentFetcher *segmentstore.SegmentFetcher
+	configFetcher  *auctionstore.ConfigFetcher
+}
+
+func NewSegmentDebugRepo(d *db.DB) *SegmentDebugRepo {
+	return &SegmentDebugRepo{
+		segmentFetcher: &segmentstore.SegmentFetcher{DB: d},
+		configFetcher:  &auctionstore.ConfigFetcher{DB: d},
+	}
+}
+
+func (r *SegmentDebugRepo) EvaluateSegments(ctx context.Context, params *segment.Params) ([]segment.Evaluation, error) {
+	matcher := &segment.Matcher{Fetcher: uncachedSegmentFetcher{r.segmentFetcher}}
+
+	return matcher.Evaluate(ctx, params)
+}
+
+func (r *SegmentDebugRepo) FetchSegments(ctx context.Context, appID int64) ([]segment.Segment, error) {
+	return r.segmentFetcher.Fetch(ctx, appID)
+}
+
+func (r *SegmentDebugRepo) MatchAuctionConfiguration(ctx context.Context, appID int64, adType ad.Type, segmentID int64) (*admin.AuctionConfigurationMatch, error) {
+	// Experiment variants are not applied without a unit to assign.
+	config, err := r.configFetcher.Match(ctx, appID, adType, segmentID, "v2", "")
+	if err != nil {
+		if errors.Is(err, auction.ErrNoAdsFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &admin.AuctionConfigurationMatch{
+		ID:  config.ID,
+		UID: config.UID,
+	}, nil
+}
+
+type uncachedSegmentFetcher struct {
+	*segmentstore.SegmentFetcher
+}
+
+func (f uncachedSegmentFetcher) FetchCached(ctx context.Context, appID int64) ([]segment.Segment, error) {
+	return f.Fetch(ctx, appID)
+}
@@ -6,35 +6,49 @@ import (
 )
 
 type Store struct {
+	AdapterInitOverrideRepo    *AdapterInitOverrideRepo
 	AppRepo                    *AppRepo
 	AppDemandProfileRepo       *AppDemandProfileRepo
 	AuctionConfigurationRepo   *AuctionConfigurationRepo
 	AuctionConfigurationV2Repo *AuctionConfigurationV2Repo
 	CountryRepo                *CountryRepo
+	DealRepo                   *DealRepo
 	DemandSourceRepo           *DemandSourceRepo
 	DemandSourceAccountRepo    *DemandSourceAccountRepo
+	ExperimentRepo             *ExperimentRepo
+	FloorStatsRepo             *FloorStatsRepo
 	LineItemRepo               *LineItemRepo
 	SegmentRepo                *SegmentRepo
+	SegmentDebugRepo           *SegmentDebugRepo
 	UserRepo                   *UserRepo
 	APIKeyRepo                 *APIKeyRepo
 }
 
 func New(db *db.DB) *Store {
 	return &Store{
+		AdapterInitOverrideRepo:    NewAdapterInitOverrideRepo(db),
 		AppRepo:                    NewAppRepo(db),
 		AppDemandProfileRepo:       NewAppDemandProfileRepo(db),
 		AuctionConfigurationRepo:   NewAuctionConfigurationRepo(db),
 		AuctionConfigurationV2Repo: NewAuctionConfigurationV2Repo(db),
 		CountryRepo:                NewCountryRepo(db),
+		DealRepo:                   NewDealRepo(db),
 		DemandSourceRepo:           NewDemandSourceRepo(db),
 		DemandSourceAccountRepo:    NewDemandSourceAccountRepo(db),
+		ExperimentRepo:             NewExperimentRepo(db),
+		FloorStatsRepo:             NewFloorStatsRepo(nil),
 		LineItemRepo:               NewLineItemRepo(db),
 		SegmentRepo:                NewSegmentRepo(db),
+		SegmentDebugRepo:           NewSegmentDebugRepo(db),
 		UserRepo:                   NewUserRepo(db),
 		APIKeyRepo:                 NewAPIKeyRepo(db),
 	}
 }
 
+func (s *Store) AdapterInitOverrides() admin.AdapterInitOverrideRepo {
+	return s.AdapterInitOverrideRepo
+}
+
 func (s *Store) Apps() admin.AppRepo {
 	return s.AppRepo
 }
@@ -55,6 +69,10 @@ func (s *Store) Countries() admin.CountryRepo {
 	return s.CountryRepo
 }
 
+func (s *Store) Deals() admin.DealRepo {
+	return s.DealRepo
+}
+
 func (s *Store) DemandSources() admin.DemandSourceRepo {
 	return s.DemandSourceRepo
 }
@@ -63,6 +81,14 @@ func (s *Store) DemandSourceAccounts() admin.DemandSourceAccountRepo {
 	return s.DemandSourceAccountRepo
 }
 
+func (s *Store) Experiments() admin.ExperimentRepo {
+	return s.ExperimentRepo
+}
+
+func (s *Store) FloorStats() admin.FloorStatsRepo {
+	return s.FloorStatsRepo
+}
+
 func (s *Store) LineItems() admin.LineItemRepo {
 	return s.LineItemRepo
 }
@@ -71,6 +97,10 @@ func (s *Store) Segments() admin.SegmentRepo {
 	return s.SegmentRepo
 }
 
+func (s *Store) SegmentDebug() admin.SegmentDebugRepo {
+	return s.SegmentDebugRepo
+}
+
 func (s *Store) Users() admin.UserRepo {
 	return s.UserRepo
 }
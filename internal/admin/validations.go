@@ -26,6 +26,15 @@ var isFloat = v8n.By(func(value any) error {
 	}
 })
 
+var isBool = v8n.By(func(value any) error {
+	_, ok := value.(bool)
+	if !ok {
+		return fmt.Errorf("must be a boolean")
+	}
+
+	return nil
+})
+
 var isMap = v8n.By(func(value any) error {
 	_, ok := value.(map[string]any)
 	if !ok {
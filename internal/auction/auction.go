@@ -18,11 +18,43 @@ type Config struct {
 	ID                       int64
 	UID                      string
 	ExternalWinNotifications bool
-	Bidding                  []adapter.Key `json:"bidding"`
-	Demands                  []adapter.Key `json:"demands"`
-	AdUnitIDs                []int64       `json:"ad_unit_ids"`
-	Timeout                  int           `json:"timeout"`
-	PriceFloor               float64       `json:"pricefloor"`
+	Bidding                  []adapter.Key   `json:"bidding"`
+	Demands                  []adapter.Key   `json:"demands"`
+	AdUnitIDs                []int64         `json:"ad_unit_ids"`
+	Timeout                  int             `json:"timeout"`
+	PriceFloor               float64         `json:"pricefloor"`
+	FloorPolicy              FloorPolicy     `json:"floor_policy"`
+	BiddingTimeouts          BiddingTimeouts `json:"bidding_timeouts"`
+	// Experiment is a running experiment of the configuration, if any.
+	Experiment *Experiment `json:"experiment"`
+	// ExperimentID and ExperimentVariantID identify experiment variant applied to the configuration.
+	ExperimentID        int64  `json:"experiment_id"`
+	ExperimentVariantID string `json:"experiment_variant_id"`
+}
+
+// FloorPolicy controls how auction price floor is calculated for an auction configuration.
+// Zero value honours every floor source.
+type FloorPolicy struct {
+	// IgnoreFloorForMediators disables price floor for requests coming from listed mediators.
+	IgnoreFloorForMediators []string `json:"ignore_floor_for_mediators"`
+	// IgnorePrevAuctionPrice disables raising price floor to previous auction price reported by custom mediators.
+	IgnorePrevAuctionPrice bool `json:"ignore_prev_auction_price"`
+	// IgnoreAdCachePrices disables raising price floor to prices of ads in SDK cache.
+	IgnoreAdCachePrices bool `json:"ignore_ad_cache_prices"`
+	// DynamicFloor raises price floor to the one recommended by floor optimizer.
+	DynamicFloor bool `json:"dynamic_floor"`
+	// DynamicFloorTargetFillRate is the share of auctions, including auctions without bids, that recommended floor
+	// should keep filled. floor.DefaultTargetFillRate is used if not set.
+	DynamicFloorTargetFillRate float64 `json:"dynamic_floor_target_fill_rate"`
+}
+
+// BiddingTimeouts limits how long bidding adapters are waited for. Timeouts are in milliseconds.
+// Zero values fall back to defaults of the bidding builder.
+type BiddingTimeouts struct {
+	// TMax is the deadline of the whole bidding round, also sent to DSPs as tmax.
+	TMax int `json:"tmax"`
+	// Adapters are timeouts of individual adapters. They are capped by TMax.
+	Adapters map[adapter.Key]int `json:"adapters"`
 }
 
 type LineItem struct {
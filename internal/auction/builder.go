@@ -3,9 +3,14 @@ package auction
 import (
 	"context"
 	"errors"
+	"log"
+	"maps"
 	"math"
+	"slices"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/bidon-io/bidon-backend/internal/ad"
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/bidding"
@@ -23,9 +28,11 @@ type Builder struct {
 	AdUnitsMatcher               AdUnitsMatcher
 	BiddingBuilder               BiddingBuilder
 	BiddingAdaptersConfigBuilder BiddingAdaptersConfigBuilder
+	// DealsFetcher provides private marketplace deals of apps. Deals are not offered to bidders if nil.
+	DealsFetcher DealsFetcher
 }
 
-//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out mocks/mocks.go -pkg mocks . AdUnitsMatcher BiddingBuilder BiddingAdaptersConfigBuilder
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out mocks/mocks.go -pkg mocks . AdUnitsMatcher BiddingBuilder BiddingAdaptersConfigBuilder DealsFetcher
 type AdUnitsMatcher interface {
 	MatchCached(ctx context.Context, params *BuildParams) ([]AdUnit, error)
 }
@@ -38,6 +45,11 @@ type BiddingAdaptersConfigBuilder interface {
 	Build(ctx context.Context, appID int64, adapterKeys []adapter.Key, adUnitsMap *AdUnitsMap) (adapter.ProcessedConfigsMap, error)
 }
 
+type DealsFetcher interface {
+	// FetchCached returns enabled deals of the app.
+	FetchCached(ctx context.Context, appID int64) ([]Deal, error)
+}
+
 type BuildParams struct {
 	App                  *sdkapi.App
 	AdType               ad.Type
@@ -51,6 +63,8 @@ type BuildParams struct {
 	AuctionKey           string
 	AuctionConfiguration *Config
 	AdUnitIDs            []int64
+	DryRun               *bidding.DryRun
+	Trace                *Trace
 }
 
 type Result struct {
@@ -58,7 +72,9 @@ type Result struct {
 	CPMAdUnits           *[]AdUnit
 	AdUnits              *[]AdUnit
 	BiddingAuctionResult *bidding.AuctionResult
-	Stat                 *Stat
+	// Deals are private marketplace deals offered to bidders in the auction.
+	Deals []Deal
+	Stat  *Stat
 }
 
 func (a Result) GetDuration() int64 {
@@ -77,15 +93,22 @@ type Stat struct {
 
 const cent = 0.01
 
-func (b *Builder) Build(ctx context.Context, params *BuildParams) (*Result, error) {
+func (b *Builder) Build(ctx context.Context, params *BuildParams) (result *Result, err error) {
 	start := time.Now()
 
+	ctx, span := tracer.Start(ctx, "auction.Build")
+	defer func() { endSpan(span, err) }()
+
 	if params.AuctionConfiguration == nil {
 		return nil, ErrNoAdsFound
 	}
 
 	demandAdapters := adapter.GetCommonAdapters(params.AuctionConfiguration.Demands, params.Adapters)
 	biddingAdapters := adapter.GetCommonAdapters(params.AuctionConfiguration.Bidding, params.Adapters)
+	if params.Trace != nil {
+		params.Trace.Adapters.Demands = demandAdapters
+		params.Trace.Adapters.Bidding = biddingAdapters
+	}
 	if len(demandAdapters) == 0 && len(biddingAdapters) == 0 {
 		return nil, ErrNoAdsFound
 	}
@@ -93,7 +116,8 @@ func (b *Builder) Build(ctx context.Context, params *BuildParams) (*Result, erro
 		return nil, ErrNoAdsFound
 	}
 
-	adUnits, err := b.AdUnitsMatcher.MatchCached(ctx, &BuildParams{
+	adUnitsCtx, adUnitsSpan := tracer.Start(ctx, "auction.MatchAdUnits")
+	adUnits, err := b.AdUnitsMatcher.MatchCached(adUnitsCtx, &BuildParams{
 		Adapters:   params.Adapters,
 		App:        params.App,
 		AdType:     params.AdType,
@@ -101,15 +125,24 @@ func (b *Builder) Build(ctx context.Context, params *BuildParams) (*Result, erro
 		DeviceType: params.DeviceType,
 		AdUnitIDs:  params.AuctionConfiguration.AdUnitIDs,
 	})
+	adUnitsSpan.SetAttributes(attribute.Int("ad_units.count", len(adUnits)))
+	endSpan(adUnitsSpan, err)
 	if err != nil {
 		return nil, err
 	}
 
 	adUnitsMap := buildAdUnitsMap(&adUnits)
-	adapterConfigs, err := b.BiddingAdaptersConfigBuilder.Build(ctx, params.App.ID, params.Adapters, adUnitsMap)
+	configsCtx, configsSpan := tracer.Start(ctx, "auction.BuildAdapterConfigs")
+	adapterConfigs, err := b.BiddingAdaptersConfigBuilder.Build(configsCtx, params.App.ID, params.Adapters, adUnitsMap)
+	endSpan(configsSpan, err)
 	if err != nil {
 		return nil, err
 	}
+	if params.Trace != nil {
+		params.Trace.Adapters.Configured = slices.Sorted(maps.Keys(adapterConfigs))
+	}
+
+	deals := b.matchDeals(ctx, params)
 
 	biddingAuctionResult, err := b.BiddingBuilder.HoldAuction(ctx, &bidding.BuildParams{
 		App:             params.App,
@@ -118,10 +151,19 @@ func (b *Builder) Build(ctx context.Context, params *BuildParams) (*Result, erro
 		AdapterConfigs:  adapterConfigs,
 		BiddingAdapters: biddingAdapters,
 		StartTS:         start.UnixMilli(),
+		TMax:            time.Duration(params.AuctionConfiguration.BiddingTimeouts.TMax) * time.Millisecond,
+		AdapterTimeouts: adapterTimeouts(params.AuctionConfiguration.BiddingTimeouts.Adapters),
+		DryRun:          params.DryRun,
+		Deals:           openRTBDeals(deals),
 	})
 	if err != nil && !errors.Is(err, bidding.ErrNoAdaptersMatched) {
 		return nil, err
 	}
+	if params.Trace != nil {
+		for _, bid := range biddingAuctionResult.Bids {
+			params.Trace.Bids = append(params.Trace.Bids, newBidTrace(bid))
+		}
+	}
 
 	maxPrice := biddingAuctionResult.GetMaxBidPrice() + cent // Try to get 1 cent more than the max bid price
 	maxPrice = math.Max(maxPrice, params.PriceFloor)
@@ -151,6 +193,7 @@ func (b *Builder) Build(ctx context.Context, params *BuildParams) (*Result, erro
 		AdUnits:              &adUnits,
 		CPMAdUnits:           &cpmAdUnits,
 		BiddingAuctionResult: &biddingAuctionResult,
+		Deals:                deals,
 		Stat: &Stat{
 			StartTS:    start.UnixMilli(),
 			EndTS:      end.UnixMilli(),
@@ -160,3 +203,33 @@ func (b *Builder) Build(ctx context.Context, params *BuildParams) (*Result, erro
 
 	return &auctionResult, nil
 }
+
+// matchDeals returns deals of the app targeting segment and country of the request.
+// matchDeals returns deals of the auction. Deals only add PMP to bid requests, so the auction is held without them
+// if they can't be fetched.
+func (b *Builder) matchDeals(ctx context.Context, params *BuildParams) []Deal {
+	if b.DealsFetcher == nil {
+		return nil
+	}
+
+	deals, err := b.DealsFetcher.FetchCached(ctx, params.App.ID)
+	if err != nil {
+		log.Printf("Error fetching deals of app %d: %v\n", params.App.ID, err)
+		return nil
+	}
+
+	return MatchDeals(deals, params.Segment.ID, params.GeoData.CountryCode)
+}
+
+func adapterTimeouts(timeouts map[adapter.Key]int) map[adapter.Key]time.Duration {
+	if len(timeouts) == 0 {
+		return nil
+	}
+
+	durations := make(map[adapter.Key]time.Duration, len(timeouts))
+	for key, timeout := range timeouts {
+		durations[key] = time.Duration(timeout) * time.Millisecond
+	}
+
+	return durations
+}
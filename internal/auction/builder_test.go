@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/prebid/openrtb/v19/openrtb2"
 
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/auction"
@@ -13,7 +14,9 @@ import (
 	"github.com/bidon-io/bidon-backend/internal/bidding"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/geocoder"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+	"github.com/bidon-io/bidon-backend/internal/segment"
 )
 
 func testApp(id int64) *sdkapi.App {
@@ -444,6 +447,105 @@ func TestBuilder_Build(t *testing.T) {
 	}
 }
 
+func TestBuilder_Build_Deals(t *testing.T) {
+	deals := []auction.Deal{
+		{ID: "pmp-1", DemandID: adapter.BidmachineKey, BidFloor: 1.5, Priority: 10},
+		{ID: "pmp-2", DemandID: adapter.BidmachineKey, SegmentID: 2},
+		{ID: "pmp-3", DemandID: adapter.MetaKey, Countries: []string{"US"}},
+		{ID: "pmp-4", DemandID: adapter.MetaKey, Countries: []string{"DE"}},
+	}
+
+	var gotDeals map[adapter.Key][]openrtb2.Deal
+	builder := testHelperAuctionBuilder(WithBiddingBuilder(&mocks.BiddingBuilderMock{
+		HoldAuctionFunc: func(_ context.Context, params *bidding.BuildParams) (bidding.AuctionResult, error) {
+			gotDeals = params.Deals
+			return bidding.AuctionResult{
+				Bids: []adapters.DemandResponse{
+					{DemandID: adapter.BidmachineKey, Bid: &adapters.BidDemandResponse{Price: 2, DealID: "pmp-1"}},
+				},
+			}, nil
+		},
+	}))
+	builder.DealsFetcher = &mocks.DealsFetcherMock{
+		FetchCachedFunc: func(_ context.Context, _ int64) ([]auction.Deal, error) {
+			return deals, nil
+		},
+	}
+
+	params := &auction.BuildParams{
+		App:            testApp(1),
+		Adapters:       []adapter.Key{adapter.BidmachineKey, adapter.MetaKey},
+		AuctionRequest: &schema.AuctionRequest{},
+		Segment:        segment.Segment{ID: 1},
+		GeoData:        geocoder.GeoData{CountryCode: "US"},
+		AuctionConfiguration: &auction.Config{
+			ID:        1,
+			Bidding:   []adapter.Key{adapter.BidmachineKey, adapter.MetaKey},
+			AdUnitIDs: []int64{1},
+			Timeout:   15000,
+		},
+	}
+
+	got, err := builder.Build(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	wantDeals := []auction.Deal{deals[0], deals[2]}
+	if diff := cmp.Diff(wantDeals, got.Deals); diff != "" {
+		t.Errorf("Build() deals mismatch (-want +got):\n%s", diff)
+	}
+
+	wantOpenRTBDeals := map[adapter.Key][]openrtb2.Deal{
+		adapter.BidmachineKey: {{ID: "pmp-1", BidFloor: 1.5, BidFloorCur: "USD"}},
+		adapter.MetaKey:       {{ID: "pmp-3", BidFloorCur: "USD"}},
+	}
+	if diff := cmp.Diff(wantOpenRTBDeals, gotDeals); diff != "" {
+		t.Errorf("HoldAuction() deals mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestBuilder_Build_DealsFetchError(t *testing.T) {
+	var gotDeals map[adapter.Key][]openrtb2.Deal
+	builder := testHelperAuctionBuilder(WithBiddingBuilder(&mocks.BiddingBuilderMock{
+		HoldAuctionFunc: func(_ context.Context, params *bidding.BuildParams) (bidding.AuctionResult, error) {
+			gotDeals = params.Deals
+			return bidding.AuctionResult{
+				Bids: []adapters.DemandResponse{
+					{DemandID: adapter.BidmachineKey, Bid: &adapters.BidDemandResponse{Price: 2}},
+				},
+			}, nil
+		},
+	}))
+	builder.DealsFetcher = &mocks.DealsFetcherMock{
+		FetchCachedFunc: func(_ context.Context, _ int64) ([]auction.Deal, error) {
+			return nil, errors.New("redis is down")
+		},
+	}
+
+	params := &auction.BuildParams{
+		App:            testApp(1),
+		Adapters:       []adapter.Key{adapter.BidmachineKey},
+		AuctionRequest: &schema.AuctionRequest{},
+		Segment:        segment.Segment{ID: 1},
+		GeoData:        geocoder.GeoData{CountryCode: "US"},
+		AuctionConfiguration: &auction.Config{
+			ID:        1,
+			Bidding:   []adapter.Key{adapter.BidmachineKey},
+			AdUnitIDs: []int64{1},
+			Timeout:   15000,
+		},
+	}
+
+	got, err := builder.Build(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Build() error = %v, want auction held without deals", err)
+	}
+	if len(got.Deals) != 0 || len(gotDeals) != 0 {
+		t.Errorf("Build() deals = %v, HoldAuction() deals = %v; want none", got.Deals, gotDeals)
+	}
+}
+
 func TestAuctionResult_GetDuration(t *testing.T) {
 	tests := []struct {
 		name  string
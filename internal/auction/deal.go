@@ -0,0 +1,82 @@
+package auction
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/prebid/openrtb/v19/openrtb2"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+)
+
+// Deal is a private marketplace deal of an app with a demand source. It is offered to the bidder in imp.pmp.deals.
+type Deal struct {
+	// ID is the deal ID agreed with the demand source.
+	ID       string      `json:"id"`
+	DemandID adapter.Key `json:"demand_id"`
+	BidFloor float64     `json:"bid_floor"`
+	// Priority ranks deal bids above bids with lower priority regardless of price, open auction bids have priority 0.
+	Priority int32 `json:"priority"`
+	// SegmentID targets the deal to a segment, 0 means all segments.
+	SegmentID int64 `json:"segment_id"`
+	// Countries target the deal to alpha-2 country codes, empty list means all countries.
+	Countries []string `json:"countries"`
+}
+
+// Matches reports whether the deal targets the segment and the country.
+func (d *Deal) Matches(segmentID int64, countryCode string) bool {
+	if d.SegmentID != 0 && d.SegmentID != segmentID {
+		return false
+	}
+	if len(d.Countries) > 0 && !slices.ContainsFunc(d.Countries, func(c string) bool { return strings.EqualFold(c, countryCode) }) {
+		return false
+	}
+
+	return true
+}
+
+// MatchDeals returns deals targeting the segment and the country.
+func MatchDeals(deals []Deal, segmentID int64, countryCode string) []Deal {
+	var matched []Deal
+	for _, deal := range deals {
+		if deal.Matches(segmentID, countryCode) {
+			matched = append(matched, deal)
+		}
+	}
+
+	return matched
+}
+
+// FindDeal returns the deal the bid is made for. Returns nil for open auction bids and deals unknown to the auction.
+func FindDeal(deals []Deal, demandResponse adapters.DemandResponse) *Deal {
+	if !demandResponse.IsBid() || demandResponse.Bid.DealID == "" {
+		return nil
+	}
+
+	for i := range deals {
+		if deals[i].DemandID == demandResponse.DemandID && deals[i].ID == demandResponse.Bid.DealID {
+			return &deals[i]
+		}
+	}
+
+	return nil
+}
+
+// openRTBDeals returns deals as imp.pmp.deals objects, keyed by adapter.
+func openRTBDeals(deals []Deal) map[adapter.Key][]openrtb2.Deal {
+	if len(deals) == 0 {
+		return nil
+	}
+
+	openRTBDeals := make(map[adapter.Key][]openrtb2.Deal)
+	for _, deal := range deals {
+		openRTBDeals[deal.DemandID] = append(openRTBDeals[deal.DemandID], openrtb2.Deal{
+			ID:          deal.ID,
+			BidFloor:    deal.BidFloor,
+			BidFloorCur: "USD",
+		})
+	}
+
+	return openRTBDeals
+}
@@ -0,0 +1,99 @@
+package auction_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/auction"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+)
+
+func TestMatchDeals(t *testing.T) {
+	deals := []auction.Deal{
+		{ID: "all"},
+		{ID: "segment", SegmentID: 1},
+		{ID: "countries", Countries: []string{"US", "GB"}},
+		{ID: "segment_and_country", SegmentID: 2, Countries: []string{"DE"}},
+	}
+
+	tests := []struct {
+		name        string
+		segmentID   int64
+		countryCode string
+		want        []string
+	}{
+		{"no segment, other country", 0, "FR", []string{"all"}},
+		{"matching segment", 1, "FR", []string{"all", "segment"}},
+		{"matching country in lower case", 0, "us", []string{"all", "countries"}},
+		{"matching segment and country", 2, "DE", []string{"all", "segment_and_country"}},
+		{"matching segment, other country", 2, "US", []string{"all", "countries"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, deal := range auction.MatchDeals(deals, tt.segmentID, tt.countryCode) {
+				got = append(got, deal.ID)
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("MatchDeals() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindDeal(t *testing.T) {
+	deals := []auction.Deal{
+		{ID: "pmp-1", DemandID: adapter.BidmachineKey, Priority: 1},
+		{ID: "pmp-1", DemandID: adapter.MetaKey, Priority: 2},
+	}
+
+	tests := []struct {
+		name           string
+		demandResponse adapters.DemandResponse
+		want           *auction.Deal
+	}{
+		{
+			name: "deal bid",
+			demandResponse: adapters.DemandResponse{
+				DemandID: adapter.MetaKey,
+				Bid:      &adapters.BidDemandResponse{Price: 1, DealID: "pmp-1"},
+			},
+			want: &deals[1],
+		},
+		{
+			name: "open auction bid",
+			demandResponse: adapters.DemandResponse{
+				DemandID: adapter.BidmachineKey,
+				Bid:      &adapters.BidDemandResponse{Price: 1},
+			},
+			want: nil,
+		},
+		{
+			name: "unknown deal",
+			demandResponse: adapters.DemandResponse{
+				DemandID: adapter.BidmachineKey,
+				Bid:      &adapters.BidDemandResponse{Price: 1, DealID: "pmp-2"},
+			},
+			want: nil,
+		},
+		{
+			name:           "no bid",
+			demandResponse: adapters.DemandResponse{DemandID: adapter.BidmachineKey},
+			want:           nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := auction.FindDeal(deals, tt.demandResponse)
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FindDeal() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package auction
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+)
+
+// Experiment splits traffic of an auction configuration between weighted variants.
+type Experiment struct {
+	ID       int64               `json:"id"`
+	Variants []ExperimentVariant `json:"variants"`
+}
+
+// ExperimentVariant overrides auction configuration for users assigned to it.
+// Overrides that are not set keep values of the auction configuration, so control variant has none.
+type ExperimentVariant struct {
+	ID          string        `json:"id"`
+	Weight      int           `json:"weight"`
+	Demands     []adapter.Key `json:"demands"`
+	Bidding     []adapter.Key `json:"bidding"`
+	AdUnitIDs   []int64       `json:"ad_unit_ids"`
+	PriceFloor  *float64      `json:"pricefloor"`
+	Timeout     *int          `json:"timeout"`
+	FloorPolicy *FloorPolicy  `json:"floor_policy"`
+}
+
+// Variant deterministically assigns unit to a variant in proportion to variant weights.
+// The same unit always gets the same variant of an experiment, while assignments in different experiments are independent.
+// Returns nil if unitID is empty or experiment has no weighted variants.
+func (e *Experiment) Variant(unitID string) *ExperimentVariant {
+	if unitID == "" {
+		return nil
+	}
+
+	var total uint64
+	for _, v := range e.Variants {
+		if v.Weight > 0 {
+			total += uint64(v.Weight)
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%d:%s", e.ID, unitID)
+	point := h.Sum64() % total
+
+	for i := range e.Variants {
+		v := &e.Variants[i]
+		if v.Weight <= 0 {
+			continue
+		}
+		if point < uint64(v.Weight) {
+			return v
+		}
+		point -= uint64(v.Weight)
+	}
+
+	return nil
+}
+
+// WithExperimentVariant returns a copy of the configuration with overrides of experiment variant assigned to unitID.
+// Returns the configuration itself if it has no experiment or unit is not assigned to any variant.
+func (c *Config) WithExperimentVariant(unitID string) *Config {
+	if c.Experiment == nil {
+		return c
+	}
+
+	variant := c.Experiment.Variant(unitID)
+	if variant == nil {
+		return c
+	}
+
+	config := *c
+	config.ExperimentID = c.Experiment.ID
+	config.ExperimentVariantID = variant.ID
+
+	if variant.Demands != nil {
+		config.Demands = variant.Demands
+	}
+	if variant.Bidding != nil {
+		config.Bidding = variant.Bidding
+	}
+	if variant.AdUnitIDs != nil {
+		config.AdUnitIDs = variant.AdUnitIDs
+	}
+	if variant.PriceFloor != nil {
+		config.PriceFloor = *variant.PriceFloor
+	}
+	if variant.Timeout != nil {
+		config.Timeout = *variant.Timeout
+	}
+	if variant.FloorPolicy != nil {
+		config.FloorPolicy = *variant.FloorPolicy
+	}
+
+	return &config
+}
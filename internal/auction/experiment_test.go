@@ -0,0 +1,99 @@
+package auction_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/auction"
+)
+
+func TestExperiment_Variant(t *testing.T) {
+	experiment := &auction.Experiment{
+		ID: 1,
+		Variants: []auction.ExperimentVariant{
+			{ID: "control", Weight: 3},
+			{ID: "treatment", Weight: 1},
+			{ID: "disabled", Weight: 0},
+		},
+	}
+
+	counts := make(map[string]int)
+	for i := range 10000 {
+		unitID := fmt.Sprintf("unit-%d", i)
+
+		variant := experiment.Variant(unitID)
+		if variant == nil {
+			t.Fatalf("Variant(%q) = nil, want variant", unitID)
+		}
+		if again := experiment.Variant(unitID); again.ID != variant.ID {
+			t.Fatalf("Variant(%q) = %q, then %q; want the same variant", unitID, variant.ID, again.ID)
+		}
+
+		counts[variant.ID]++
+	}
+
+	if counts["disabled"] != 0 {
+		t.Errorf("variant without weight got %d units, want 0", counts["disabled"])
+	}
+	if share := float64(counts["treatment"]) / 10000; share < 0.23 || share > 0.27 {
+		t.Errorf("treatment share = %v, want about 0.25", share)
+	}
+
+	if variant := experiment.Variant(""); variant != nil {
+		t.Errorf("Variant(\"\") = %+v, want nil", variant)
+	}
+	if variant := (&auction.Experiment{ID: 1}).Variant("unit"); variant != nil {
+		t.Errorf("Variant() of experiment without variants = %+v, want nil", variant)
+	}
+}
+
+func TestConfig_WithExperimentVariant(t *testing.T) {
+	priceFloor := 0.5
+	timeout := 15000
+	config := &auction.Config{
+		ID:         1,
+		PriceFloor: 0.1,
+		Timeout:    30000,
+		Bidding:    []adapter.Key{adapter.BidmachineKey, adapter.MintegralKey},
+		Demands:    []adapter.Key{adapter.AdmobKey},
+		Experiment: &auction.Experiment{
+			ID: 7,
+			Variants: []auction.ExperimentVariant{
+				{
+					ID:         "treatment",
+					Weight:     1,
+					Bidding:    []adapter.Key{adapter.BidmachineKey},
+					PriceFloor: &priceFloor,
+					Timeout:    &timeout,
+				},
+			},
+		},
+	}
+
+	got := config.WithExperimentVariant("unit")
+
+	want := *config
+	want.ExperimentID = 7
+	want.ExperimentVariantID = "treatment"
+	want.Bidding = []adapter.Key{adapter.BidmachineKey}
+	want.PriceFloor = 0.5
+	want.Timeout = 15000
+	if diff := cmp.Diff(&want, got); diff != "" {
+		t.Errorf("WithExperimentVariant() mismatch (-want +got):\n%s", diff)
+	}
+	if config.PriceFloor != 0.1 || config.ExperimentVariantID != "" {
+		t.Errorf("WithExperimentVariant() modified original config: %+v", config)
+	}
+
+	if got := config.WithExperimentVariant(""); got != config {
+		t.Errorf("WithExperimentVariant(\"\") = %+v, want original config", got)
+	}
+
+	config.Experiment = nil
+	if got := config.WithExperimentVariant("unit"); got != config {
+		t.Errorf("WithExperimentVariant() without experiment = %+v, want original config", got)
+	}
+}
@@ -238,3 +238,75 @@ func (mock *BiddingAdaptersConfigBuilderMock) BuildCalls() []struct {
 	mock.lockBuild.RUnlock()
 	return calls
 }
+
+// Ensure, that DealsFetcherMock does implement auction.DealsFetcher.
+// If this is not the case, regenerate this file with moq.
+var _ auction.DealsFetcher = &DealsFetcherMock{}
+
+// DealsFetcherMock is a mock implementation of auction.DealsFetcher.
+//
+//	func TestSomethingThatUsesDealsFetcher(t *testing.T) {
+//
+//		// make and configure a mocked auction.DealsFetcher
+//		mockedDealsFetcher := &DealsFetcherMock{
+//			FetchCachedFunc: func(ctx context.Context, appID int64) ([]auction.Deal, error) {
+//				panic("mock out the FetchCached method")
+//			},
+//		}
+//
+//		// use mockedDealsFetcher in code that requires auction.DealsFetcher
+//		// and then make assertions.
+//
+//	}
+type DealsFetcherMock struct {
+	// FetchCachedFunc mocks the FetchCached method.
+	FetchCachedFunc func(ctx context.Context, appID int64) ([]auction.Deal, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// FetchCached holds details about calls to the FetchCached method.
+		FetchCached []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AppID is the appID argument value.
+			AppID int64
+		}
+	}
+	lockFetchCached sync.RWMutex
+}
+
+// FetchCached calls FetchCachedFunc.
+func (mock *DealsFetcherMock) FetchCached(ctx context.Context, appID int64) ([]auction.Deal, error) {
+	if mock.FetchCachedFunc == nil {
+		panic("DealsFetcherMock.FetchCachedFunc: method is nil but DealsFetcher.FetchCached was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		AppID int64
+	}{
+		Ctx:   ctx,
+		AppID: appID,
+	}
+	mock.lockFetchCached.Lock()
+	mock.calls.FetchCached = append(mock.calls.FetchCached, callInfo)
+	mock.lockFetchCached.Unlock()
+	return mock.FetchCachedFunc(ctx, appID)
+}
+
+// FetchCachedCalls gets all the calls that were made to FetchCached.
+// Check the length with:
+//
+//	len(mockedDealsFetcher.FetchCachedCalls())
+func (mock *DealsFetcherMock) FetchCachedCalls() []struct {
+	Ctx   context.Context
+	AppID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		AppID int64
+	}
+	mock.lockFetchCached.RLock()
+	calls = mock.calls.FetchCached
+	mock.lockFetchCached.RUnlock()
+	return calls
+}
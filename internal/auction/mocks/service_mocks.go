@@ -8,6 +8,7 @@ import (
 	"github.com/bidon-io/bidon-backend/internal/ad"
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/auction"
+	"github.com/bidon-io/bidon-backend/internal/floor"
 	"sync"
 )
 
@@ -24,7 +25,7 @@ var _ auction.ConfigFetcher = &ConfigFetcherMock{}
 //			FetchByUIDCachedFunc: func(ctx context.Context, appID int64, id string, uid string) *auction.Config {
 //				panic("mock out the FetchByUIDCached method")
 //			},
-//			MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error) {
+//			MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string, unitID string) (*auction.Config, error) {
 //				panic("mock out the Match method")
 //			},
 //		}
@@ -38,7 +39,7 @@ type ConfigFetcherMock struct {
 	FetchByUIDCachedFunc func(ctx context.Context, appID int64, id string, uid string) *auction.Config
 
 	// MatchFunc mocks the Match method.
-	MatchFunc func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error)
+	MatchFunc func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string, unitID string) (*auction.Config, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -65,6 +66,8 @@ type ConfigFetcherMock struct {
 			SegmentID int64
 			// Version is the version argument value.
 			Version string
+			// UnitID is the unitID argument value.
+			UnitID string
 		}
 	}
 	lockFetchByUIDCached sync.RWMutex
@@ -116,7 +119,7 @@ func (mock *ConfigFetcherMock) FetchByUIDCachedCalls() []struct {
 }
 
 // Match calls MatchFunc.
-func (mock *ConfigFetcherMock) Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error) {
+func (mock *ConfigFetcherMock) Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string, unitID string) (*auction.Config, error) {
 	if mock.MatchFunc == nil {
 		panic("ConfigFetcherMock.MatchFunc: method is nil but ConfigFetcher.Match was just called")
 	}
@@ -126,17 +129,19 @@ func (mock *ConfigFetcherMock) Match(ctx context.Context, appID int64, adType ad
 		AdType    ad.Type
 		SegmentID int64
 		Version   string
+		UnitID    string
 	}{
 		Ctx:       ctx,
 		AppID:     appID,
 		AdType:    adType,
 		SegmentID: segmentID,
 		Version:   version,
+		UnitID:    unitID,
 	}
 	mock.lockMatch.Lock()
 	mock.calls.Match = append(mock.calls.Match, callInfo)
 	mock.lockMatch.Unlock()
-	return mock.MatchFunc(ctx, appID, adType, segmentID, version)
+	return mock.MatchFunc(ctx, appID, adType, segmentID, version, unitID)
 }
 
 // MatchCalls gets all the calls that were made to Match.
@@ -149,6 +154,7 @@ func (mock *ConfigFetcherMock) MatchCalls() []struct {
 	AdType    ad.Type
 	SegmentID int64
 	Version   string
+	UnitID    string
 } {
 	var calls []struct {
 		Ctx       context.Context
@@ -156,6 +162,7 @@ func (mock *ConfigFetcherMock) MatchCalls() []struct {
 		AdType    ad.Type
 		SegmentID int64
 		Version   string
+		UnitID    string
 	}
 	mock.lockMatch.RLock()
 	calls = mock.calls.Match
@@ -312,3 +319,81 @@ func (mock *AdapterKeysFetcherMock) FetchEnabledAdapterKeysCalls() []struct {
 	mock.lockFetchEnabledAdapterKeys.RUnlock()
 	return calls
 }
+
+// Ensure, that FloorOptimizerMock does implement auction.FloorOptimizer.
+// If this is not the case, regenerate this file with moq.
+var _ auction.FloorOptimizer = &FloorOptimizerMock{}
+
+// FloorOptimizerMock is a mock implementation of auction.FloorOptimizer.
+//
+//	func TestSomethingThatUsesFloorOptimizer(t *testing.T) {
+//
+//		// make and configure a mocked auction.FloorOptimizer
+//		mockedFloorOptimizer := &FloorOptimizerMock{
+//			RecommendFunc: func(ctx context.Context, key floor.Key, targetFillRate float64) (floor.Recommendation, error) {
+//				panic("mock out the Recommend method")
+//			},
+//		}
+//
+//		// use mockedFloorOptimizer in code that requires auction.FloorOptimizer
+//		// and then make assertions.
+//
+//	}
+type FloorOptimizerMock struct {
+	// RecommendFunc mocks the Recommend method.
+	RecommendFunc func(ctx context.Context, key floor.Key, targetFillRate float64) (floor.Recommendation, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Recommend holds details about calls to the Recommend method.
+		Recommend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key floor.Key
+			// TargetFillRate is the targetFillRate argument value.
+			TargetFillRate float64
+		}
+	}
+	lockRecommend sync.RWMutex
+}
+
+// Recommend calls RecommendFunc.
+func (mock *FloorOptimizerMock) Recommend(ctx context.Context, key floor.Key, targetFillRate float64) (floor.Recommendation, error) {
+	if mock.RecommendFunc == nil {
+		panic("FloorOptimizerMock.RecommendFunc: method is nil but FloorOptimizer.Recommend was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		Key            floor.Key
+		TargetFillRate float64
+	}{
+		Ctx:            ctx,
+		Key:            key,
+		TargetFillRate: targetFillRate,
+	}
+	mock.lockRecommend.Lock()
+	mock.calls.Recommend = append(mock.calls.Recommend, callInfo)
+	mock.lockRecommend.Unlock()
+	return mock.RecommendFunc(ctx, key, targetFillRate)
+}
+
+// RecommendCalls gets all the calls that were made to Recommend.
+// Check the length with:
+//
+//	len(mockedFloorOptimizer.RecommendCalls())
+func (mock *FloorOptimizerMock) RecommendCalls() []struct {
+	Ctx            context.Context
+	Key            floor.Key
+	TargetFillRate float64
+} {
+	var calls []struct {
+		Ctx            context.Context
+		Key            floor.Key
+		TargetFillRate float64
+	}
+	mock.lockRecommend.RLock()
+	calls = mock.calls.Recommend
+	mock.lockRecommend.RUnlock()
+	return calls
+}
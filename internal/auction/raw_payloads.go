@@ -0,0 +1,229 @@
+package auction
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"net/netip"
+	"strings"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+)
+
+// RawPayloadPolicy decides which raw bid requests and responses are logged with bid_request events
+// and redacts user data in them.
+//
+// Sampling is deterministic by auction ID, so either all or none of sampled adapters of an auction are captured.
+// Captured requests are redacted: user and device identifiers, including ones in user.ext, are masked and IPs are
+// truncated. If GDPR applies, IPs, precise geolocation and user data are removed altogether. Responses are redacted
+// the same way wherever identifiers appear in them. Payloads that are not JSON are dropped, because they can't be
+// redacted. Payloads of COPPA requests are never captured.
+type RawPayloadPolicy struct {
+	// SampleRate is the share of auctions with captured payloads, from 0 to 1.
+	SampleRate float64
+	// AppSampleRates override SampleRate for apps by app ID.
+	AppSampleRates map[int64]float64
+	// AdapterSampleRates override SampleRate and AppSampleRates for adapters.
+	AdapterSampleRates map[adapter.Key]float64
+	// CaptureErrors captures payloads of failed bid requests regardless of sample rate.
+	CaptureErrors bool
+}
+
+const redactedValue = "REDACTED"
+
+// sampleBuckets is the precision of sample rates.
+const sampleBuckets = 1_000_000
+
+// redactedDeviceFields are identifiers in OpenRTB device object.
+var redactedDeviceFields = []string{"ifa", "dpidsha1", "dpidmd5", "didsha1", "didmd5", "macsha1", "macmd5"}
+
+// redactedUserFields are identifiers in OpenRTB user object and its ext, where some demands expect buyeruid.
+var redactedUserFields = []string{"id", "buyeruid"}
+
+// redactedResponseFields are identifiers masked anywhere in OpenRTB response, in case demand echoes them.
+var redactedResponseFields = append([]string{"buyeruid"}, redactedDeviceFields...)
+
+// Apply returns raw request and response of the demand response to log. Both are empty if payloads are not captured.
+func (p *RawPayloadPolicy) Apply(req *schema.AuctionRequest, appID int64, result adapters.DemandResponse) (string, string) {
+	if p == nil {
+		return result.RawRequest, result.RawResponse
+	}
+
+	regs := req.GetRegulations()
+	if regs.COPPA {
+		return "", ""
+	}
+	if !p.sampled(req.AdObject.AuctionID, appID, result.DemandID) && !(p.CaptureErrors && result.Error != nil) {
+		return "", ""
+	}
+
+	return redactRawRequest(result.RawRequest, regs.GDPR), redactRawResponse(result.RawResponse, regs.GDPR)
+}
+
+func (p *RawPayloadPolicy) sampled(auctionID string, appID int64, demandID adapter.Key) bool {
+	rate := p.SampleRate
+	if appRate, ok := p.AppSampleRates[appID]; ok {
+		rate = appRate
+	}
+	if adapterRate, ok := p.AdapterSampleRates[demandID]; ok {
+		rate = adapterRate
+	}
+
+	switch {
+	case rate <= 0:
+		return false
+	case rate >= 1:
+		return true
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(auctionID))
+
+	return float64(h.Sum64()%sampleBuckets)/sampleBuckets < rate
+}
+
+// redactRawRequest masks user data in OpenRTB request. Requests that are not JSON objects are dropped, because
+// they can't be redacted.
+func redactRawRequest(raw string, gdpr bool) string {
+	request, ok := decodeRawPayload(raw)
+	if !ok {
+		return ""
+	}
+
+	if device, ok := request["device"].(map[string]any); ok {
+		maskFields(device, redactedDeviceFields)
+		for _, field := range []string{"ip", "ipv6"} {
+			if ip, ok := device[field].(string); ok {
+				if gdpr {
+					delete(device, field)
+				} else {
+					device[field] = truncateIP(ip)
+				}
+			}
+		}
+		if gdpr {
+			redactGeo(device)
+		}
+	}
+
+	if user, ok := request["user"].(map[string]any); ok {
+		maskFields(user, redactedUserFields)
+		ext, _ := user["ext"].(map[string]any)
+		if ext != nil {
+			maskFields(ext, redactedUserFields)
+		}
+		if gdpr {
+			redactGeo(user)
+			for _, field := range []string{"yob", "gender", "data", "eids"} {
+				delete(user, field)
+			}
+			if ext != nil {
+				delete(ext, "eids")
+			}
+		}
+	}
+
+	return encodeRawPayload(request)
+}
+
+// redactRawResponse masks identifiers and IPs anywhere in OpenRTB response. Responses that are not JSON objects
+// are dropped, because they can't be redacted.
+func redactRawResponse(raw string, gdpr bool) string {
+	response, ok := decodeRawPayload(raw)
+	if !ok {
+		return ""
+	}
+
+	redactObjects(response, gdpr)
+
+	return encodeRawPayload(response)
+}
+
+// redactObjects masks identifiers in all objects nested in value.
+func redactObjects(value any, gdpr bool) {
+	switch value := value.(type) {
+	case map[string]any:
+		maskFields(value, redactedResponseFields)
+		for _, field := range []string{"ip", "ipv6"} {
+			if ip, ok := value[field].(string); ok {
+				if gdpr {
+					delete(value, field)
+				} else {
+					value[field] = truncateIP(ip)
+				}
+			}
+		}
+		if gdpr {
+			redactGeo(value)
+		}
+		for _, nested := range value {
+			redactObjects(nested, gdpr)
+		}
+	case []any:
+		for _, nested := range value {
+			redactObjects(nested, gdpr)
+		}
+	}
+}
+
+func decodeRawPayload(raw string) (map[string]any, bool) {
+	if raw == "" {
+		return nil, false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+	var payload map[string]any
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, false
+	}
+
+	return payload, true
+}
+
+// encodeRawPayload encodes redacted payload back to JSON. HTML is not escaped to keep ad markup readable.
+func encodeRawPayload(payload map[string]any) string {
+	var b strings.Builder
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(payload); err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func maskFields(object map[string]any, fields []string) {
+	for _, field := range fields {
+		if value, ok := object[field].(string); ok && value != "" {
+			object[field] = redactedValue
+		}
+	}
+}
+
+func redactGeo(object map[string]any) {
+	if geo, ok := object["geo"].(map[string]any); ok {
+		delete(geo, "lat")
+		delete(geo, "lon")
+	}
+}
+
+// truncateIP zeroes host part of IP: the last octet of IPv4 and the last 80 bits of IPv6 address.
+func truncateIP(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return redactedValue
+	}
+
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return redactedValue
+	}
+
+	return prefix.Addr().String()
+}
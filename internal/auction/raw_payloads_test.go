@@ -0,0 +1,200 @@
+package auction_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prebid/openrtb/v19/openrtb2"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/auction"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/vkads"
+	"github.com/bidon-io/bidon-backend/internal/bidding/openrtb"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+)
+
+func TestRawPayloadPolicy_Apply(t *testing.T) {
+	const rawRequest = `{"id":"req-1","device":{"ifa":"idfa-1","ip":"192.168.1.17","ipv6":"2001:db8:85a3::8a2e:370:7334","geo":{"lat":51.5,"lon":-0.12,"country":"GBR"}},"user":{"id":"user-1","buyeruid":"buyer-1","yob":1990,"eids":[{"source":"x"}]}}`
+	const rawResponse = `{"id":"req-1","seatbid":[]}`
+
+	request := func(regs *schema.Regulations) *schema.AuctionRequest {
+		return &schema.AuctionRequest{
+			BaseRequest: schema.BaseRequest{Regulations: regs},
+			AdObject:    schema.AdObject{AuctionID: "auction-1"},
+		}
+	}
+	result := adapters.DemandResponse{DemandID: adapter.BidmachineKey, RawRequest: rawRequest, RawResponse: rawResponse}
+	failed := result
+	failed.Error = errors.New("timeout")
+
+	tests := []struct {
+		name         string
+		policy       *auction.RawPayloadPolicy
+		req          *schema.AuctionRequest
+		result       adapters.DemandResponse
+		wantRequest  string
+		wantResponse string
+	}{
+		{
+			name:         "no policy",
+			req:          request(nil),
+			result:       result,
+			wantRequest:  rawRequest,
+			wantResponse: rawResponse,
+		},
+		{
+			name:         "redacted",
+			policy:       &auction.RawPayloadPolicy{SampleRate: 1},
+			req:          request(nil),
+			result:       result,
+			wantRequest:  `{"device":{"geo":{"country":"GBR","lat":51.5,"lon":-0.12},"ifa":"REDACTED","ip":"192.168.1.0","ipv6":"2001:db8:85a3::"},"id":"req-1","user":{"buyeruid":"REDACTED","eids":[{"source":"x"}],"id":"REDACTED","yob":1990}}`,
+			wantResponse: rawResponse,
+		},
+		{
+			name:         "redacted with GDPR",
+			policy:       &auction.RawPayloadPolicy{SampleRate: 1},
+			req:          request(&schema.Regulations{GDPR: true}),
+			result:       result,
+			wantRequest:  `{"device":{"geo":{"country":"GBR"},"ifa":"REDACTED"},"id":"req-1","user":{"buyeruid":"REDACTED","id":"REDACTED"}}`,
+			wantResponse: rawResponse,
+		},
+		{
+			name:   "COPPA",
+			policy: &auction.RawPayloadPolicy{SampleRate: 1, CaptureErrors: true},
+			req:    request(&schema.Regulations{COPPA: true}),
+			result: failed,
+		},
+		{
+			name:   "not sampled",
+			policy: &auction.RawPayloadPolicy{SampleRate: 0},
+			req:    request(nil),
+			result: result,
+		},
+		{
+			name:   "not sampled adapter",
+			policy: &auction.RawPayloadPolicy{SampleRate: 1, AdapterSampleRates: map[adapter.Key]float64{adapter.BidmachineKey: 0}},
+			req:    request(nil),
+			result: result,
+		},
+		{
+			name:   "not sampled app",
+			policy: &auction.RawPayloadPolicy{SampleRate: 1, AppSampleRates: map[int64]float64{1: 0}},
+			req:    request(nil),
+			result: result,
+		},
+		{
+			name:         "not sampled error",
+			policy:       &auction.RawPayloadPolicy{SampleRate: 0, CaptureErrors: true},
+			req:          request(nil),
+			result:       failed,
+			wantRequest:  `{"device":{"geo":{"country":"GBR","lat":51.5,"lon":-0.12},"ifa":"REDACTED","ip":"192.168.1.0","ipv6":"2001:db8:85a3::"},"id":"req-1","user":{"buyeruid":"REDACTED","eids":[{"source":"x"}],"id":"REDACTED","yob":1990}}`,
+			wantResponse: rawResponse,
+		},
+		{
+			name:         "request is not JSON",
+			policy:       &auction.RawPayloadPolicy{SampleRate: 1},
+			req:          request(nil),
+			result:       adapters.DemandResponse{DemandID: adapter.BidmachineKey, RawRequest: "<xml/>", RawResponse: rawResponse},
+			wantResponse: rawResponse,
+		},
+		{
+			name:   "user ext identifiers",
+			policy: &auction.RawPayloadPolicy{SampleRate: 1},
+			req:    request(&schema.Regulations{GDPR: true}),
+			result: adapters.DemandResponse{
+				DemandID:   adapter.BidmachineKey,
+				RawRequest: `{"id":"req-1","user":{"ext":{"buyeruid":"buyer-1","consent":"CP","eids":[{"source":"x"}]}}}`,
+			},
+			wantRequest: `{"id":"req-1","user":{"ext":{"buyeruid":"REDACTED","consent":"CP"}}}`,
+		},
+		{
+			name:   "response echoing identifiers",
+			policy: &auction.RawPayloadPolicy{SampleRate: 1},
+			req:    request(nil),
+			result: adapters.DemandResponse{
+				DemandID:    adapter.BidmachineKey,
+				RawResponse: `{"id":"req-1","seatbid":[{"bid":[{"id":"bid-1","adm":"<div>ad</div>","ext":{"buyeruid":"buyer-1","device":{"ifa":"idfa-1","ip":"192.168.1.17"}}}]}]}`,
+			},
+			wantResponse: `{"id":"req-1","seatbid":[{"bid":[{"adm":"<div>ad</div>","ext":{"buyeruid":"REDACTED","device":{"ifa":"REDACTED","ip":"192.168.1.0"}},"id":"bid-1"}]}]}`,
+		},
+		{
+			name:        "response is not JSON",
+			policy:      &auction.RawPayloadPolicy{SampleRate: 1},
+			req:         request(nil),
+			result:      adapters.DemandResponse{DemandID: adapter.BidmachineKey, RawRequest: rawRequest, RawResponse: "user idfa-1 is blocked"},
+			wantRequest: `{"device":{"geo":{"country":"GBR","lat":51.5,"lon":-0.12},"ifa":"REDACTED","ip":"192.168.1.0","ipv6":"2001:db8:85a3::"},"id":"req-1","user":{"buyeruid":"REDACTED","eids":[{"source":"x"}],"id":"REDACTED","yob":1990}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRequest, gotResponse := tt.policy.Apply(tt.req, 1, tt.result)
+
+			if diff := cmp.Diff(tt.wantRequest, gotRequest); diff != "" {
+				t.Errorf("Apply() request mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantResponse, gotResponse); diff != "" {
+				t.Errorf("Apply() response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRawPayloadPolicy_Apply_VKAds(t *testing.T) {
+	auctionRequest := &schema.AuctionRequest{
+		BaseRequest: schema.BaseRequest{User: schema.User{IDG: "idg-1"}},
+		AdObject: schema.AdObject{
+			AuctionID:    "auction-1",
+			Interstitial: &schema.InterstitialAdObject{},
+			Demands:      map[adapter.Key]map[string]any{adapter.VKAdsKey: {"token": "vk-token-1"}},
+		},
+	}
+	vkAds := &vkads.VKAdsAdapter{AppID: "10182906", TagID: "10182906-10192212"}
+	request, err := vkAds.CreateRequest(openrtb.BidRequest{App: &openrtb2.App{}}, auctionRequest)
+	if err != nil {
+		t.Fatalf("CreateRequest() error = %v", err)
+	}
+	rawRequest, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	policy := &auction.RawPayloadPolicy{SampleRate: 1}
+	gotRequest, _ := policy.Apply(auctionRequest, 1, adapters.DemandResponse{DemandID: adapter.VKAdsKey, RawRequest: string(rawRequest)})
+
+	for _, value := range []string{"vk-token-1", "idg-1"} {
+		if strings.Contains(gotRequest, value) {
+			t.Errorf("Apply() request contains %q: %s", value, gotRequest)
+		}
+	}
+	if !strings.Contains(gotRequest, `"ext":{"buyeruid":"REDACTED"}`) {
+		t.Errorf("Apply() request has no redacted buyeruid in user.ext: %s", gotRequest)
+	}
+}
+
+func TestRawPayloadPolicy_Apply_SampleRate(t *testing.T) {
+	policy := &auction.RawPayloadPolicy{SampleRate: 0.25}
+	result := adapters.DemandResponse{DemandID: adapter.BidmachineKey, RawResponse: "{}"}
+
+	captured := 0
+	for i := range 10000 {
+		req := &schema.AuctionRequest{AdObject: schema.AdObject{AuctionID: fmt.Sprintf("auction-%d", i)}}
+		_, first := policy.Apply(req, 1, result)
+		_, second := policy.Apply(req, 1, result)
+		if first != second {
+			t.Fatalf("Apply() is not deterministic for %q", req.AdObject.AuctionID)
+		}
+		if first != "" {
+			captured++
+		}
+	}
+
+	if captured < 2300 || captured > 2700 {
+		t.Errorf("Apply() captured %d of 10000 auctions, want about 2500", captured)
+	}
+}
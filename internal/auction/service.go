@@ -10,11 +10,17 @@ import (
 	"sort"
 	"strconv"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/bidon-io/bidon-backend/internal/ad"
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/bidding"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/bidmachine"
+	"github.com/bidon-io/bidon-backend/internal/floor"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/geocoder"
@@ -28,7 +34,10 @@ type Service struct {
 	AuctionBuilder     AuctionBuilder
 	SegmentMatcher     *segment.Matcher
 	AdapterKeysFetcher AdapterKeysFetcher
+	FloorOptimizer     FloorOptimizer
 	EventLogger        *event.Logger
+	// RawPayloads limits raw bid requests and responses logged with bid_request events. All are logged if nil.
+	RawPayloads *RawPayloadPolicy
 }
 
 type Response struct {
@@ -51,12 +60,16 @@ type ExecutionParams struct {
 	GeoData geocoder.GeoData
 	Log     func(string)
 	LogErr  func(err error)
+	// DryRun runs the auction on recorded DSP responses without logging events and other side effects if set.
+	DryRun *bidding.DryRun
+	// Trace is filled with details of the auction if set.
+	Trace *Trace
 }
 
-//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out mocks/service_mocks.go -pkg mocks . ConfigFetcher AuctionBuilder AdapterKeysFetcher
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out mocks/service_mocks.go -pkg mocks . ConfigFetcher AuctionBuilder AdapterKeysFetcher FloorOptimizer
 
 type ConfigFetcher interface {
-	Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*Config, error)
+	Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version, unitID string) (*Config, error)
 	FetchByUIDCached(ctx context.Context, appID int64, id, uid string) *Config
 }
 
@@ -64,6 +77,10 @@ type AdapterKeysFetcher interface {
 	FetchEnabledAdapterKeys(ctx context.Context, appID int64, adapterKeys []adapter.Key) ([]adapter.Key, error)
 }
 
+type FloorOptimizer interface {
+	Recommend(ctx context.Context, key floor.Key, targetFillRate float64) (floor.Recommendation, error)
+}
+
 type AuctionBuilder interface { //nolint:revive
 	Build(ctx context.Context, params *BuildParams) (*Result, error)
 }
@@ -74,9 +91,17 @@ const (
 
 var adCacheAdaptersFilter = store.NewAdCacheAdaptersFilter()
 
+var tracer = otel.Tracer("github.com/bidon-io/bidon-backend/internal/auction")
+
 func (s *Service) Run(ctx context.Context, params *ExecutionParams) (*Response, error) {
 	req := params.Req
 
+	ctx, span := tracer.Start(ctx, "auction.Run", trace.WithAttributes(
+		attribute.Int64("app.id", params.App.ID),
+		attribute.String("ad.type", string(req.AdType)),
+		attribute.String("auction.id", req.AdObject.AuctionID),
+	))
+
 	var auctionConfig *Config
 	var auctionResult *Result
 	var adUnitsMap *AdUnitsMap
@@ -84,16 +109,29 @@ func (s *Service) Run(ctx context.Context, params *ExecutionParams) (*Response,
 
 	// Ensure events are always logged, even on errors
 	defer func() {
-		s.logEvents(req, params, auctionConfig, auctionResult, adUnitsMap, err)
+		endSpan(span, err)
+		if params.Trace != nil && err != nil {
+			params.Trace.Error = err.Error()
+		}
+		if params.DryRun == nil {
+			s.logEvents(req, params, auctionConfig, auctionResult, adUnitsMap, err)
+		}
 	}()
 
 	segmentParams := &segment.Params{
-		Country: params.Country,
-		Ext:     req.Segment.Ext,
-		AppID:   params.App.ID,
-	}
-
-	sgmnt := s.SegmentMatcher.Match(ctx, segmentParams)
+		Country:       params.Country,
+		Ext:           req.Segment.Ext,
+		AppID:         params.App.ID,
+		DeviceOS:      req.Device.OS,
+		SDKVersion:    req.App.SDKVersion,
+		AppVersion:    req.App.Version,
+		SessionUptime: req.Session.Uptime(),
+	}
+
+	segmentCtx, segmentSpan := tracer.Start(ctx, "auction.MatchSegment")
+	sgmnt := s.SegmentMatcher.Match(segmentCtx, segmentParams)
+	segmentSpan.SetAttributes(attribute.Int64("segment.id", sgmnt.ID))
+	segmentSpan.End()
 	req.Segment.ID = sgmnt.StringID()
 	req.Segment.UID = sgmnt.UID
 
@@ -103,34 +141,39 @@ func (s *Service) Run(ctx context.Context, params *ExecutionParams) (*Response,
 		req.Adapters.Keys(),
 		req.AdCache,
 	)
+	cacheFilteredKeys := adapterKeys
 
-	adapterKeys, err = s.AdapterKeysFetcher.FetchEnabledAdapterKeys(ctx, params.App.ID, adapterKeys)
+	adaptersCtx, adaptersSpan := tracer.Start(ctx, "auction.FetchEnabledAdapters")
+	adapterKeys, err = s.AdapterKeysFetcher.FetchEnabledAdapterKeys(adaptersCtx, params.App.ID, adapterKeys)
+	endSpan(adaptersSpan, err)
 	if err != nil {
 		return nil, err
 	}
-
-	if req.AdObject.AuctionKey != "" {
-		publicUID, success := new(big.Int).SetString(req.AdObject.AuctionKey, 32)
-		if !success {
-			err = sdkapi.ErrInvalidAuctionKey
-			return nil, err
-		}
-
-		auctionConfig = s.ConfigFetcher.FetchByUIDCached(ctx, params.App.ID, "0", publicUID.String())
-		if auctionConfig == nil {
-			err = sdkapi.ErrInvalidAuctionKey
-			return nil, err
-		}
-	} else {
-		auctionConfig, err = s.ConfigFetcher.Match(ctx, params.App.ID, req.AdType, sgmnt.ID, "v2")
+	if params.Trace != nil {
+		params.Trace.Segment = sgmnt
+		params.Trace.Adapters.Requested = req.Adapters.Keys()
+		params.Trace.Adapters.AdCacheFiltered = without(req.Adapters.Keys(), cacheFilteredKeys)
+		params.Trace.Adapters.Disabled = without(cacheFilteredKeys, adapterKeys)
 	}
+
+	auctionConfig, err = s.matchConfig(ctx, params, sgmnt.ID)
 	if err != nil {
-		err = sdkapi.ErrNoAdsFound
 		return nil, err
 	}
 	req.AdObject.AuctionConfigurationID = auctionConfig.ID
 	req.AdObject.AuctionConfigurationUID = auctionConfig.UID
-	req.AdObject.PriceFloor = priceFloor(req, auctionConfig)
+	requestPriceFloor := req.AdObject.PriceFloor
+	dynamicPriceFloor := s.dynamicPriceFloor(ctx, params, auctionConfig)
+	req.AdObject.PriceFloor = priceFloor(req, auctionConfig, dynamicPriceFloor)
+	if params.Trace != nil {
+		params.Trace.Configuration = auctionConfig
+		params.Trace.PriceFloor = PriceFloorTrace{
+			Request:       requestPriceFloor,
+			Configuration: auctionConfig.PriceFloor,
+			Dynamic:       dynamicPriceFloor,
+			Final:         req.AdObject.PriceFloor,
+		}
+	}
 
 	bp := &BuildParams{
 		App:                  params.App,
@@ -144,6 +187,8 @@ func (s *Service) Run(ctx context.Context, params *ExecutionParams) (*Response,
 		GeoData:              params.GeoData,
 		AuctionKey:           req.AdObject.AuctionKey,
 		AuctionConfiguration: auctionConfig,
+		DryRun:               params.DryRun,
+		Trace:                params.Trace,
 	}
 
 	auctionResult, err = s.AuctionBuilder.Build(ctx, bp)
@@ -156,43 +201,92 @@ func (s *Service) Run(ctx context.Context, params *ExecutionParams) (*Response,
 
 	adUnitsMap = buildAdUnitsMap(auctionResult.AdUnits)
 
-	return s.buildResponse(req, auctionResult, adUnitsMap, params.App)
+	return s.buildResponse(req, auctionResult, adUnitsMap, params.App, params.Trace)
+}
+
+// matchConfig returns auction configuration by auction key of the request if set, or matches it by segment.
+func (s *Service) matchConfig(ctx context.Context, params *ExecutionParams, segmentID int64) (*Config, error) {
+	ctx, span := tracer.Start(ctx, "auction.MatchConfig")
+
+	auctionConfig, err := s.fetchConfig(ctx, params, segmentID)
+	if err == nil {
+		span.SetAttributes(attribute.Int64("auction_configuration.id", auctionConfig.ID))
+	}
+	endSpan(span, err)
+
+	return auctionConfig, err
 }
 
-// Auction keys that should have disabled price floors when using custom adapters
-var disabledFloorAuctionKeys = [...]string{
-	"1LOQ1LROG0000", // Inter
-	"1LOQ2BFG00000", // Banner
-	"1LOQ2KLES0400", // Rewarded
-	"1LPGM6QBC0000", // Banner
-	"1LPGMABLK0000", // Interstitial
-	"1LRBRTLCS0400", // Interstitial
-	"1LVHHK37O0400", // Banner
-	"1LVHHK51K0400", // Interstitial
-	"1LVHEVUJO0400", // Interstitial
-	"1LQP712M00000", // Banner
-	"1LQP75UU00400", // Interstitial
-	"1LQP7A4UG0400", // Rewarded
+func (s *Service) fetchConfig(ctx context.Context, params *ExecutionParams, segmentID int64) (*Config, error) {
+	req := params.Req
+
+	if req.AdObject.AuctionKey != "" {
+		publicUID, success := new(big.Int).SetString(req.AdObject.AuctionKey, 32)
+		if !success {
+			return nil, sdkapi.ErrInvalidAuctionKey
+		}
+
+		auctionConfig := s.ConfigFetcher.FetchByUIDCached(ctx, params.App.ID, "0", publicUID.String())
+		if auctionConfig == nil {
+			return nil, sdkapi.ErrInvalidAuctionKey
+		}
+
+		return auctionConfig.WithExperimentVariant(req.GetExperimentUnitID()), nil
+	}
+
+	auctionConfig, err := s.ConfigFetcher.Match(ctx, params.App.ID, req.AdType, segmentID, "v2", req.GetExperimentUnitID())
+	if err != nil {
+		return nil, sdkapi.ErrNoAdsFound
+	}
+
+	return auctionConfig, nil
+}
+
+// dynamicPriceFloor returns floor recommended by FloorOptimizer if auction configuration enables it, 0 otherwise.
+func (s *Service) dynamicPriceFloor(ctx context.Context, params *ExecutionParams, auctionConfig *Config) float64 {
+	policy := auctionConfig.FloorPolicy
+	if !policy.DynamicFloor || s.FloorOptimizer == nil {
+		return 0
+	}
+
+	key := floor.Key{
+		AppID:                  params.App.ID,
+		AdType:                 params.Req.AdType,
+		Country:                params.GeoData.CountryCode,
+		AuctionConfigurationID: auctionConfig.ID,
+	}
+	recommendation, err := s.FloorOptimizer.Recommend(ctx, key, policy.DynamicFloorTargetFillRate)
+	if err != nil {
+		params.LogErr(fmt.Errorf("recommend dynamic price floor: %v", err))
+		return 0
+	}
+
+	return recommendation.Floor
 }
 
-func priceFloor(req *schema.AuctionRequest, auctionConfig *Config) float64 {
-	// Check if price floor should be disabled for this auction key with custom adapter
-	isCustomAdapter := slices.Contains(adapter.CustomAdapters[:], req.GetMediator())
-	if isCustomAdapter && slices.Contains(disabledFloorAuctionKeys[:], req.AdObject.AuctionKey) {
-		return 0 // Disable price floor for specified auction keys with custom adapters
+func priceFloor(req *schema.AuctionRequest, auctionConfig *Config, dynamicFloor float64) float64 {
+	policy := auctionConfig.FloorPolicy
+	mediator := req.GetMediator()
+
+	if mediator != "" && slices.Contains(policy.IgnoreFloorForMediators, mediator) {
+		return 0
 	}
 
 	// Default floor logic
 	priceFloor := req.AdObject.PriceFloor
-	for _, cacheObject := range req.AdCache {
-		priceFloor = math.Max(priceFloor, cacheObject.Price)
+	if !policy.IgnoreAdCachePrices {
+		for _, cacheObject := range req.AdCache {
+			priceFloor = math.Max(priceFloor, cacheObject.Price)
+		}
 	}
 	priceFloor = math.Max(auctionConfig.PriceFloor, priceFloor)
+	priceFloor = math.Max(dynamicFloor, priceFloor)
 
 	// Custom Adapter floor logic
 	// Check if previous auction price is higher than the current price floor
+	isCustomAdapter := slices.Contains(adapter.CustomAdapters[:], mediator)
 	prevFloor := req.GetPrevAuctionPrice()
-	if prevFloor != nil && isCustomAdapter {
+	if prevFloor != nil && isCustomAdapter && !policy.IgnorePrevAuctionPrice {
 		priceFloor = math.Max(*prevFloor, priceFloor)
 	}
 
@@ -204,6 +298,7 @@ func (s *Service) buildResponse(
 	auctionResult *Result,
 	adUnitsMap *AdUnitsMap,
 	app *sdkapi.App,
+	trace *Trace,
 ) (*Response, error) {
 	adObject := req.AdObject
 	response := Response{
@@ -224,9 +319,15 @@ func (s *Service) buildResponse(
 		isCOPPA = req.Regulations.COPPA
 	}
 
+	// Deal bids are ranked by deal priority first, the rest of ad units have priority 0
+	rankedAdUnits := make([]rankedAdUnit, 0)
+
 	// Store CPM AdUnits from AuctionConfiguration
 	for _, adUnit := range *auctionResult.CPMAdUnits {
 		if isCOPPA && adapter.IsDisabledForCOPPA(adapter.Key(adUnit.DemandID)) {
+			if trace != nil {
+				trace.COPPAFiltered = append(trace.COPPAFiltered, adUnit.DemandID)
+			}
 			continue
 		}
 		if adUnit.DemandID == string(adapter.BidmachineKey) {
@@ -234,7 +335,7 @@ func (s *Service) buildResponse(
 			addBlockingFields(adUnit.Extra, app)
 		}
 
-		response.AdUnits = append(response.AdUnits, adUnit)
+		rankedAdUnits = append(rankedAdUnits, rankedAdUnit{AdUnit: adUnit})
 	}
 
 	// Store Bids AS RTB AdUnits from BiddingAuctionResult
@@ -244,21 +345,47 @@ func (s *Service) buildResponse(
 			continue
 		}
 
+		deal := FindDeal(auctionResult.Deals, bidResponse)
+		if deal != nil && bidResponse.Price() < deal.BidFloor {
+			response.NoBids = append(response.NoBids, *adUnit)
+			continue
+		}
+
 		if bidResponse.IsBid() && bidResponse.Price() > adObject.PriceFloor {
-			response.AdUnits = append(response.AdUnits, *adUnit)
+			ranked := rankedAdUnit{AdUnit: *adUnit}
+			if deal != nil {
+				ranked.dealPriority = deal.Priority
+			}
+			rankedAdUnits = append(rankedAdUnits, ranked)
 		} else {
 			response.NoBids = append(response.NoBids, *adUnit)
 		}
 	}
 
-	// Sort AdUnits by price
-	sort.Slice(response.AdUnits, func(i, j int) bool {
-		return response.AdUnits[i].GetPriceFloor() > response.AdUnits[j].GetPriceFloor()
+	// Sort AdUnits by deal priority, then by price
+	sort.SliceStable(rankedAdUnits, func(i, j int) bool {
+		if rankedAdUnits[i].dealPriority != rankedAdUnits[j].dealPriority {
+			return rankedAdUnits[i].dealPriority > rankedAdUnits[j].dealPriority
+		}
+		return rankedAdUnits[i].GetPriceFloor() > rankedAdUnits[j].GetPriceFloor()
 	})
+	for _, ranked := range rankedAdUnits {
+		response.AdUnits = append(response.AdUnits, ranked.AdUnit)
+	}
+	if trace != nil {
+		trace.AdUnits = response.AdUnits
+		trace.NoBids = response.NoBids
+	}
 
 	return &response, nil
 }
 
+// rankedAdUnit is an ad unit of the response with priority of the deal it was bid for.
+type rankedAdUnit struct {
+	AdUnit
+	dealPriority int32
+}
+
 func (s *Service) logEvents(
 	req *schema.AuctionRequest,
 	params *ExecutionParams,
@@ -303,13 +430,21 @@ func (s *Service) logEvents(
 
 	// Add bidding events if available
 	if auctionResult != nil && auctionResult.BiddingAuctionResult != nil && adUnitsMap != nil {
-		events = prepareBiddingEvents(req, params, auctionResult.BiddingAuctionResult, adUnitsMap)
+		events = prepareBiddingEvents(req, params, auctionResult.BiddingAuctionResult, adUnitsMap, s.RawPayloads)
 	}
 
 	// Add auction request event
 	aucRequestEvent := prepareAuctionRequestEvent(req, params, auc, auctionConfigurationUID, auctionErr)
 	events = append(events, aucRequestEvent)
 
+	// Stamp experiment variant the auction configuration was resolved to
+	if auctionConfig != nil {
+		for _, ev := range events {
+			ev.ExperimentID = auctionConfig.ExperimentID
+			ev.ExperimentVariantID = auctionConfig.ExperimentVariantID
+		}
+	}
+
 	// Log all events
 	for _, ev := range events {
 		s.EventLogger.Log(ev, func(err error) {
@@ -331,6 +466,8 @@ func convertBidToAdUnit(req *schema.AuctionRequest, demandResponse adapters.Dema
 	ext := map[string]any{}
 	if demandResponse.IsBid() {
 		ext = buildDemandExt(req, demandResponse)
+		// SDK sends bid_id back in stats, show and win requests to identify the winning bid
+		ext["bid_id"] = demandResponse.Bid.ID
 	}
 
 	for key, value := range storeAdUnit.Extra {
@@ -365,6 +502,7 @@ func prepareAuctionRequestEvent(
 
 	adRequestParams := event.AdRequestParams{
 		EventType:               "auction_request",
+		AppID:                   params.App.ID,
 		AdType:                  string(req.AdType),
 		AdFormat:                string(req.AdObject.Format()),
 		AuctionID:               req.AdObject.AuctionID,
@@ -391,6 +529,7 @@ func prepareBiddingEvents(
 	params *ExecutionParams,
 	auctionResult *bidding.AuctionResult,
 	adUnitsMap *AdUnitsMap,
+	rawPayloads *RawPayloadPolicy,
 ) []*event.AdEvent {
 	adObject := req.AdObject
 	auctionConfigurationUID, err := strconv.Atoi(adObject.AuctionConfigurationUID)
@@ -399,6 +538,8 @@ func prepareBiddingEvents(
 	}
 
 	events := make([]*event.AdEvent, 0, len(auctionResult.Bids))
+	// Adapter may return several bids for one request, bid_request event is logged once per request
+	requested := make(map[string]bool, len(auctionResult.Bids))
 	for _, result := range auctionResult.Bids {
 		adUnit, _ := selectAdUnit(result, adUnitsMap)
 		adUnitUID := int64(0)
@@ -409,14 +550,26 @@ func prepareBiddingEvents(
 			adUnitLabel = adUnit.Label
 		}
 
+		requestKey := string(result.DemandID) + ":" + result.RequestID
+		if result.RequestID != "" && requested[requestKey] {
+			if result.IsBid() {
+				events = append(events, newBidEvent(req, params, result, adUnitUID, adUnitLabel, auctionConfigurationUID))
+			}
+			continue
+		}
+		requested[requestKey] = true
+
+		rawRequest, rawResponse := rawPayloads.Apply(req, params.App.ID, result)
+
 		adRequestParams := event.AdRequestParams{
 			EventType:               "bid_request",
+			AppID:                   params.App.ID,
 			AdType:                  string(req.AdType),
 			AdFormat:                string(req.AdObject.Format()),
 			AuctionID:               adObject.AuctionID,
 			AuctionConfigurationID:  adObject.AuctionConfigurationID,
 			AuctionConfigurationUID: int64(auctionConfigurationUID),
-			Status:                  fmt.Sprint(result.Status),
+			Status:                  bidRequestStatus(result),
 			ImpID:                   "",
 			DemandID:                string(result.DemandID),
 			AdUnitUID:               adUnitUID,
@@ -424,8 +577,8 @@ func prepareBiddingEvents(
 			ECPM:                    result.Price(),
 			PriceFloor:              adObject.PriceFloor,
 			Bidding:                 true,
-			RawRequest:              result.RawRequest,
-			RawResponse:             result.RawResponse,
+			RawRequest:              rawRequest,
+			RawResponse:             rawResponse,
 			Error:                   result.ErrorMessage(),
 			TimingMap: event.TimingMap{
 				"bid":   {result.StartTS, result.EndTS},
@@ -437,41 +590,67 @@ func prepareBiddingEvents(
 		}
 		events = append(events, event.NewAdEvent(&req.BaseRequest, adRequestParams, params.GeoData))
 		if result.IsBid() {
-			adRequestParams = event.AdRequestParams{
-				EventType:               "bid",
-				AdType:                  string(req.AdType),
-				AdFormat:                string(adObject.Format()),
-				AuctionID:               adObject.AuctionID,
-				AuctionConfigurationID:  adObject.AuctionConfigurationID,
-				AuctionConfigurationUID: int64(auctionConfigurationUID),
-				Status:                  "SUCCESS",
-				ImpID:                   "",
-				DemandID:                string(result.DemandID),
-				AdUnitUID:               adUnitUID,
-				AdUnitLabel:             adUnitLabel,
-				ECPM:                    result.Bid.Price,
-				PriceFloor:              adObject.PriceFloor,
-				Bidding:                 true,
-				TimingMap: event.TimingMap{
-					"bid": {result.StartTS, result.EndTS},
-				},
-			}
-			events = append(events, event.NewAdEvent(&req.BaseRequest, adRequestParams, params.GeoData))
+			events = append(events, newBidEvent(req, params, result, adUnitUID, adUnitLabel, auctionConfigurationUID))
 		}
 	}
 
 	return events
 }
 
+func newBidEvent(
+	req *schema.AuctionRequest,
+	params *ExecutionParams,
+	result adapters.DemandResponse,
+	adUnitUID int64,
+	adUnitLabel string,
+	auctionConfigurationUID int,
+) *event.AdEvent {
+	adObject := req.AdObject
+	adRequestParams := event.AdRequestParams{
+		EventType:               "bid",
+		AppID:                   params.App.ID,
+		AdType:                  string(req.AdType),
+		AdFormat:                string(adObject.Format()),
+		AuctionID:               adObject.AuctionID,
+		AuctionConfigurationID:  adObject.AuctionConfigurationID,
+		AuctionConfigurationUID: int64(auctionConfigurationUID),
+		Status:                  "SUCCESS",
+		ImpID:                   "",
+		DemandID:                string(result.DemandID),
+		AdUnitUID:               adUnitUID,
+		AdUnitLabel:             adUnitLabel,
+		ECPM:                    result.Bid.Price,
+		PriceFloor:              adObject.PriceFloor,
+		Bidding:                 true,
+		DealID:                  result.Bid.DealID,
+		TimingMap: event.TimingMap{
+			"bid": {result.StartTS, result.EndTS},
+		},
+	}
+
+	return event.NewAdEvent(&req.BaseRequest, adRequestParams, params.GeoData)
+}
+
+// bidRequestStatus returns HTTP status of the bid request, or CIRCUIT_OPEN if adapter was skipped by circuit breaker.
+func bidRequestStatus(result adapters.DemandResponse) string {
+	if errors.Is(result.Error, bidding.ErrCircuitOpen) {
+		return "CIRCUIT_OPEN"
+	}
+
+	return fmt.Sprint(result.Status)
+}
+
+// selectAdUnit returns RTB ad unit of the demand response: the one the bid is made for if adapter requested several
+// ad units, or the first ad unit of the demand.
 func selectAdUnit(demandResponse adapters.DemandResponse, adUnitsMap *AdUnitsMap) (*AdUnit, error) {
 	adUnits, err := adUnitsMap.All(demandResponse.DemandID, schema.RTBBidType)
 	if err != nil {
 		return nil, err
 	}
 
-	if demandResponse.DemandID == adapter.AmazonKey {
+	if demandResponse.IsBid() && demandResponse.Bid.AdUnitUID != "" {
 		for _, adUnit := range adUnits {
-			if demandResponse.SlotUUID == adUnit.Extra["slot_uuid"] {
+			if adUnit.UID == demandResponse.Bid.AdUnitUID {
 				return &adUnit, nil
 			}
 		}
@@ -485,7 +664,7 @@ func selectAdUnit(demandResponse adapters.DemandResponse, adUnitsMap *AdUnitsMap
 
 func buildDemandExt(req *schema.AuctionRequest, demandResponse adapters.DemandResponse) map[string]any {
 	switch demandResponse.DemandID {
-	case adapter.AmazonKey:
+	case adapter.AmazonKey, adapter.VKAdsKey:
 		return map[string]any{}
 	case adapter.MobileFuseKey:
 		return map[string]any{
@@ -495,10 +674,6 @@ func buildDemandExt(req *schema.AuctionRequest, demandResponse adapters.DemandRe
 		return map[string]any{
 			"signaldata": demandResponse.Bid.Signaldata,
 		}
-	case adapter.VKAdsKey:
-		return map[string]any{
-			"bid_id": demandResponse.Bid.ID,
-		}
 	case adapter.BidmachineKey:
 		extra := map[string]any{
 			"payload": demandResponse.Bid.Payload,
@@ -510,9 +685,14 @@ func buildDemandExt(req *schema.AuctionRequest, demandResponse adapters.DemandRe
 		}
 		return extra
 	default:
-		return map[string]any{
+		extra := map[string]any{
 			"payload": demandResponse.Bid.Payload,
 		}
+		// Generic OpenRTB adapter may return signaldata too
+		if demandResponse.Bid.Signaldata != "" {
+			extra["signaldata"] = demandResponse.Bid.Signaldata
+		}
+		return extra
 	}
 }
 
@@ -528,6 +708,15 @@ func addBlockingFields(ext map[string]any, app *sdkapi.App) {
 	}
 }
 
+// endSpan records err on span if any and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
 func auctionTimeout(conf *Config) int {
 	if conf.Timeout > 0 {
 		return conf.Timeout
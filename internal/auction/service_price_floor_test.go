@@ -11,6 +11,7 @@ func TestPriceFloor(t *testing.T) {
 		name          string
 		req           *schema.AuctionRequest
 		auctionConfig *Config
+		dynamicFloor  float64
 		expected      float64
 	}{
 		{
@@ -167,14 +168,13 @@ func TestPriceFloor(t *testing.T) {
 			expected: 0.05, // Should use calculated floor since previous auction price is lower
 		},
 		{
-			name: "Disabled floor - Custom adapter (max) with disabled auction key",
+			name: "Floor policy - ignore floor for mediator (max)",
 			req: &schema.AuctionRequest{
 				AdObject: schema.AdObject{
-					AuctionKey: "1LOQ1LROG0000", // Inter - should disable floor
 					PriceFloor: 0.01,
 				},
 				BaseRequest: schema.BaseRequest{
-					Ext: `{"mediator":"max"}`,
+					Ext: `{"mediator":"max","previous_auction_price":0.25}`,
 				},
 				AdCache: []schema.AdCacheObject{
 					{Price: 0.02},
@@ -182,14 +182,16 @@ func TestPriceFloor(t *testing.T) {
 			},
 			auctionConfig: &Config{
 				PriceFloor: 0.05,
+				FloorPolicy: FloorPolicy{
+					IgnoreFloorForMediators: []string{"max", "level_play"},
+				},
 			},
-			expected: 0.0, // Should return 0 (disabled floor) for specified auction key with custom adapter
+			expected: 0.0, // Should return 0 (disabled floor) for listed mediator
 		},
 		{
-			name: "Disabled floor - Custom adapter (level_play) with disabled auction key",
+			name: "Floor policy - ignore floor for mediator (level_play)",
 			req: &schema.AuctionRequest{
 				AdObject: schema.AdObject{
-					AuctionKey: "1LOQ2BFG00000", // Banner - should disable floor
 					PriceFloor: 0.01,
 				},
 				BaseRequest: schema.BaseRequest{
@@ -201,18 +203,20 @@ func TestPriceFloor(t *testing.T) {
 			},
 			auctionConfig: &Config{
 				PriceFloor: 0.05,
+				FloorPolicy: FloorPolicy{
+					IgnoreFloorForMediators: []string{"max", "level_play"},
+				},
 			},
-			expected: 0.0, // Should return 0 (disabled floor) for specified auction key with custom adapter
+			expected: 0.0, // Should return 0 (disabled floor) for listed mediator
 		},
 		{
-			name: "Disabled floor - Custom adapter (max) with rewarded auction key",
+			name: "Floor policy - mediator not listed",
 			req: &schema.AuctionRequest{
 				AdObject: schema.AdObject{
-					AuctionKey: "1LOQ2KLES0400", // Rewarded - should disable floor
 					PriceFloor: 0.01,
 				},
 				BaseRequest: schema.BaseRequest{
-					Ext: `{"mediator":"max"}`,
+					Ext: `{"mediator":"level_play"}`,
 				},
 				AdCache: []schema.AdCacheObject{
 					{Price: 0.02},
@@ -220,37 +224,38 @@ func TestPriceFloor(t *testing.T) {
 			},
 			auctionConfig: &Config{
 				PriceFloor: 0.05,
+				FloorPolicy: FloorPolicy{
+					IgnoreFloorForMediators: []string{"max"},
+				},
 			},
-			expected: 0.0, // Should return 0 (disabled floor) for specified auction key with custom adapter
+			expected: 0.05, // Should use normal floor logic since mediator is not listed
 		},
 		{
-			name: "No disabled floor - Non-custom adapter with disabled auction key",
+			name: "Floor policy - no mediator",
 			req: &schema.AuctionRequest{
 				AdObject: schema.AdObject{
-					AuctionKey: "1LOQ1LROG0000", // Inter key but non-custom adapter
 					PriceFloor: 0.01,
 				},
-				BaseRequest: schema.BaseRequest{
-					Ext: `{"mediator":"regular"}`,
-				},
 				AdCache: []schema.AdCacheObject{
 					{Price: 0.02},
 				},
 			},
 			auctionConfig: &Config{
 				PriceFloor: 0.05,
+				FloorPolicy: FloorPolicy{
+					IgnoreFloorForMediators: []string{"max", "level_play"},
+				},
 			},
-			expected: 0.05, // Should use normal floor logic since adapter is not custom
+			expected: 0.05, // Should use normal floor logic since request has no mediator
 		},
 		{
-			name: "No disabled floor - Custom adapter with non-disabled auction key",
+			name: "Floor policy - ignore previous auction price",
 			req: &schema.AuctionRequest{
 				AdObject: schema.AdObject{
-					AuctionKey: "SOMEOTHERKEY", // Different auction key
 					PriceFloor: 0.01,
 				},
 				BaseRequest: schema.BaseRequest{
-					Ext: `{"mediator":"max"}`,
+					Ext: `{"mediator":"max","previous_auction_price":0.25}`,
 				},
 				AdCache: []schema.AdCacheObject{
 					{Price: 0.02},
@@ -258,18 +263,36 @@ func TestPriceFloor(t *testing.T) {
 			},
 			auctionConfig: &Config{
 				PriceFloor: 0.05,
+				FloorPolicy: FloorPolicy{
+					IgnorePrevAuctionPrice: true,
+				},
 			},
-			expected: 0.05, // Should use normal floor logic since auction key is not in disabled list
+			expected: 0.05, // Should ignore previous auction price
 		},
 		{
-			name: "No disabled floor - Custom adapter with empty auction key",
+			name: "Floor policy - ignore ad cache prices",
 			req: &schema.AuctionRequest{
 				AdObject: schema.AdObject{
-					AuctionKey: "", // Empty auction key
 					PriceFloor: 0.01,
 				},
-				BaseRequest: schema.BaseRequest{
-					Ext: `{"mediator":"max"}`,
+				AdCache: []schema.AdCacheObject{
+					{Price: 0.02},
+					{Price: 0.07},
+				},
+			},
+			auctionConfig: &Config{
+				PriceFloor: 0.05,
+				FloorPolicy: FloorPolicy{
+					IgnoreAdCachePrices: true,
+				},
+			},
+			expected: 0.05, // Should ignore ad cache prices
+		},
+		{
+			name: "Dynamic floor higher than calculated floor",
+			req: &schema.AuctionRequest{
+				AdObject: schema.AdObject{
+					PriceFloor: 0.01,
 				},
 				AdCache: []schema.AdCacheObject{
 					{Price: 0.02},
@@ -278,7 +301,27 @@ func TestPriceFloor(t *testing.T) {
 			auctionConfig: &Config{
 				PriceFloor: 0.05,
 			},
-			expected: 0.05, // Should use normal floor logic since auction key is empty
+			dynamicFloor: 0.3,
+			expected:     0.3, // Should use dynamic floor
+		},
+		{
+			name: "Dynamic floor with ignored mediator",
+			req: &schema.AuctionRequest{
+				AdObject: schema.AdObject{
+					PriceFloor: 0.01,
+				},
+				BaseRequest: schema.BaseRequest{
+					Ext: `{"mediator":"max"}`,
+				},
+			},
+			auctionConfig: &Config{
+				PriceFloor: 0.05,
+				FloorPolicy: FloorPolicy{
+					IgnoreFloorForMediators: []string{"max"},
+				},
+			},
+			dynamicFloor: 0.3,
+			expected:     0.0, // Should return 0 (disabled floor) for listed mediator
 		},
 	}
 
@@ -287,7 +330,7 @@ func TestPriceFloor(t *testing.T) {
 			// Need to parse the Ext field to populate extData
 			tt.req.NormalizeValues()
 
-			result := priceFloor(tt.req, tt.auctionConfig)
+			result := priceFloor(tt.req, tt.auctionConfig, tt.dynamicFloor)
 			if result != tt.expected {
 				t.Errorf("priceFloor() = %v, want %v", result, tt.expected)
 			}
@@ -15,6 +15,7 @@ import (
 	"github.com/bidon-io/bidon-backend/internal/auction/mocks"
 	"github.com/bidon-io/bidon-backend/internal/bidding"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/floor"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/event/engine"
@@ -85,7 +86,7 @@ func TestService_Run(t *testing.T) {
 		FetchByUIDCachedFunc: func(_ context.Context, _ int64, _, _ string) *auction.Config {
 			return auctionConfig
 		},
-		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _ string) (*auction.Config, error) {
+		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _, _ string) (*auction.Config, error) {
 			return auctionConfig, nil
 		},
 	}
@@ -294,7 +295,7 @@ func TestService_Run(t *testing.T) {
 		service.EventLogger = &event.Logger{Engine: mockEventLogger}
 
 		// Make ConfigFetcher.Match return an error
-		configFetcher.MatchFunc = func(_ context.Context, _ int64, _ ad.Type, _ int64, _ string) (*auction.Config, error) {
+		configFetcher.MatchFunc = func(_ context.Context, _ int64, _ ad.Type, _ int64, _, _ string) (*auction.Config, error) {
 			return nil, errors.New("config match failed")
 		}
 
@@ -349,7 +350,7 @@ func TestService_Run(t *testing.T) {
 		service.EventLogger = &event.Logger{Engine: mockEventLogger}
 
 		// Reset ConfigFetcher to success
-		configFetcher.MatchFunc = func(_ context.Context, _ int64, _ ad.Type, _ int64, _ string) (*auction.Config, error) {
+		configFetcher.MatchFunc = func(_ context.Context, _ int64, _ ad.Type, _ int64, _, _ string) (*auction.Config, error) {
 			return auctionConfig, nil
 		}
 
@@ -437,7 +438,7 @@ func TestService_Run(t *testing.T) {
 		}
 
 		// Reset all mocks to success
-		configFetcher.MatchFunc = func(_ context.Context, _ int64, _ ad.Type, _ int64, _ string) (*auction.Config, error) {
+		configFetcher.MatchFunc = func(_ context.Context, _ int64, _ ad.Type, _ int64, _, _ string) (*auction.Config, error) {
 			return auctionConfig, nil
 		}
 		auctionBuilder.BuildFunc = func(_ context.Context, _ *auction.BuildParams) (*auction.Result, error) {
@@ -491,6 +492,103 @@ func TestService_Run(t *testing.T) {
 	})
 }
 
+func TestService_Run_DynamicPriceFloor(t *testing.T) {
+	ctx := context.Background()
+	auctionConfig := &auction.Config{
+		ID:         3,
+		UID:        "config_uid",
+		PriceFloor: 0.05,
+		Timeout:    15000,
+		FloorPolicy: auction.FloorPolicy{
+			DynamicFloor:               true,
+			DynamicFloorTargetFillRate: 0.8,
+		},
+	}
+	request := &schema.AuctionRequest{
+		AdObject: schema.AdObject{
+			PriceFloor: 0.01,
+		},
+		BaseRequest: schema.BaseRequest{
+			Device: schema.Device{
+				OS:   "android",
+				Type: "phone",
+			},
+		},
+		AdType: ad.BannerType,
+	}
+	segmentMatcher := &segment.Matcher{
+		Fetcher: &segmentmocks.FetcherMock{
+			FetchCachedFunc: func(_ context.Context, _ int64) ([]segment.Segment, error) {
+				return []segment.Segment{{
+					ID:      1,
+					UID:     "1",
+					Filters: []segment.Filter{{Type: "country", Operator: "IN", Values: []string{"US"}}},
+				}}, nil
+			},
+		},
+	}
+	configFetcher := &mocks.ConfigFetcherMock{
+		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _, _ string) (*auction.Config, error) {
+			return auctionConfig, nil
+		},
+	}
+	adapterKeysFetcher := &mocks.AdapterKeysFetcherMock{
+		FetchEnabledAdapterKeysFunc: func(_ context.Context, _ int64, keys []adapter.Key) ([]adapter.Key, error) {
+			return keys, nil
+		},
+	}
+	auctionBuilder := &mocks.AuctionBuilderMock{
+		BuildFunc: func(_ context.Context, _ *auction.BuildParams) (*auction.Result, error) {
+			return &auction.Result{
+				AuctionConfiguration: auctionConfig,
+				CPMAdUnits:           &[]auction.AdUnit{},
+				BiddingAuctionResult: &bidding.AuctionResult{},
+			}, nil
+		},
+	}
+	floorOptimizer := &mocks.FloorOptimizerMock{
+		RecommendFunc: func(_ context.Context, _ floor.Key, _ float64) (floor.Recommendation, error) {
+			return floor.Recommendation{Floor: 0.5}, nil
+		},
+	}
+
+	service := &auction.Service{
+		AdapterKeysFetcher: adapterKeysFetcher,
+		ConfigFetcher:      configFetcher,
+		AuctionBuilder:     auctionBuilder,
+		SegmentMatcher:     segmentMatcher,
+		FloorOptimizer:     floorOptimizer,
+		EventLogger:        &event.Logger{Engine: &engine.Log{}},
+	}
+
+	params := &auction.ExecutionParams{
+		Req:     request,
+		App:     testApp(1),
+		Country: "US",
+		GeoData: geocoder.GeoData{CountryCode: "US"},
+		Log:     func(string) {},
+		LogErr:  func(_ error) {},
+	}
+
+	response, err := service.Run(ctx, params)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if response.AuctionPriceFloor != 0.5 {
+		t.Errorf("Expected AuctionPriceFloor %v, got %v", 0.5, response.AuctionPriceFloor)
+	}
+
+	calls := floorOptimizer.RecommendCalls()
+	if len(calls) != 1 {
+		t.Fatalf("Expected 1 Recommend call, got %d", len(calls))
+	}
+	wantKey := floor.Key{AppID: params.App.ID, AdType: ad.BannerType, Country: "US", AuctionConfigurationID: auctionConfig.ID}
+	if calls[0].Key != wantKey || calls[0].TargetFillRate != 0.8 {
+		t.Errorf("Expected Recommend(%v, %v), got Recommend(%v, %v)", wantKey, 0.8, calls[0].Key, calls[0].TargetFillRate)
+	}
+}
+
 func TestService_Run_BidmachineWithMediator(t *testing.T) {
 	ctx := context.Background()
 	auctionConfig := &auction.Config{
@@ -534,7 +632,7 @@ func TestService_Run_BidmachineWithMediator(t *testing.T) {
 		FetchByUIDCachedFunc: func(_ context.Context, _ int64, _, _ string) *auction.Config {
 			return auctionConfig
 		},
-		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _ string) (*auction.Config, error) {
+		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _, _ string) (*auction.Config, error) {
 			return auctionConfig, nil
 		},
 	}
@@ -648,7 +746,7 @@ func TestService_Run_BiddingWithDemandExt(t *testing.T) {
 		FetchByUIDCachedFunc: func(_ context.Context, _ int64, _, _ string) *auction.Config {
 			return auctionConfig
 		},
-		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _ string) (*auction.Config, error) {
+		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _, _ string) (*auction.Config, error) {
 			return auctionConfig, nil
 		},
 	}
@@ -764,7 +862,7 @@ func TestService_Run_BidmachineWithMediatorInBidding(t *testing.T) {
 		FetchByUIDCachedFunc: func(_ context.Context, _ int64, _, _ string) *auction.Config {
 			return auctionConfig
 		},
-		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _ string) (*auction.Config, error) {
+		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _, _ string) (*auction.Config, error) {
 			return auctionConfig, nil
 		},
 	}
@@ -905,7 +1003,7 @@ func TestService_Run_BuildDemandExtVariousAdapters(t *testing.T) {
 		FetchByUIDCachedFunc: func(_ context.Context, _ int64, _, _ string) *auction.Config {
 			return auctionConfig
 		},
-		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _ string) (*auction.Config, error) {
+		MatchFunc: func(_ context.Context, _ int64, _ ad.Type, _ int64, _, _ string) (*auction.Config, error) {
 			return auctionConfig, nil
 		},
 	}
@@ -967,12 +1065,13 @@ func TestService_Run_BuildDemandExtVariousAdapters(t *testing.T) {
 					Bids: []adapters.DemandResponse{
 						{
 							DemandID: adapter.AmazonKey,
-							SlotUUID: "amazon_slot",
 							Bid: &adapters.BidDemandResponse{
-								ID:      "amazon_bid",
-								ImpID:   "amazon_imp",
-								Price:   0.12,
-								Payload: "amazon_payload",
+								ID:        "amazon_bid",
+								ImpID:     "amazon_imp",
+								Price:     0.12,
+								Payload:   "amazon_payload",
+								TagID:     "amazon_slot",
+								AdUnitUID: "amazon_unit_123",
 							},
 						},
 						{
@@ -1055,7 +1154,9 @@ func TestService_Run_BuildDemandExtVariousAdapters(t *testing.T) {
 	for _, adUnit := range allAdUnits {
 		switch adUnit.DemandID {
 		case string(adapter.AmazonKey):
-			amazonFound = true
+			if bidID, ok := adUnit.Extra["bid_id"].(string); ok && bidID == "amazon_bid" {
+				amazonFound = true
+			}
 		case string(adapter.MobileFuseKey):
 			if signaldata, ok := adUnit.Extra["signaldata"].(string); ok && signaldata == "mobilefuse_signal" {
 				mobilefuseFound = true
@@ -1076,7 +1177,7 @@ func TestService_Run_BuildDemandExtVariousAdapters(t *testing.T) {
 	}
 
 	if !amazonFound {
-		t.Error("Expected Amazon ad unit to be found")
+		t.Error("Expected Amazon ad unit to have bid_id")
 	}
 	if !mobilefuseFound {
 		t.Error("Expected MobileFuse ad unit to have signaldata")
@@ -1163,7 +1264,7 @@ func TestBidmachineWithPlacementID(t *testing.T) {
 					Bids: []adapters.DemandResponse{
 						{
 							DemandID: adapter.BidmachineKey,
-							Bid:      &adapters.BidDemandResponse{Payload: "test_payload", Price: 0.15}, // Higher than price floor
+							Bid:      &adapters.BidDemandResponse{ID: "bidmachine_bid", Payload: "test_payload", Price: 0.15}, // Higher than price floor
 						},
 					},
 				},
@@ -1212,9 +1313,304 @@ func TestBidmachineWithPlacementID(t *testing.T) {
 	want := map[string]any{
 		"placement": placementID,
 		"payload":   "test_payload",
+		"bid_id":    "bidmachine_bid",
 	}
 
 	if diff := cmp.Diff(want, bidmachineAdUnit.Extra); diff != "" {
 		t.Errorf("Extra mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestService_Run_MultipleBidsOfAdapter(t *testing.T) {
+	auctionConfig := &auction.Config{
+		ID:         1,
+		UID:        "config_uid",
+		PriceFloor: 0.05,
+		Timeout:    15000,
+	}
+	request := &schema.AuctionRequest{
+		AdObject: schema.AdObject{
+			AuctionKey: "1ERNSV33K4000",
+			PriceFloor: 0.01,
+		},
+		BaseRequest: schema.BaseRequest{
+			Device: schema.Device{
+				OS:   "android",
+				Type: "phone",
+			},
+		},
+		AdType: ad.BannerType,
+	}
+	configFetcher := &mocks.ConfigFetcherMock{
+		FetchByUIDCachedFunc: func(_ context.Context, _ int64, _, _ string) *auction.Config {
+			return auctionConfig
+		},
+	}
+	adapterKeysFetcher := &mocks.AdapterKeysFetcherMock{
+		FetchEnabledAdapterKeysFunc: func(_ context.Context, _ int64, keys []adapter.Key) ([]adapter.Key, error) {
+			return keys, nil
+		},
+	}
+
+	const acmeKey adapter.Key = "acme"
+	auctionBuilder := &mocks.AuctionBuilderMock{
+		BuildFunc: func(_ context.Context, _ *auction.BuildParams) (*auction.Result, error) {
+			return &auction.Result{
+				AuctionConfiguration: auctionConfig,
+				CPMAdUnits:           &[]auction.AdUnit{},
+				AdUnits: &[]auction.AdUnit{
+					{DemandID: string(acmeKey), UID: "1", Label: "acme_1", BidType: schema.RTBBidType, Extra: map[string]any{}},
+					{DemandID: string(acmeKey), UID: "2", Label: "acme_2", BidType: schema.RTBBidType, Extra: map[string]any{}},
+				},
+				BiddingAuctionResult: &bidding.AuctionResult{
+					Bids: []adapters.DemandResponse{
+						{
+							DemandID:  acmeKey,
+							RequestID: "request-1",
+							Bid:       &adapters.BidDemandResponse{ID: "bid-2", Payload: "p2", Price: 0.3, AdUnitUID: "2"},
+						},
+						{
+							DemandID:  acmeKey,
+							RequestID: "request-1",
+							Bid:       &adapters.BidDemandResponse{ID: "bid-1", Payload: "p1", Price: 0.2, AdUnitUID: "1"},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	service := &auction.Service{
+		AdapterKeysFetcher: adapterKeysFetcher,
+		ConfigFetcher:      configFetcher,
+		AuctionBuilder:     auctionBuilder,
+		SegmentMatcher: &segment.Matcher{
+			Fetcher: &segmentmocks.FetcherMock{
+				FetchCachedFunc: func(_ context.Context, _ int64) ([]segment.Segment, error) {
+					return nil, nil
+				},
+			},
+		},
+		EventLogger: &event.Logger{Engine: &engine.Log{}},
+	}
+
+	params := &auction.ExecutionParams{
+		Req:     request,
+		App:     testApp(1),
+		Country: "US",
+		Log:     func(string) {},
+		LogErr:  func(_ error) {},
+	}
+
+	response, err := service.Run(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	got := make(map[string]string, len(response.AdUnits))
+	for _, adUnit := range response.AdUnits {
+		got[adUnit.Extra["bid_id"].(string)] = adUnit.UID
+	}
+	want := map[string]string{"bid-1": "1", "bid-2": "2"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Bid ad units mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestService_Run_CircuitOpen(t *testing.T) {
+	auctionConfig := &auction.Config{
+		ID:         1,
+		UID:        "config_uid",
+		PriceFloor: 0.05,
+		Timeout:    15000,
+	}
+	request := &schema.AuctionRequest{
+		AdObject: schema.AdObject{
+			AuctionKey: "1ERNSV33K4000",
+			PriceFloor: 0.01,
+		},
+		BaseRequest: schema.BaseRequest{
+			Device: schema.Device{
+				OS:   "android",
+				Type: "phone",
+			},
+		},
+		AdType: ad.BannerType,
+	}
+	auctionBuilder := &mocks.AuctionBuilderMock{
+		BuildFunc: func(_ context.Context, _ *auction.BuildParams) (*auction.Result, error) {
+			return &auction.Result{
+				AuctionConfiguration: auctionConfig,
+				CPMAdUnits:           &[]auction.AdUnit{},
+				AdUnits: &[]auction.AdUnit{
+					{DemandID: string(adapter.BidmachineKey), UID: "1", Label: "bidmachine_1", BidType: schema.RTBBidType, Extra: map[string]any{}},
+				},
+				BiddingAuctionResult: &bidding.AuctionResult{
+					Bids: []adapters.DemandResponse{
+						{DemandID: adapter.BidmachineKey, Error: bidding.ErrCircuitOpen},
+					},
+				},
+			}, nil
+		},
+	}
+
+	eventLogger := &MockEventLogger{}
+	service := &auction.Service{
+		AdapterKeysFetcher: &mocks.AdapterKeysFetcherMock{
+			FetchEnabledAdapterKeysFunc: func(_ context.Context, _ int64, keys []adapter.Key) ([]adapter.Key, error) {
+				return keys, nil
+			},
+		},
+		ConfigFetcher: &mocks.ConfigFetcherMock{
+			FetchByUIDCachedFunc: func(_ context.Context, _ int64, _, _ string) *auction.Config {
+				return auctionConfig
+			},
+		},
+		AuctionBuilder: auctionBuilder,
+		SegmentMatcher: &segment.Matcher{
+			Fetcher: &segmentmocks.FetcherMock{
+				FetchCachedFunc: func(_ context.Context, _ int64) ([]segment.Segment, error) {
+					return nil, nil
+				},
+			},
+		},
+		EventLogger: &event.Logger{Engine: eventLogger},
+	}
+
+	params := &auction.ExecutionParams{
+		Req:     request,
+		App:     testApp(1),
+		Country: "US",
+		Log:     func(string) {},
+		LogErr:  func(_ error) {},
+	}
+
+	if _, err := service.Run(context.Background(), params); err != nil && !errors.Is(err, auction.ErrNoAdsFound) {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	var statuses []string
+	for _, e := range eventLogger.LoggedEvents {
+		if adEvent := e.(*event.AdEvent); adEvent.EventType == "bid_request" {
+			statuses = append(statuses, adEvent.Status)
+		}
+	}
+	if diff := cmp.Diff([]string{"CIRCUIT_OPEN"}, statuses); diff != "" {
+		t.Errorf("bid_request event statuses mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestService_Run_DealBids(t *testing.T) {
+	auctionConfig := &auction.Config{
+		ID:         1,
+		UID:        "config_uid",
+		PriceFloor: 0.05,
+		Timeout:    15000,
+	}
+	request := &schema.AuctionRequest{
+		AdObject: schema.AdObject{
+			AuctionKey: "1ERNSV33K4000",
+			PriceFloor: 0.01,
+		},
+		BaseRequest: schema.BaseRequest{
+			Device: schema.Device{
+				OS:   "android",
+				Type: "phone",
+			},
+		},
+		AdType: ad.BannerType,
+	}
+	configFetcher := &mocks.ConfigFetcherMock{
+		FetchByUIDCachedFunc: func(_ context.Context, _ int64, _, _ string) *auction.Config {
+			return auctionConfig
+		},
+	}
+	adapterKeysFetcher := &mocks.AdapterKeysFetcherMock{
+		FetchEnabledAdapterKeysFunc: func(_ context.Context, _ int64, keys []adapter.Key) ([]adapter.Key, error) {
+			return keys, nil
+		},
+	}
+
+	const acmeKey adapter.Key = "acme"
+	auctionBuilder := &mocks.AuctionBuilderMock{
+		BuildFunc: func(_ context.Context, _ *auction.BuildParams) (*auction.Result, error) {
+			return &auction.Result{
+				AuctionConfiguration: auctionConfig,
+				CPMAdUnits: &[]auction.AdUnit{
+					{DemandID: string(adapter.AdmobKey), UID: "cpm", Label: "admob_1", PriceFloor: ptr(0.4), BidType: schema.CPMBidType, Extra: map[string]any{}},
+				},
+				AdUnits: &[]auction.AdUnit{
+					{DemandID: string(acmeKey), UID: "1", Label: "acme_1", BidType: schema.RTBBidType, Extra: map[string]any{}},
+					{DemandID: string(acmeKey), UID: "2", Label: "acme_2", BidType: schema.RTBBidType, Extra: map[string]any{}},
+					{DemandID: string(acmeKey), UID: "3", Label: "acme_3", BidType: schema.RTBBidType, Extra: map[string]any{}},
+				},
+				BiddingAuctionResult: &bidding.AuctionResult{
+					Bids: []adapters.DemandResponse{
+						{
+							DemandID:  acmeKey,
+							RequestID: "request-1",
+							Bid:       &adapters.BidDemandResponse{ID: "open-bid", Payload: "p1", Price: 0.5, AdUnitUID: "1"},
+						},
+						{
+							DemandID:  acmeKey,
+							RequestID: "request-1",
+							Bid:       &adapters.BidDemandResponse{ID: "deal-bid", Payload: "p2", Price: 0.3, AdUnitUID: "2", DealID: "pmp-1"},
+						},
+						{
+							DemandID:  acmeKey,
+							RequestID: "request-1",
+							Bid:       &adapters.BidDemandResponse{ID: "deal-bid-below-floor", Payload: "p3", Price: 0.15, AdUnitUID: "3", DealID: "pmp-2"},
+						},
+					},
+				},
+				Deals: []auction.Deal{
+					{ID: "pmp-1", DemandID: acmeKey, BidFloor: 0.2, Priority: 10},
+					{ID: "pmp-2", DemandID: acmeKey, BidFloor: 0.2, Priority: 20},
+				},
+			}, nil
+		},
+	}
+
+	service := &auction.Service{
+		AdapterKeysFetcher: adapterKeysFetcher,
+		ConfigFetcher:      configFetcher,
+		AuctionBuilder:     auctionBuilder,
+		SegmentMatcher: &segment.Matcher{
+			Fetcher: &segmentmocks.FetcherMock{
+				FetchCachedFunc: func(_ context.Context, _ int64) ([]segment.Segment, error) {
+					return nil, nil
+				},
+			},
+		},
+		EventLogger: &event.Logger{Engine: &engine.Log{}},
+	}
+
+	params := &auction.ExecutionParams{
+		Req:     request,
+		App:     testApp(1),
+		Country: "US",
+		Log:     func(string) {},
+		LogErr:  func(_ error) {},
+	}
+
+	response, err := service.Run(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var gotAdUnits []string
+	for _, adUnit := range response.AdUnits {
+		gotAdUnits = append(gotAdUnits, adUnit.UID)
+	}
+	if diff := cmp.Diff([]string{"2", "1", "cpm"}, gotAdUnits); diff != "" {
+		t.Errorf("Ad units order mismatch (-want +got):\n%s", diff)
+	}
+
+	var gotNoBids []string
+	for _, adUnit := range response.NoBids {
+		gotNoBids = append(gotNoBids, adUnit.UID)
+	}
+	if diff := cmp.Diff([]string{"3"}, gotNoBids); diff != "" {
+		t.Errorf("No bids mismatch (-want +got):\n%s", diff)
+	}
+}
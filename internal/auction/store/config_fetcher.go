@@ -2,13 +2,18 @@ package store
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 
 	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/auction"
 	"github.com/bidon-io/bidon-backend/internal/db"
 )
@@ -18,31 +23,63 @@ type ConfigFetcher struct {
 	Cache cache[*auction.Config]
 }
 
-func (m *ConfigFetcher) Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error) {
-	dbConfig := &db.AuctionConfiguration{}
+// configColumns are columns of auction configuration and its running experiment, see configRow.
+var configColumns = []string{
+	"auction_configurations.id",
+	"auction_configurations.public_uid",
+	"auction_configurations.external_win_notifications",
+	"auction_configurations.rounds",
+	"auction_configurations.demands",
+	"auction_configurations.bidding",
+	"auction_configurations.ad_unit_ids",
+	"auction_configurations.pricefloor",
+	"auction_configurations.timeout",
+	"auction_configurations.floor_policy",
+	"auction_configurations.bidding_timeouts",
+	"experiments.id AS experiment_id",
+	"experiments.variants AS experiment_variants",
+}
+
+// runningExperimentJoin joins running experiment of auction configuration, so that it's fetched with the same query.
+const runningExperimentJoin = "LEFT JOIN experiments ON experiments.auction_configuration_id = auction_configurations.id AND experiments.status = 'running'"
+
+// configRow is auction configuration with its running experiment, if there is one.
+type configRow struct {
+	db.AuctionConfiguration
+	ExperimentID sql.NullInt64 `gorm:"column:experiment_id"`
+	// ExperimentVariants are decoded separately, so that broken variants don't prevent serving the configuration.
+	ExperimentVariants []byte `gorm:"column:experiment_variants"`
+}
+
+// Match finds auction configuration for the app, ad type and segment.
+// If the configuration has a running experiment, overrides of the variant assigned to unitID are applied.
+func (m *ConfigFetcher) Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version, unitID string) (*auction.Config, error) {
+	row := &configRow{}
 
 	query := m.DB.
 		WithContext(ctx).
-		Select("id", "public_uid", "external_win_notifications", "rounds", "demands", "bidding", "ad_unit_ids", "pricefloor", "timeout").
+		Model(&db.AuctionConfiguration{}).
+		Select(configColumns).
+		Joins(runningExperimentJoin).
 		Where(map[string]any{
-			"app_id":  appID,
-			"ad_type": db.AdTypeFromDomain(adType),
+			"auction_configurations.app_id":  appID,
+			"auction_configurations.ad_type": db.AdTypeFromDomain(adType),
 		}).
-		Order("segment_id, is_default DESC, created_at DESC")
+		Order("auction_configurations.segment_id, auction_configurations.is_default DESC, auction_configurations.created_at DESC")
 
 	if segmentID != 0 {
-		query = query.Where("segment_id = ? OR segment_id IS NULL", segmentID)
+		query = query.Where("auction_configurations.segment_id = ? OR auction_configurations.segment_id IS NULL", segmentID)
 	} else {
-		query = query.Where("segment_id IS NULL")
+		query = query.Where("auction_configurations.segment_id IS NULL")
 	}
 
 	if version == "v2" {
-		query = query.Where("settings->>'v2' = ?", "true")
+		query = query.Where("auction_configurations.settings->>'v2' = ?", "true")
 	} else {
-		query = query.Where("settings->>'v2' IS NULL")
+		query = query.Where("auction_configurations.settings->>'v2' IS NULL")
 	}
 
-	err := query.Take(dbConfig).Error
+	err := query.Take(row).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			err = auction.ErrNoAdsFound
@@ -50,18 +87,7 @@ func (m *ConfigFetcher) Match(ctx context.Context, appID int64, adType ad.Type,
 		return nil, err
 	}
 
-	config := &auction.Config{
-		ID:                       dbConfig.ID,
-		UID:                      strconv.FormatInt(dbConfig.PublicUID.Int64, 10),
-		ExternalWinNotifications: *dbConfig.ExternalWinNotifications,
-		Demands:                  db.StringArrayToAdapterKeys(&dbConfig.Demands),
-		Bidding:                  db.StringArrayToAdapterKeys(&dbConfig.Bidding),
-		AdUnitIDs:                dbConfig.AdUnitIds,
-		PriceFloor:               dbConfig.Pricefloor,
-		Timeout:                  int(dbConfig.Timeout),
-	}
-
-	return config, nil
+	return row.config().WithExperimentVariant(unitID), nil
 }
 
 func (m *ConfigFetcher) FetchByUIDCached(ctx context.Context, appID int64, id, uid string) *auction.Config {
@@ -91,33 +117,43 @@ func (m *ConfigFetcher) FetchByUIDCached(ctx context.Context, appID int64, id, u
 // If both id and uid are empty, returns nil
 // If both id and uid are provided, uid takes precedence
 // If no configuration is found, returns nil
+// Running experiment of the configuration is included, the configuration is returned without it if it can't be decoded
 func (m *ConfigFetcher) FetchByUID(ctx context.Context, appID int64, id, uid string) *auction.Config {
 	if id == "" && uid == "" {
 		return nil
 	}
 
-	dbConfig := &db.AuctionConfiguration{}
+	row := &configRow{}
 
 	filter := map[string]any{
-		"app_id": appID,
+		"auction_configurations.app_id": appID,
 	}
 	if uid != "" {
-		filter["public_uid"] = uid
+		filter["auction_configurations.public_uid"] = uid
 	} else {
-		filter["id"] = id
+		filter["auction_configurations.id"] = id
 	}
 
 	err := m.DB.
 		WithContext(ctx).
-		Select("id", "public_uid", "external_win_notifications", "rounds", "demands", "bidding", "ad_unit_ids", "pricefloor", "timeout").
+		Model(&db.AuctionConfiguration{}).
+		Select(configColumns).
+		Joins(runningExperimentJoin).
 		Where(filter).
-		Order("created_at DESC").
-		Take(dbConfig).
+		Order("auction_configurations.created_at DESC").
+		Take(row).
 		Error
 	if err != nil {
 		return nil
 	}
 
+	return row.config()
+}
+
+// config returns auction configuration of the row. The configuration is served without experiment if its variants
+// can't be decoded, auctions just run without the experiment.
+func (r *configRow) config() *auction.Config {
+	dbConfig := &r.AuctionConfiguration
 	config := &auction.Config{
 		ID:                       dbConfig.ID,
 		UID:                      strconv.FormatInt(dbConfig.PublicUID.Int64, 10),
@@ -127,11 +163,86 @@ func (m *ConfigFetcher) FetchByUID(ctx context.Context, appID int64, id, uid str
 		AdUnitIDs:                dbConfig.AdUnitIds,
 		PriceFloor:               dbConfig.Pricefloor,
 		Timeout:                  int(dbConfig.Timeout),
+		FloorPolicy:              floorPolicy(dbConfig.FloorPolicy),
+		BiddingTimeouts:          biddingTimeouts(dbConfig.BiddingTimeouts),
+	}
+
+	if r.ExperimentID.Valid {
+		var variants []db.ExperimentVariant
+		if err := json.Unmarshal(r.ExperimentVariants, &variants); err != nil {
+			log.Printf("Error decoding experiment %d, serving config without it: %v\n", r.ExperimentID.Int64, err)
+		} else {
+			config.Experiment = experiment(r.ExperimentID.Int64, variants)
+		}
 	}
 
 	return config
 }
 
+func experiment(id int64, variants []db.ExperimentVariant) *auction.Experiment {
+	experiment := &auction.Experiment{
+		ID:       id,
+		Variants: make([]auction.ExperimentVariant, len(variants)),
+	}
+	for i, v := range variants {
+		variant := auction.ExperimentVariant{
+			ID:         v.ID,
+			Weight:     int(v.Weight),
+			AdUnitIDs:  v.AdUnitIDs,
+			PriceFloor: v.Pricefloor,
+		}
+		if v.Demands != nil {
+			variant.Demands = db.StringArrayToAdapterKeys((*pq.StringArray)(&v.Demands))
+		}
+		if v.Bidding != nil {
+			variant.Bidding = db.StringArrayToAdapterKeys((*pq.StringArray)(&v.Bidding))
+		}
+		if v.Timeout != nil {
+			timeout := int(*v.Timeout)
+			variant.Timeout = &timeout
+		}
+		if v.FloorPolicy != nil {
+			policy := floorPolicy(v.FloorPolicy)
+			variant.FloorPolicy = &policy
+		}
+		experiment.Variants[i] = variant
+	}
+
+	return experiment
+}
+
+func floorPolicy(p *db.FloorPolicy) auction.FloorPolicy {
+	if p == nil {
+		return auction.FloorPolicy{}
+	}
+
+	return auction.FloorPolicy{
+		IgnoreFloorForMediators:    p.IgnoreFloorForMediators,
+		IgnorePrevAuctionPrice:     p.IgnorePrevAuctionPrice,
+		IgnoreAdCachePrices:        p.IgnoreAdCachePrices,
+		DynamicFloor:               p.DynamicFloor,
+		DynamicFloorTargetFillRate: p.DynamicFloorTargetFillRate,
+	}
+}
+
+func biddingTimeouts(t *db.BiddingTimeouts) auction.BiddingTimeouts {
+	if t == nil {
+		return auction.BiddingTimeouts{}
+	}
+
+	timeouts := auction.BiddingTimeouts{
+		TMax: int(t.TMax),
+	}
+	if len(t.Adapters) > 0 {
+		timeouts.Adapters = make(map[adapter.Key]int, len(t.Adapters))
+		for key, timeout := range t.Adapters {
+			timeouts.Adapters[adapter.Key(key)] = int(timeout)
+		}
+	}
+
+	return timeouts
+}
+
 // FetchBidMachinePlacements fetches auction configurations that include BidMachine in demands or bidding
 // and returns a map of auction_key to placement_id from line_items
 func (m *ConfigFetcher) FetchBidMachinePlacements(ctx context.Context, appID int64) (map[string]string, error) {
@@ -173,7 +173,7 @@ func TestConfigFetcher_Match(t *testing.T) {
 
 	matcher := &store.ConfigFetcher{DB: tx}
 	for _, tC := range testCases {
-		got, err := matcher.Match(context.Background(), tC.args.appID, tC.args.adType, tC.args.segmentID, "v1")
+		got, err := matcher.Match(context.Background(), tC.args.appID, tC.args.adType, tC.args.segmentID, "v1", "")
 		if err != nil {
 			t.Errorf("Error matching config: %v", err)
 		}
@@ -219,6 +219,10 @@ func TestConfigFetcher_FetchByUID(t *testing.T) {
 			Bidding:   pq.StringArray{"bidmachine", "mintegral"},
 			AdUnitIds: pq.Int64Array{1, 2, 3},
 			Timeout:   1500,
+			FloorPolicy: &db.FloorPolicy{
+				IgnoreFloorForMediators: []string{"max"},
+				IgnoreAdCachePrices:     true,
+			},
 		},
 	}
 	if err := tx.Create(&configs).Error; err != nil {
@@ -277,6 +281,10 @@ func TestConfigFetcher_FetchByUID(t *testing.T) {
 				Bidding:   db.StringArrayToAdapterKeys(&app3InterstitialConfig.Bidding),
 				AdUnitIDs: app3InterstitialConfig.AdUnitIds,
 				Timeout:   int(app3InterstitialConfig.Timeout),
+				FloorPolicy: auction.FloorPolicy{
+					IgnoreFloorForMediators: []string{"max"},
+					IgnoreAdCachePrices:     true,
+				},
 			},
 		},
 		{
@@ -295,6 +303,83 @@ func TestConfigFetcher_FetchByUID(t *testing.T) {
 	}
 }
 
+func TestConfigFetcher_FetchByUID_ExperimentError(t *testing.T) {
+	tx := testDB.Begin()
+	defer tx.Rollback()
+
+	app := dbtest.CreateApp(t, tx)
+	dbConfig := db.AuctionConfiguration{
+		AppID:     app.ID,
+		PublicUID: sql.NullInt64{Int64: 5555555555555555555, Valid: true},
+		AdType:    db.BannerAdType,
+	}
+	if err := tx.Create(&dbConfig).Error; err != nil {
+		t.Fatalf("Error creating config: %v", err)
+	}
+	// Variants that are not an array can't be decoded, so the config is served without the experiment.
+	err := tx.Exec(
+		"INSERT INTO experiments (app_id, auction_configuration_id, name, variants, created_at, updated_at) VALUES (?, ?, ?, ?::jsonb, NOW(), NOW())",
+		app.ID, dbConfig.ID, "broken", `{"id":"control"}`,
+	).Error
+	if err != nil {
+		t.Fatalf("Error creating experiment: %v", err)
+	}
+
+	matcher := &store.ConfigFetcher{DB: tx}
+	got := matcher.FetchByUID(context.Background(), app.ID, fmt.Sprint(dbConfig.ID), "")
+
+	want := &auction.Config{
+		ID:        dbConfig.ID,
+		UID:       strconv.FormatInt(dbConfig.PublicUID.Int64, 10),
+		Demands:   db.StringArrayToAdapterKeys(&dbConfig.Demands),
+		Bidding:   db.StringArrayToAdapterKeys(&dbConfig.Bidding),
+		AdUnitIDs: dbConfig.AdUnitIds,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("matcher.FetchByUID() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestConfigFetcher_Match_ExperimentError(t *testing.T) {
+	tx := testDB.Begin()
+	defer tx.Rollback()
+
+	app := dbtest.CreateApp(t, tx)
+	dbConfig := db.AuctionConfiguration{
+		AppID:     app.ID,
+		PublicUID: sql.NullInt64{Int64: 6666666666666666666, Valid: true},
+		AdType:    db.BannerAdType,
+	}
+	if err := tx.Create(&dbConfig).Error; err != nil {
+		t.Fatalf("Error creating config: %v", err)
+	}
+	// Variants that are not an array can't be decoded, so the config is served without the experiment.
+	err := tx.Exec(
+		"INSERT INTO experiments (app_id, auction_configuration_id, name, variants, created_at, updated_at) VALUES (?, ?, ?, ?::jsonb, NOW(), NOW())",
+		app.ID, dbConfig.ID, "broken", `{"id":"control"}`,
+	).Error
+	if err != nil {
+		t.Fatalf("Error creating experiment: %v", err)
+	}
+
+	matcher := &store.ConfigFetcher{DB: tx}
+	got, err := matcher.Match(context.Background(), app.ID, ad.BannerType, 0, "v1", "unit")
+	if err != nil {
+		t.Fatalf("Error matching config: %v", err)
+	}
+
+	want := &auction.Config{
+		ID:        dbConfig.ID,
+		UID:       strconv.FormatInt(dbConfig.PublicUID.Int64, 10),
+		Demands:   db.StringArrayToAdapterKeys(&dbConfig.Demands),
+		Bidding:   db.StringArrayToAdapterKeys(&dbConfig.Bidding),
+		AdUnitIDs: dbConfig.AdUnitIds,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("matcher.Match() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
 func TestConfigFetcher_FetchByUIDCached(t *testing.T) {
 	tx := testDB.Begin()
 	defer tx.Rollback()
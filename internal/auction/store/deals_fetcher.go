@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/auction"
+	"github.com/bidon-io/bidon-backend/internal/db"
+)
+
+type DealsFetcher struct {
+	DB    *db.DB
+	Cache cache[[]auction.Deal]
+}
+
+func (f *DealsFetcher) FetchCached(ctx context.Context, appID int64) ([]auction.Deal, error) {
+	cacheKey := []byte("deals:" + strconv.FormatInt(appID, 10))
+
+	return f.Cache.Get(ctx, cacheKey, func(ctx context.Context) ([]auction.Deal, error) {
+		return f.Fetch(ctx, appID)
+	})
+}
+
+// Fetch returns enabled deals of the app.
+func (f *DealsFetcher) Fetch(ctx context.Context, appID int64) ([]auction.Deal, error) {
+	var dbDeals []db.Deal
+
+	err := f.DB.
+		WithContext(ctx).
+		Select("deals.id", "deals.deal_id", "deals.bid_floor", "deals.priority", "deals.segment_id", "deals.countries").
+		InnerJoins("DemandSource", f.DB.Select("api_key")).
+		Where("deals.app_id = ? AND deals.enabled", appID).
+		Order("deals.id").
+		Find(&dbDeals).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("find deals: %v", err)
+	}
+
+	deals := make([]auction.Deal, 0, len(dbDeals))
+	for _, dbDeal := range dbDeals {
+		deal := auction.Deal{
+			ID:        dbDeal.DealID,
+			DemandID:  adapter.Key(dbDeal.DemandSource.APIKey),
+			BidFloor:  dbDeal.BidFloor.Decimal.InexactFloat64(),
+			Priority:  dbDeal.Priority,
+			Countries: dbDeal.Countries,
+		}
+		if dbDeal.SegmentID != nil && dbDeal.SegmentID.Valid {
+			deal.SegmentID = dbDeal.SegmentID.Int64
+		}
+
+		deals = append(deals, deal)
+	}
+
+	return deals, nil
+}
@@ -0,0 +1,80 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shopspring/decimal"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/auction"
+	"github.com/bidon-io/bidon-backend/internal/auction/store"
+	"github.com/bidon-io/bidon-backend/internal/db"
+	"github.com/bidon-io/bidon-backend/internal/db/dbtest"
+)
+
+func TestDealsFetcher_Fetch(t *testing.T) {
+	tx := testDB.Begin()
+	defer tx.Rollback()
+
+	app := dbtest.CreateApp(t, tx)
+	sgmnt := dbtest.CreateSegment(t, tx, func(s *db.Segment) {
+		s.App = app
+	})
+	demandSource := dbtest.CreateDemandSource(t, tx, func(source *db.DemandSource) {
+		source.APIKey = string(adapter.BidmachineKey)
+	})
+
+	dbtest.CreateDeal(t, tx, func(d *db.Deal) {
+		d.App = app
+		d.DemandSource = demandSource
+		d.DealID = "pmp-1"
+		d.BidFloor = decimal.NewNullDecimal(decimal.RequireFromString("1.5"))
+		d.Priority = 10
+	})
+	dbtest.CreateDeal(t, tx, func(d *db.Deal) {
+		d.App = app
+		d.DemandSource = demandSource
+		d.DealID = "pmp-2"
+		d.SegmentID = &sql.NullInt64{Int64: sgmnt.ID, Valid: true}
+		d.Countries = []string{"US", "GB"}
+	})
+	dbtest.CreateDeal(t, tx, func(d *db.Deal) {
+		d.App = app
+		d.DemandSource = demandSource
+		d.DealID = "pmp-disabled"
+		d.Enabled = ptr(false)
+	})
+	dbtest.CreateDeal(t, tx, func(d *db.Deal) {
+		d.DealID = "pmp-other-app"
+	})
+
+	fetcher := &store.DealsFetcher{DB: tx, Cache: config.NewMemoryCacheOf[[]auction.Deal](10 * time.Minute)}
+
+	got, err := fetcher.FetchCached(context.Background(), app.ID)
+	if err != nil {
+		t.Fatalf("FetchCached() error = %v", err)
+	}
+
+	want := []auction.Deal{
+		{
+			ID:       "pmp-1",
+			DemandID: adapter.BidmachineKey,
+			BidFloor: 1.5,
+			Priority: 10,
+		},
+		{
+			ID:        "pmp-2",
+			DemandID:  adapter.BidmachineKey,
+			SegmentID: sgmnt.ID,
+			Countries: []string{"US", "GB"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FetchCached() mismatch (-want +got):\n%s", diff)
+	}
+}
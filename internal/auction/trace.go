@@ -0,0 +1,86 @@
+package auction
+
+import (
+	"slices"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/segment"
+)
+
+// Trace records how Service.Run resolved an auction, for debugging auctions with no fill.
+type Trace struct {
+	Segment       segment.Segment `json:"segment"`
+	Configuration *Config         `json:"configuration"`
+	Adapters      AdaptersTrace   `json:"adapters"`
+	PriceFloor    PriceFloorTrace `json:"price_floor"`
+	Bids          []BidTrace      `json:"bids"`
+	// COPPAFiltered are demands of CPM ad units removed from response of COPPA request.
+	COPPAFiltered []string `json:"coppa_filtered"`
+	// AdUnits are ad units of response in order of ranking.
+	AdUnits []AdUnit `json:"ad_units"`
+	NoBids  []AdUnit `json:"no_bids"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// AdaptersTrace records adapters at each filtering step of the auction.
+type AdaptersTrace struct {
+	// Requested are adapters sent by SDK.
+	Requested []adapter.Key `json:"requested"`
+	// AdCacheFiltered are adapters removed because SDK has their ads cached.
+	AdCacheFiltered []adapter.Key `json:"ad_cache_filtered"`
+	// Disabled are adapters removed because they are not enabled for the app.
+	Disabled []adapter.Key `json:"disabled"`
+	// Demands and Bidding are adapters of auction configuration left after filtering.
+	Demands []adapter.Key `json:"demands"`
+	Bidding []adapter.Key `json:"bidding"`
+	// Configured are adapters with demand source profiles, only they take part in bidding.
+	Configured []adapter.Key `json:"configured"`
+}
+
+// PriceFloorTrace records components of auction price floor.
+type PriceFloorTrace struct {
+	Request       float64 `json:"request"`
+	Configuration float64 `json:"configuration"`
+	Dynamic       float64 `json:"dynamic"`
+	Final         float64 `json:"final"`
+}
+
+// BidTrace records bid request to an adapter.
+type BidTrace struct {
+	DemandID    adapter.Key `json:"demand_id"`
+	Status      int         `json:"status"`
+	BidID       string      `json:"bid_id,omitempty"`
+	Price       float64     `json:"price"`
+	Error       string      `json:"error,omitempty"`
+	RawRequest  string      `json:"raw_request"`
+	RawResponse string      `json:"raw_response"`
+}
+
+func newBidTrace(response adapters.DemandResponse) BidTrace {
+	trace := BidTrace{
+		DemandID:    response.DemandID,
+		Status:      response.Status,
+		Price:       response.Price(),
+		Error:       response.ErrorMessage(),
+		RawRequest:  response.RawRequest,
+		RawResponse: response.RawResponse,
+	}
+	if response.Bid != nil {
+		trace.BidID = response.Bid.ID
+	}
+
+	return trace
+}
+
+// without returns keys of all missing in filtered.
+func without(all, filtered []adapter.Key) []adapter.Key {
+	var removed []adapter.Key
+	for _, key := range all {
+		if !slices.Contains(filtered, key) {
+			removed = append(removed, key)
+		}
+	}
+
+	return removed
+}
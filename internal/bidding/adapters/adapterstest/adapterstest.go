@@ -0,0 +1,565 @@
+// Package adapterstest provides the conformance suite that every bidding adapter must pass.
+//
+// The suite builds the adapter with adapters.Builder and checks request construction, propagation of regulations and
+// price floor, handling of failed DSP responses and timeouts. Bid requests and parsed bids are compared with golden
+// file testdata/conformance.golden.json of the adapter package. Recorded DSP response testdata/conformance_response.json
+// is replayed by httptest server that stands in for the DSP. Run tests with -update flag to record golden file.
+//
+// The suite runs for every adapter registered in adapters_builder, so an adapter without suite config fails the tests.
+package adapterstest
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prebid/openrtb/v19/openrtb2"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/bidding/openrtb"
+	"github.com/bidon-io/bidon-backend/internal/device"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+)
+
+var update = flag.Bool("update", false, "update golden files of adapter conformance tests")
+
+const (
+	GoldenFile   = "testdata/conformance.golden.json"
+	ResponseFile = "testdata/conformance_response.json"
+)
+
+// Suite describes the adapter under test.
+type Suite struct {
+	Key     adapter.Key
+	Builder adapters.Builder
+	// Config is processed config of the adapter.
+	Config map[string]any
+	// Demand is demand data of the adapter in auction request, {"token": "token"} by default.
+	Demand map[string]any
+	// Unsupported are names of CreateRequest cases the adapter must reject with error, e.g. "adaptive_tablet".
+	Unsupported []string
+	// Dir is the adapter package directory that holds testdata, the current directory by default.
+	Dir string
+}
+
+// Golden is the content of GoldenFile.
+type Golden struct {
+	// Requests are bid requests created for ad types and devices, keyed by test case name.
+	Requests map[string]json.RawMessage `json:"requests"`
+	Replay   GoldenReplay               `json:"replay"`
+}
+
+// GoldenReplay is the bid request received by the stand-in DSP and the bid parsed from the recorded response.
+type GoldenReplay struct {
+	Method string                      `json:"method"`
+	Path   string                      `json:"path"`
+	Query  string                      `json:"query,omitempty"`
+	Header map[string]string           `json:"header,omitempty"`
+	Bid    *adapters.BidDemandResponse `json:"bid"`
+}
+
+// Response is the content of ResponseFile, the DSP response recorded for the banner request.
+type Response struct {
+	Status int               `json:"status"`
+	Header map[string]string `json:"header,omitempty"`
+	Body   json.RawMessage   `json:"body"`
+}
+
+const (
+	requestID = "conformance-request"
+	bidFloor  = 1.5
+	// impIDPlaceholder replaces impression IDs in golden requests as they are random.
+	impIDPlaceholder = "IMP_ID"
+)
+
+type requestCase struct {
+	name       string
+	adType     ad.Type
+	format     ad.Format
+	deviceType device.Type
+}
+
+func requestCases() []requestCase {
+	placements := []struct {
+		name   string
+		adType ad.Type
+		format ad.Format
+	}{
+		{"banner", ad.BannerType, ad.BannerFormat},
+		{"mrec", ad.BannerType, ad.MRECFormat},
+		{"adaptive", ad.BannerType, ad.AdaptiveFormat},
+		{"interstitial", ad.InterstitialType, ad.EmptyFormat},
+		{"rewarded", ad.RewardedType, ad.EmptyFormat},
+	}
+	devices := []device.Type{device.PhoneType, device.TabletType}
+
+	cases := make([]requestCase, 0, len(placements)*len(devices))
+	for _, p := range placements {
+		for _, d := range devices {
+			cases = append(cases, requestCase{
+				name:       p.name + "_" + strings.ToLower(string(d)),
+				adType:     p.adType,
+				format:     p.format,
+				deviceType: d,
+			})
+		}
+	}
+
+	return cases
+}
+
+// Run runs the conformance suite for the adapter.
+func Run(t *testing.T, s Suite) {
+	t.Helper()
+
+	golden := s.readGolden(t)
+	recorded := Golden{Requests: map[string]json.RawMessage{}}
+
+	t.Run("CreateRequest", func(t *testing.T) {
+		for _, tc := range requestCases() {
+			t.Run(tc.name, func(t *testing.T) {
+				if request := s.testCreateRequest(t, tc, golden.Requests[tc.name]); request != nil {
+					recorded.Requests[tc.name] = request
+				}
+			})
+		}
+	})
+	t.Run("Regulations", s.testRegulations)
+	t.Run("ParseBids", s.testParseBids)
+	t.Run("Timeout", s.testTimeout)
+	t.Run("Replay", func(t *testing.T) {
+		recorded.Replay = s.testReplay(t, golden.Replay)
+	})
+
+	if *update {
+		s.writeGolden(t, recorded)
+	}
+}
+
+func (s Suite) bidder(t *testing.T, client *http.Client) *adapters.Bidder {
+	t.Helper()
+
+	bidder, err := s.Builder(adapter.ProcessedConfigsMap{s.Key: s.Config}, client)
+	if err != nil {
+		t.Fatalf("Builder() error = %v", err)
+	}
+
+	return bidder
+}
+
+func (s Suite) auctionRequest(adType ad.Type, format ad.Format, deviceType device.Type) *schema.AuctionRequest {
+	demand := s.Demand
+	if demand == nil {
+		demand = map[string]any{"token": "token"}
+	}
+
+	adObject := schema.AdObject{
+		AuctionID:   "auction-1",
+		PriceFloor:  bidFloor,
+		Orientation: "PORTRAIT",
+		Demands:     map[adapter.Key]map[string]any{s.Key: demand},
+	}
+	switch adType {
+	case ad.BannerType:
+		adObject.Banner = &schema.BannerAdObject{Format: format}
+	case ad.InterstitialType:
+		adObject.Interstitial = &schema.InterstitialAdObject{}
+	case ad.RewardedType:
+		adObject.Rewarded = &schema.RewardedAdObject{}
+	}
+
+	return &schema.AuctionRequest{
+		BaseRequest: schema.BaseRequest{
+			Device: schema.Device{Type: deviceType},
+		},
+		AdType:   adType,
+		AdObject: adObject,
+		Adapters: schema.Adapters{
+			s.Key: {Version: "1.0.0", SDKVersion: "1.0.0"},
+		},
+	}
+}
+
+// baseRequest is the request that bidding builder passes to adapters.
+func baseRequest(coppa, gdpr bool) openrtb.BidRequest {
+	return openrtb.BidRequest{
+		ID: requestID,
+		AT: 1,
+		App: &openrtb2.App{
+			Bundle:    "com.example.app",
+			Publisher: &openrtb2.Publisher{},
+		},
+		Device: &openrtb2.Device{
+			OS:  "android",
+			Geo: &openrtb2.Geo{Country: "USA"},
+		},
+		Imp: []openrtb2.Imp{{BidFloor: bidFloor}},
+		Regs: &openrtb2.Regs{
+			COPPA: boolToInt(coppa),
+			GDPR:  ptr(boolToInt(gdpr)),
+		},
+	}
+}
+
+func (s Suite) testCreateRequest(t *testing.T, tc requestCase, want json.RawMessage) json.RawMessage {
+	bidder := s.bidder(t, http.DefaultClient)
+	base := baseRequest(false, false)
+	auctionRequest := s.auctionRequest(tc.adType, tc.format, tc.deviceType)
+
+	request, err := bidder.Adapter.CreateRequest(base, auctionRequest)
+	if slices.Contains(s.Unsupported, tc.name) {
+		if err == nil {
+			t.Errorf("CreateRequest() error = nil, want error for unsupported case")
+		}
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("CreateRequest() error = %v", err)
+	}
+
+	if len(request.Imp) != 1 {
+		t.Fatalf("CreateRequest() got %d imps, want 1", len(request.Imp))
+	}
+	imp := request.Imp[0]
+	if imp.ID == "" {
+		t.Errorf("CreateRequest() imp.id is empty")
+	}
+	if wantFloor := adapters.CalculatePriceFloor(&base, auctionRequest); imp.BidFloor != wantFloor {
+		t.Errorf("CreateRequest() imp.bidfloor = %v, want %v", imp.BidFloor, wantFloor)
+	}
+	if tc.adType == ad.BannerType && imp.Banner == nil {
+		t.Errorf("CreateRequest() imp.banner is not set for banner ad")
+	}
+	if request.ID != requestID {
+		t.Errorf("CreateRequest() id = %q, want %q", request.ID, requestID)
+	}
+
+	got := normalizeRequest(t, request)
+	if !*update {
+		compareJSON(t, "CreateRequest()", want, got)
+	}
+
+	return got
+}
+
+func (s Suite) testRegulations(t *testing.T) {
+	bidder := s.bidder(t, http.DefaultClient)
+
+	for _, coppa := range []bool{false, true} {
+		for _, gdpr := range []bool{false, true} {
+			t.Run(fmt.Sprintf("coppa=%t,gdpr=%t", coppa, gdpr), func(t *testing.T) {
+				base := baseRequest(coppa, gdpr)
+				request, err := bidder.Adapter.CreateRequest(base, s.auctionRequest(ad.BannerType, ad.BannerFormat, device.PhoneType))
+				if err != nil {
+					t.Fatalf("CreateRequest() error = %v", err)
+				}
+
+				want := baseRequest(coppa, gdpr).Regs
+				if diff := cmp.Diff(want, request.Regs); diff != "" {
+					t.Errorf("CreateRequest() regs mismatch (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func (s Suite) testParseBids(t *testing.T) {
+	bidder := s.bidder(t, http.DefaultClient)
+
+	tests := []struct {
+		name    string
+		dr      adapters.DemandResponse
+		wantErr bool
+	}{
+		{
+			name: "no content",
+			dr:   adapters.DemandResponse{Status: http.StatusNoContent},
+		},
+		{
+			name:    "bad request",
+			dr:      adapters.DemandResponse{Status: http.StatusBadRequest, RawResponse: "bad request"},
+			wantErr: true,
+		},
+		{
+			name:    "internal server error",
+			dr:      adapters.DemandResponse{Status: http.StatusInternalServerError, RawResponse: "internal error"},
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			dr:      adapters.DemandResponse{Status: http.StatusOK, RawResponse: `{"id":`},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr := tt.dr
+			dr.DemandID = s.Key
+
+			got, err := bidder.Adapter.ParseBids(&dr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBids() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != nil && got.IsBid() {
+				t.Errorf("ParseBids() bid = %+v, want no bid", got.Bid)
+			}
+		})
+	}
+
+	t.Run("no bids", func(t *testing.T) {
+		dr := adapters.DemandResponse{DemandID: s.Key, Status: http.StatusOK, RawResponse: `{"id":"` + requestID + `","seatbid":[]}`}
+
+		got, _ := bidder.Adapter.ParseBids(&dr)
+		if got != nil && got.IsBid() {
+			t.Errorf("ParseBids() bid = %+v, want no bid", got.Bid)
+		}
+	})
+}
+
+func (s Suite) testTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		readBody(r)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	bidder := s.bidder(t, NewDSPClient(server))
+	request, err := bidder.Adapter.CreateRequest(baseRequest(false, false), s.auctionRequest(ad.BannerType, ad.BannerFormat, device.PhoneType))
+	if err != nil {
+		t.Fatalf("CreateRequest() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	dr := bidder.Adapter.ExecuteRequest(ctx, bidder.Client, request)
+	if dr.Error == nil {
+		t.Errorf("ExecuteRequest() error = nil, want timeout error")
+	}
+	if dr.IsBid() {
+		t.Errorf("ExecuteRequest() bid = %+v, want no bid", dr.Bid)
+	}
+}
+
+func (s Suite) testReplay(t *testing.T, want GoldenReplay) GoldenReplay {
+	response := s.readResponse(t)
+
+	var got GoldenReplay
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.Method = r.Method
+		got.Path = r.URL.Path
+		got.Query = r.URL.RawQuery
+		got.Header = recordedHeader(r.Header)
+		gotBody = readBody(r)
+
+		for key, value := range response.Header {
+			w.Header().Set(key, value)
+		}
+		w.WriteHeader(response.Status)
+		_, _ = w.Write(response.Body)
+	}))
+	defer server.Close()
+
+	bidder := s.bidder(t, NewDSPClient(server))
+	request, err := bidder.Adapter.CreateRequest(baseRequest(false, false), s.auctionRequest(ad.BannerType, ad.BannerFormat, device.PhoneType))
+	if err != nil {
+		t.Fatalf("CreateRequest() error = %v", err)
+	}
+
+	dr := bidder.Adapter.ExecuteRequest(context.Background(), bidder.Client, request)
+	if dr.Error != nil {
+		t.Fatalf("ExecuteRequest() error = %v", dr.Error)
+	}
+	if dr.DemandID != s.Key {
+		t.Errorf("ExecuteRequest() demand id = %q, want %q", dr.DemandID, s.Key)
+	}
+	if dr.RawRequest != string(gotBody) {
+		t.Errorf("ExecuteRequest() raw request differs from the request received by DSP")
+	}
+
+	dr, err = bidder.Adapter.ParseBids(dr)
+	if err != nil {
+		t.Fatalf("ParseBids() error = %v", err)
+	}
+	got.Bid = dr.Bid
+
+	if !*update {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Replay mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	return got
+}
+
+// NewDSPClient returns client that sends all requests to the server regardless of their host.
+func NewDSPClient(server *httptest.Server) *http.Client {
+	serverURL, _ := url.Parse(server.URL)
+	transport := server.Client().Transport
+
+	return &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.URL.Scheme = serverURL.Scheme
+			req.URL.Host = serverURL.Host
+			req.Host = serverURL.Host
+
+			return transport.RoundTrip(req)
+		}),
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// recordedHeader returns request headers set by adapter, the ones set by http client are skipped.
+func recordedHeader(header http.Header) map[string]string {
+	recorded := make(map[string]string)
+	for key := range header {
+		switch key {
+		case "Accept-Encoding", "Content-Length", "User-Agent":
+			continue
+		}
+		recorded[key] = header.Get(key)
+	}
+
+	return recorded
+}
+
+func readBody(r *http.Request) []byte {
+	defer r.Body.Close()
+
+	body, _ := io.ReadAll(r.Body)
+
+	return body
+}
+
+// normalizeRequest marshals the request with random impression IDs replaced by placeholder.
+func normalizeRequest(t *testing.T, request openrtb.BidRequest) json.RawMessage {
+	t.Helper()
+
+	request.Imp = append([]openrtb2.Imp(nil), request.Imp...)
+	for i := range request.Imp {
+		request.Imp[i].ID = impIDPlaceholder
+	}
+
+	raw, err := json.MarshalIndent(request, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal request: %v", err)
+	}
+
+	return raw
+}
+
+func compareJSON(t *testing.T, name string, want, got json.RawMessage) {
+	t.Helper()
+
+	if want == nil {
+		t.Errorf("%s golden value is missing, run tests with -update flag", name)
+		return
+	}
+
+	var wantValue, gotValue any
+	if err := json.Unmarshal(want, &wantValue); err != nil {
+		t.Fatalf("unmarshal golden value: %v", err)
+	}
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("unmarshal value: %v", err)
+	}
+
+	if diff := cmp.Diff(wantValue, gotValue); diff != "" {
+		t.Errorf("%s mismatch (-want +got):\n%s", name, diff)
+	}
+}
+
+func (s Suite) path(file string) string {
+	return filepath.Join(s.Dir, file)
+}
+
+func (s Suite) readGolden(t *testing.T) Golden {
+	t.Helper()
+
+	var golden Golden
+	raw, err := os.ReadFile(s.path(GoldenFile))
+	if err != nil {
+		if *update && os.IsNotExist(err) {
+			return golden
+		}
+		t.Fatalf("read golden file: %v", err)
+	}
+	if err := json.Unmarshal(raw, &golden); err != nil {
+		t.Fatalf("unmarshal golden file: %v", err)
+	}
+
+	return golden
+}
+
+func (s Suite) writeGolden(t *testing.T, golden Golden) {
+	t.Helper()
+
+	raw, err := json.MarshalIndent(golden, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal golden file: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path(GoldenFile)), 0o755); err != nil {
+		t.Fatalf("create testdata dir: %v", err)
+	}
+	if err := os.WriteFile(s.path(GoldenFile), append(raw, '\n'), 0o644); err != nil {
+		t.Fatalf("write golden file: %v", err)
+	}
+}
+
+func (s Suite) readResponse(t *testing.T) Response {
+	t.Helper()
+
+	raw, err := os.ReadFile(s.path(ResponseFile))
+	if err != nil {
+		t.Fatalf("read recorded response: %v", err)
+	}
+
+	var response Response
+	if err := json.Unmarshal(raw, &response); err != nil {
+		t.Fatalf("unmarshal recorded response: %v", err)
+	}
+	if response.Status == 0 {
+		response.Status = http.StatusOK
+	}
+
+	return response
+}
+
+func boolToInt(b bool) int8 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func ptr[T any](t T) *T {
+	return &t
+}
@@ -1,13 +1,18 @@
 package amazon
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"net/http"
+	"strings"
 
 	"github.com/gofrs/uuid/v5"
 
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/bidding/openrtb"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
 )
 
@@ -23,11 +28,19 @@ type PricePoint struct {
 	PricePoint string  `json:"price_point"`
 }
 
+// SlotsKey is the key of map of slot UUIDs to UIDs of RTB ad units in processed adapter config.
+const SlotsKey = "slots"
+
+// Adapter is a client-side token bidder: SDK loads Amazon slots and sends their price points in the bid token.
 type Adapter struct {
 	PricePointsMap PricePointsMap
+	// Slots are UIDs of ad units keyed by slot UUID.
+	Slots map[string]string
 }
 
-func (a *Adapter) FetchBids(auctionRequest *schema.AuctionRequest) ([]*adapters.DemandResponse, error) {
+// DecodeBids returns bid for every slot of the token that has known price point and ad unit.
+// Other slots are dropped and logged, it's an error if all slots are dropped.
+func (a *Adapter) DecodeBids(auctionRequest *schema.AuctionRequest) (*adapters.DemandResponse, error) {
 	slotsJSON, ok := auctionRequest.AdObject.Demands[adapter.AmazonKey]["token"].(string)
 	if !ok {
 		return nil, fmt.Errorf("no token in request")
@@ -38,36 +51,66 @@ func (a *Adapter) FetchBids(auctionRequest *schema.AuctionRequest) ([]*adapters.
 		return nil, err
 	}
 
-	demandResponses := make([]*adapters.DemandResponse, 0, len(slots))
+	bids := make([]*adapters.BidDemandResponse, 0, len(slots))
+	var dropped []string
 	for _, slot := range slots {
-		if pricePoint, ok := a.PricePointsMap[slot.PricePoint]; ok {
-			ID, _ := uuid.NewV4()
-			impID, _ := uuid.NewV4()
-			demandResponse := adapters.DemandResponse{
-				DemandID: adapter.AmazonKey,
-				SlotUUID: slot.SlotUUID,
-				Bid: &adapters.BidDemandResponse{
-					DemandID: adapter.AmazonKey,
-					ID:       ID.String(),
-					ImpID:    impID.String(),
-					Price:    pricePoint.Price,
-				},
-			}
-			demandResponses = append(demandResponses, &demandResponse)
-		} else {
-			demandResponse := adapters.DemandResponse{
-				DemandID: adapter.AmazonKey,
-				Error:    fmt.Errorf("cannot find price point"),
-				SlotUUID: slot.SlotUUID,
-			}
-			demandResponses = append(demandResponses, &demandResponse)
+		pricePoint, ok := a.PricePointsMap[slot.PricePoint]
+		if !ok {
+			dropped = append(dropped, fmt.Sprintf("slot %s: cannot find price point %s", slot.SlotUUID, slot.PricePoint))
+			continue
+		}
+		adUnitUID, ok := a.Slots[slot.SlotUUID]
+		if !ok {
+			dropped = append(dropped, fmt.Sprintf("slot %s: cannot find ad unit", slot.SlotUUID))
+			continue
 		}
+
+		ID, _ := uuid.NewV4()
+		impID, _ := uuid.NewV4()
+		bids = append(bids, &adapters.BidDemandResponse{
+			DemandID:  adapter.AmazonKey,
+			ID:        ID.String(),
+			ImpID:     impID.String(),
+			Price:     pricePoint.Price,
+			TagID:     slot.SlotUUID,
+			AdUnitUID: adUnitUID,
+		})
+	}
+	if len(dropped) > 0 {
+		err := fmt.Errorf("dropped %d of %d slots: %s", len(dropped), len(slots), strings.Join(dropped, "; "))
+		if len(bids) == 0 {
+			return nil, err
+		}
+		log.Printf("Error decoding Amazon bids: %v\n", err)
 	}
 
-	return demandResponses, nil
+	demandResponse := &adapters.DemandResponse{DemandID: adapter.AmazonKey}
+	demandResponse.SetBids(bids)
+
+	return demandResponse, nil
+}
+
+// CreateRequest is not used, Amazon bids are decoded from the bid token.
+func (a *Adapter) CreateRequest(request openrtb.BidRequest, _ *schema.AuctionRequest) (openrtb.BidRequest, error) {
+	return request, adapters.ErrTokenBidder
+}
+
+// ExecuteRequest is not used, Amazon bids are decoded from the bid token.
+func (a *Adapter) ExecuteRequest(_ context.Context, _ *http.Client, _ openrtb.BidRequest) *adapters.DemandResponse {
+	return &adapters.DemandResponse{DemandID: adapter.AmazonKey, Error: adapters.ErrTokenBidder}
+}
+
+// ParseBids is not used, Amazon bids are decoded from the bid token.
+func (a *Adapter) ParseBids(dr *adapters.DemandResponse) (*adapters.DemandResponse, error) {
+	return dr, adapters.ErrTokenBidder
 }
 
-func Builder(cfg adapter.ProcessedConfigsMap) (*Adapter, error) {
+// CachePolicy disables bid cache for Amazon: its bids are price points of slots loaded by SDK for the current auction.
+func (a *Adapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{}
+}
+
+func Builder(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
 	amazonCfg := cfg[adapter.AmazonKey]
 
 	pricePointsMapRaw, ok := amazonCfg["price_points_map"].(map[string]any)
@@ -91,9 +134,17 @@ func Builder(cfg adapter.ProcessedConfigsMap) (*Adapter, error) {
 		}
 	}
 
+	slots, _ := amazonCfg[SlotsKey].(map[string]string)
+
 	adpt := &Adapter{
 		PricePointsMap: pricePointsMap,
+		Slots:          slots,
+	}
+
+	bidder := &adapters.Bidder{
+		Adapter: adpt,
+		Client:  client,
 	}
 
-	return adpt, nil
+	return bidder, nil
 }
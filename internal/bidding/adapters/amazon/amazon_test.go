@@ -2,6 +2,7 @@ package amazon
 
 import (
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -18,103 +19,147 @@ func compareErrors(x, y error) bool {
 	return x.Error() == y.Error()
 }
 
-func TestAdapter_FetchBids(t *testing.T) {
+func TestAdapter_DecodeBids(t *testing.T) {
 	type fields struct {
 		PricePointsMap PricePointsMap
+		Slots          map[string]string
 	}
 	type args struct {
 		auctionRequest *schema.AuctionRequest
 	}
+	pricePointsMap := PricePointsMap{
+		"price_point_1": {
+			Price:      1.0,
+			PricePoint: "price_point_1",
+		},
+		"price_point_2": {
+			Price:      2.0,
+			PricePoint: "price_point_2",
+		},
+	}
+	slots := map[string]string{
+		"slot_uuid_1": "ad_unit_1",
+		"slot_uuid_2": "ad_unit_2",
+		"slot_uuid_3": "ad_unit_3",
+	}
+	tokenRequest := func(token string) *schema.AuctionRequest {
+		return &schema.AuctionRequest{
+			AdObject: schema.AdObject{
+				Demands: map[adapter.Key]map[string]interface{}{
+					adapter.AmazonKey: {"token": token},
+				},
+			},
+		}
+	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    []*adapters.DemandResponse
-		wantErr bool
+		want    []*adapters.BidDemandResponse
+		wantErr error
 	}{
 		{
 			name: "success",
 			fields: fields{
-				PricePointsMap: PricePointsMap{
-					"price_point_1": {
-						Price:      1.0,
-						PricePoint: "price_point_1",
-					},
-					"price_point_2": {
-						Price:      2.0,
-						PricePoint: "price_point_2",
-					},
-				},
+				PricePointsMap: pricePointsMap,
+				Slots:          slots,
 			},
 			args: args{
-				auctionRequest: &schema.AuctionRequest{
-					AdObject: schema.AdObject{
-						Demands: map[adapter.Key]map[string]interface{}{
-							adapter.AmazonKey: {
-								"token": `[
-									{
-										"slot_uuid": "slot_uuid_1",
-										"price_point": "price_point_1"
-									},
-									{
-										"slot_uuid": "slot_uuid_2",
-										"price_point": "price_point_2"
-									},
-									{
-										"slot_uuid": "slot_uuid_3",
-										"price_point": "price_point_3"
-									}
-								]`,
-							},
-						},
+				auctionRequest: tokenRequest(`[
+					{
+						"slot_uuid": "slot_uuid_1",
+						"price_point": "price_point_1"
 					},
-				},
-			},
-			want: []*adapters.DemandResponse{
-				{
-					DemandID: adapter.AmazonKey,
-					SlotUUID: "slot_uuid_1",
-					Bid: &adapters.BidDemandResponse{
-						DemandID: adapter.AmazonKey,
-						Price:    1.0,
+					{
+						"slot_uuid": "slot_uuid_2",
+						"price_point": "price_point_2"
 					},
-				},
-				{
-					DemandID: adapter.AmazonKey,
-					SlotUUID: "slot_uuid_2",
-					Bid: &adapters.BidDemandResponse{
-						DemandID: adapter.AmazonKey,
-						Price:    2.0,
+					{
+						"slot_uuid": "slot_uuid_3",
+						"price_point": "price_point_3"
 					},
+					{
+						"slot_uuid": "slot_uuid_4",
+						"price_point": "price_point_1"
+					}
+				]`),
+			},
+			want: []*adapters.BidDemandResponse{
+				{
+					DemandID:  adapter.AmazonKey,
+					Price:     2.0,
+					TagID:     "slot_uuid_2",
+					AdUnitUID: "ad_unit_2",
 				},
 				{
-					DemandID: adapter.AmazonKey,
-					SlotUUID: "slot_uuid_3",
-					Error:    errors.New("cannot find price point"),
+					DemandID:  adapter.AmazonKey,
+					Price:     1.0,
+					TagID:     "slot_uuid_1",
+					AdUnitUID: "ad_unit_1",
 				},
 			},
-			wantErr: false,
+		},
+		{
+			name: "unknown price points",
+			fields: fields{
+				PricePointsMap: pricePointsMap,
+				Slots:          slots,
+			},
+			args: args{
+				auctionRequest: tokenRequest(`[{"slot_uuid": "slot_uuid_3", "price_point": "price_point_3"}]`),
+			},
+			wantErr: errors.New("dropped 1 of 1 slots: slot slot_uuid_3: cannot find price point price_point_3"),
+		},
+		{
+			name: "unknown price points and slots",
+			fields: fields{
+				PricePointsMap: pricePointsMap,
+				Slots:          slots,
+			},
+			args: args{
+				auctionRequest: tokenRequest(`[
+					{"slot_uuid": "slot_uuid_3", "price_point": "price_point_3"},
+					{"slot_uuid": "slot_uuid_4", "price_point": "price_point_1"}
+				]`),
+			},
+			wantErr: errors.New("dropped 2 of 2 slots: slot slot_uuid_3: cannot find price point price_point_3; slot slot_uuid_4: cannot find ad unit"),
+		},
+		{
+			name: "no token",
+			fields: fields{
+				PricePointsMap: pricePointsMap,
+				Slots:          slots,
+			},
+			args: args{
+				auctionRequest: &schema.AuctionRequest{},
+			},
+			wantErr: errors.New("no token in request"),
 		},
 	}
 	for _, tt := range tests {
 		adapter := Adapter{
 			PricePointsMap: tt.fields.PricePointsMap,
+			Slots:          tt.fields.Slots,
+		}
+		got, err := adapter.DecodeBids(tt.args.auctionRequest)
+		if !compareErrors(err, tt.wantErr) {
+			t.Errorf("%q. Adapter.DecodeBids() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
 		}
-		got, err := adapter.FetchBids(tt.args.auctionRequest)
-		if (err != nil) != tt.wantErr {
-			t.Errorf("%q. Adapter.FetchBids() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		if err != nil {
 			continue
 		}
 
 		// ignore id and imp_id
-		for _, dr := range got {
-			if dr.Bid != nil {
-				dr.Bid.ID = ""
-				dr.Bid.ImpID = ""
-			}
+		for _, bid := range got.Bids {
+			bid.ID = ""
+			bid.ImpID = ""
+		}
+		if diff := cmp.Diff(tt.want, got.Bids); diff != "" {
+			t.Errorf("%q. Adapter.DecodeBids() mismatch (-want +got):\n%s", tt.name, diff)
 		}
-		if diff := cmp.Diff(tt.want, got, cmp.Comparer(compareErrors)); diff != "" {
-			t.Errorf("%q. Adapter.FetchBids() mismatch (-want +got):\n%s", tt.name, diff)
+		if got.Bid != got.Bids[0] {
+			t.Errorf("%q. Adapter.DecodeBids() bid = %+v, want the highest", tt.name, got.Bid)
 		}
 	}
 }
@@ -130,6 +175,7 @@ func TestBuilder(t *testing.T) {
 			name: "Valid Configuration",
 			config: adapter.ProcessedConfigsMap{
 				adapter.AmazonKey: map[string]interface{}{
+					SlotsKey: map[string]string{"slot_uuid_1": "ad_unit_1"},
 					"price_points_map": map[string]interface{}{
 						"00n9g200_zzz": map[string]interface{}{
 							"name":        "Interstitial",
@@ -145,6 +191,7 @@ func TestBuilder(t *testing.T) {
 				},
 			},
 			expected: &Adapter{
+				Slots: map[string]string{"slot_uuid_1": "ad_unit_1"},
 				PricePointsMap: PricePointsMap{
 					"00n9g200_zzz": {
 						Price:      0.5,
@@ -172,7 +219,7 @@ func TestBuilder(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			adpt, err := Builder(test.config)
+			bidder, err := Builder(test.config, http.DefaultClient)
 
 			if test.expectError {
 				if err == nil {
@@ -183,12 +230,12 @@ func TestBuilder(t *testing.T) {
 					t.Fatalf("Expected no error, but got an error: %v", err)
 				}
 
-				if adpt == nil {
-					t.Fatal("Expected non-nil Adapter, but got nil")
+				if bidder == nil {
+					t.Fatal("Expected non-nil Bidder, but got nil")
 				}
 
-				if diff := cmp.Diff(test.expected.PricePointsMap, adpt.PricePointsMap); diff != "" {
-					t.Fatalf("PricePointsMap mismatch. Got %v, expected %v", adpt.PricePointsMap, test.expected.PricePointsMap)
+				if diff := cmp.Diff(test.expected, bidder.Adapter); diff != "" {
+					t.Fatalf("Adapter mismatch (-want +got):\n%s", diff)
 				}
 			}
 		})
@@ -1,8 +1,12 @@
 package adapters
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/bidding/openrtb"
@@ -18,11 +22,108 @@ type BidderInterface interface {
 
 	// ParseBids unpacks the server's response into Bids.
 	ParseBids(*DemandResponse) (*DemandResponse, error)
+
+	// CachePolicy declares whether bids of the adapter can be kept in bid cache and for how long.
+	CachePolicy() CachePolicy
+}
+
+// TokenBidder is an optional capability of BidderInterface for client-side token bidders, e.g. header bidding partners
+// whose SDK sends price points in the bid token. Their bids are decoded from the auction request without HTTP request,
+// CreateRequest, ExecuteRequest and ParseBids of such adapters are not called.
+type TokenBidder interface {
+	// DecodeBids returns bids of the bid token in auction request.
+	DecodeBids(*schema.AuctionRequest) (*DemandResponse, error)
 }
 
+// TimeoutNotifier is an optional capability of BidderInterface for adapters that expect a notification when their bid
+// request isn't answered by the bidding deadline.
+type TimeoutNotifier interface {
+	// TimeoutURL returns URL notified when the bid request times out.
+	TimeoutURL(openrtb.BidRequest) string
+}
+
+// ErrTokenBidder is returned by HTTP methods of TokenBidder adapters.
+var ErrTokenBidder = errors.New("token bidder doesn't send bid requests")
+
 type Bidder struct {
 	Adapter BidderInterface
 	Client  *http.Client
+	// CachePolicyOverride is set from demand source account settings and takes precedence over adapter cache policy.
+	CachePolicyOverride *CachePolicyOverride
+}
+
+// CachePolicy returns cache policy of the adapter with account overrides applied.
+func (b *Bidder) CachePolicy() CachePolicy {
+	return b.CachePolicyOverride.Apply(b.Adapter.CachePolicy())
+}
+
+// DefaultCacheTTL is how long cached bid stays valid if adapter doesn't limit it.
+const DefaultCacheTTL = 5 * time.Minute
+
+// CachePolicy tells whether bids of an adapter can be cached and served in later auctions of the same session.
+type CachePolicy struct {
+	Cacheable bool
+	// MaxTTL is how long cached bid stays valid. DefaultCacheTTL is used if zero.
+	MaxTTL time.Duration
+	// CrossFormat allows serving cached bid for another ad format of the same ad type, e.g. MREC bid for banner.
+	CrossFormat bool
+}
+
+// TTL returns MaxTTL or DefaultCacheTTL if MaxTTL is not set.
+func (p CachePolicy) TTL() time.Duration {
+	if p.MaxTTL > 0 {
+		return p.MaxTTL
+	}
+
+	return DefaultCacheTTL
+}
+
+// CachePolicyOverride is stored in bid_cache field of demand source account extra. Fields that are not set keep adapter values.
+type CachePolicyOverride struct {
+	Cacheable *bool `json:"cacheable"`
+	// MaxTTL is in seconds.
+	MaxTTL      *float64 `json:"max_ttl"`
+	CrossFormat *bool    `json:"cross_format"`
+}
+
+// Apply returns policy with overrides applied. Nil override returns policy as is.
+func (o *CachePolicyOverride) Apply(policy CachePolicy) CachePolicy {
+	if o == nil {
+		return policy
+	}
+
+	if o.Cacheable != nil {
+		policy.Cacheable = *o.Cacheable
+	}
+	if o.MaxTTL != nil {
+		policy.MaxTTL = time.Duration(*o.MaxTTL * float64(time.Second))
+	}
+	if o.CrossFormat != nil {
+		policy.CrossFormat = *o.CrossFormat
+	}
+
+	return policy
+}
+
+// CachePolicyOverrideFromConfig reads bid_cache override from processed adapter config. Returns nil if it's not set.
+func CachePolicyOverrideFromConfig(cfg map[string]any) *CachePolicyOverride {
+	raw, ok := cfg["bid_cache"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	override := &CachePolicyOverride{}
+	if cacheable, ok := raw["cacheable"].(bool); ok {
+		override.Cacheable = &cacheable
+	}
+	if maxTTL, ok := raw["max_ttl"].(float64); ok {
+		override.MaxTTL = &maxTTL
+	}
+	if crossFormat, ok := raw["cross_format"].(bool); ok {
+		override.CrossFormat = &crossFormat
+	}
+
+	return override
 }
 
 type Builder func(adapter.ProcessedConfigsMap, *http.Client) (*Bidder, error)
@@ -34,14 +135,16 @@ type DemandResponse struct {
 	RawResponse string
 	Status      int
 	Bid         *BidDemandResponse
+	// Bids are all bids of the response, e.g. of several seats, deals or impressions. Bid is the highest of them.
+	Bids        []*BidDemandResponse
 	Error       error
 	TagID       string
 	PlacementID string
-	SlotUUID    string
 	TimeoutURL  string
 	StartTS     int64
 	EndTS       int64
 	Token       Token
+	CachePolicy CachePolicy
 }
 
 func (dr *DemandResponse) IsBid() bool {
@@ -72,17 +175,43 @@ func (dr *DemandResponse) Price() float64 {
 	return price
 }
 
-// CanCache returns true if the bidder can cache the bid response. For now, it returns false just for BM and Amazon.
-// Probably should be part of the BidderInterface in the future.
+// CanCache returns true if the bid can be kept in bid cache according to cache policy of the bidder.
 func (dr *DemandResponse) CanCache() bool {
-	if !dr.IsBid() {
-		return false
+	return dr.IsBid() && dr.CachePolicy.Cacheable
+}
+
+// SetBids sets all bids of the response ordered by price, the highest one becomes Bid.
+func (dr *DemandResponse) SetBids(bids []*BidDemandResponse) {
+	if len(bids) == 0 {
+		dr.Bid, dr.Bids = nil, nil
+		return
+	}
+
+	slices.SortStableFunc(bids, func(a, b *BidDemandResponse) int {
+		return cmp.Compare(b.Price, a.Price)
+	})
+	dr.Bid, dr.Bids = bids[0], bids
+}
+
+// Split returns demand response for each of Bids, so every bid takes part in the auction on its own.
+// Response with one bid or without bids is returned as is.
+func (dr *DemandResponse) Split() []*DemandResponse {
+	if len(dr.Bids) <= 1 {
+		return []*DemandResponse{dr}
 	}
-	if dr.DemandID == adapter.BidmachineKey || dr.DemandID == adapter.AmazonKey {
-		return false
+
+	responses := make([]*DemandResponse, 0, len(dr.Bids))
+	for _, bid := range dr.Bids {
+		response := *dr
+		response.Bid = bid
+		response.Bids = nil
+		if bid.TagID != "" {
+			response.TagID = bid.TagID
+		}
+		responses = append(responses, &response)
 	}
 
-	return true
+	return responses
 }
 
 type BidDemandResponse struct {
@@ -97,6 +226,12 @@ type BidDemandResponse struct {
 	LURL       string
 	NURL       string
 	BURL       string
+	// DealID is ID of the private marketplace deal the bid is made for, empty for open auction bids.
+	DealID string
+	// TagID is tag ID of the impression the bid is made for. Set if request has several impressions.
+	TagID string
+	// AdUnitUID is UID of the ad unit the bid is made for. Set if adapter requests several ad units at once.
+	AdUnitUID string
 }
 
 type Token struct {
@@ -215,25 +215,34 @@ func (a *BidmachineAdapter) ParseBids(dr *adapters.DemandResponse) (*adapters.De
 		return dr, err
 	}
 
-	seat := bidResponse.SeatBid[0]
-	bid := seat.Bid[0]
-
-	dr.Bid = &adapters.BidDemandResponse{
-		ID:       bid.ID,
-		ImpID:    bid.ImpID,
-		Price:    bid.Price,
-		Payload:  bid.AdM,
-		DemandID: adapter.BidmachineKey,
-		AdID:     bid.AdID,
-		SeatID:   seat.Seat,
-		LURL:     bid.LURL,
-		NURL:     bid.NURL,
-		BURL:     bid.BURL,
+	var bids []*adapters.BidDemandResponse
+	for _, seat := range bidResponse.SeatBid {
+		for _, bid := range seat.Bid {
+			bids = append(bids, &adapters.BidDemandResponse{
+				ID:       bid.ID,
+				ImpID:    bid.ImpID,
+				Price:    bid.Price,
+				Payload:  bid.AdM,
+				DemandID: adapter.BidmachineKey,
+				AdID:     bid.AdID,
+				SeatID:   seat.Seat,
+				LURL:     bid.LURL,
+				NURL:     bid.NURL,
+				BURL:     bid.BURL,
+				DealID:   bid.DealID,
+			})
+		}
 	}
+	dr.SetBids(bids)
 
 	return dr, nil
 }
 
+// CachePolicy disables bid cache for BidMachine: its bids are bound to the auction they were made in.
+func (a *BidmachineAdapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{}
+}
+
 // Builder builds a new instance of the Bidmachine adapter for the given bidder with the given config.
 func Builder(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
 	bmCfg := cfg[adapter.BidmachineKey]
@@ -416,6 +416,17 @@ func TestBidmachine_ParseBids(t *testing.T) {
 	}`
 	adapter := buildAdapter()
 
+	bid := &adapters.BidDemandResponse{
+		ID:       "0",
+		ImpID:    "6579ca7b-7e2c-48b6-8915-46efa6530fb5",
+		Price:    1.5,
+		Payload:  "0692d0a0efdbd5bd470dafea742cef6a1f6b840c5c83240e165bc33a038b3d5487e25a52",
+		DemandID: "bidmachine",
+		AdID:     "bmad5e0471131b8a4e3c",
+		LURL:     "https://api.gov-static.tech/Ad/AdxEvent?sid=0&sslot=10182906-10163778",
+		NURL:     "https://api.gov-static.tech/Ad/AdxEvent?sid=0&sslot=10182906-10163778&adtype=4",
+	}
+
 	testCases := []struct {
 		name   string
 		params ParseBidsTestParams
@@ -433,16 +444,8 @@ func TestBidmachine_ParseBids(t *testing.T) {
 				DemandResponse: adapters.DemandResponse{
 					Status:      200,
 					RawResponse: rawResponse,
-					Bid: &adapters.BidDemandResponse{
-						ID:       "0",
-						ImpID:    "6579ca7b-7e2c-48b6-8915-46efa6530fb5",
-						Price:    1.5,
-						Payload:  "0692d0a0efdbd5bd470dafea742cef6a1f6b840c5c83240e165bc33a038b3d5487e25a52",
-						DemandID: "bidmachine",
-						AdID:     "bmad5e0471131b8a4e3c",
-						LURL:     "https://api.gov-static.tech/Ad/AdxEvent?sid=0&sslot=10182906-10163778",
-						NURL:     "https://api.gov-static.tech/Ad/AdxEvent?sid=0&sslot=10182906-10163778&adtype=4",
-					},
+					Bid:         bid,
+					Bids:        []*adapters.BidDemandResponse{bid},
 				},
 				Err: nil,
 			},
@@ -492,6 +495,41 @@ func TestBidmachine_ParseBids(t *testing.T) {
 	}
 }
 
+func TestBidmachine_ParseBids_SeveralSeats(t *testing.T) {
+	rawResponse := `{
+		"id": "47611e59-e05b-4e1e-9074-5a65eb4501e4",
+		"seatbid": [
+			{
+				"seat": "open",
+				"bid": [{"id": "0", "impid": "1", "price": 1.5, "adm": "open-adm"}]
+			},
+			{
+				"seat": "deals",
+				"bid": [
+					{"id": "1", "impid": "1", "price": 0.5, "adm": "deal-1-adm", "dealid": "deal-1"},
+					{"id": "2", "impid": "1", "price": 2.5, "adm": "deal-2-adm", "dealid": "deal-2"}
+				]
+			}
+		]
+	}`
+	adapter := buildAdapter()
+
+	response, err := adapter.ParseBids(&adapters.DemandResponse{Status: 200, RawResponse: rawResponse})
+	if err != nil {
+		t.Fatalf("ParseBids() error = %v", err)
+	}
+
+	deal2 := &adapters.BidDemandResponse{ID: "2", ImpID: "1", Price: 2.5, Payload: "deal-2-adm", DemandID: "bidmachine", SeatID: "deals", DealID: "deal-2"}
+	open := &adapters.BidDemandResponse{ID: "0", ImpID: "1", Price: 1.5, Payload: "open-adm", DemandID: "bidmachine", SeatID: "open"}
+	deal1 := &adapters.BidDemandResponse{ID: "1", ImpID: "1", Price: 0.5, Payload: "deal-1-adm", DemandID: "bidmachine", SeatID: "deals", DealID: "deal-1"}
+	if diff := cmp.Diff(deal2, response.Bid); diff != "" {
+		t.Errorf("ParseBids() Bid mismatch (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]*adapters.BidDemandResponse{deal2, open, deal1}, response.Bids); diff != "" {
+		t.Errorf("ParseBids() Bids mismatch (-want, +got):\n%s", diff)
+	}
+}
+
 func TestBidmachine_Builder(t *testing.T) {
 	client := &http.Client{}
 	bmCfg := adapter.ProcessedConfigsMap{
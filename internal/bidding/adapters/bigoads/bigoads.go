@@ -195,25 +195,33 @@ func (a *BigoAdsAdapter) ParseBids(dr *adapters.DemandResponse) (*adapters.Deman
 		return dr, err
 	}
 
-	seat := bidResponse.SeatBid[0]
-	bid := seat.Bid[0]
-
-	dr.Bid = &adapters.BidDemandResponse{
-		ID:       bid.ID,
-		ImpID:    bid.ImpID,
-		Price:    bid.Price,
-		Payload:  bid.AdM,
-		DemandID: adapter.BigoAdsKey,
-		AdID:     bid.AdID,
-		SeatID:   seat.Seat,
-		LURL:     bid.LURL,
-		NURL:     bid.NURL,
-		BURL:     bid.BURL,
+	var bids []*adapters.BidDemandResponse
+	for _, seat := range bidResponse.SeatBid {
+		for _, bid := range seat.Bid {
+			bids = append(bids, &adapters.BidDemandResponse{
+				ID:       bid.ID,
+				ImpID:    bid.ImpID,
+				Price:    bid.Price,
+				Payload:  bid.AdM,
+				DemandID: adapter.BigoAdsKey,
+				AdID:     bid.AdID,
+				SeatID:   seat.Seat,
+				LURL:     bid.LURL,
+				NURL:     bid.NURL,
+				BURL:     bid.BURL,
+				DealID:   bid.DealID,
+			})
+		}
 	}
+	dr.SetBids(bids)
 
 	return dr, nil
 }
 
+func (a *BigoAdsAdapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{Cacheable: true}
+}
+
 // Builder builds a new instance of the BigoAds adapter for the given bidder with the given config.
 func Builder(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
 	bigoCfg := cfg[adapter.BigoAdsKey]
@@ -0,0 +1,452 @@
+package genericrtb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/prebid/openrtb/v19/adcom1"
+	"github.com/prebid/openrtb/v19/openrtb2"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/bidding/openrtb"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+)
+
+// DefinitionKey is the key of *adapter.OpenRTBBidder in processed adapter config.
+// Values of template macros are kept in the config under adapter.OpenRTBMacroAccount, adapter.OpenRTBMacroApp and
+// adapter.OpenRTBMacroAdUnit keys.
+const DefinitionKey = "openrtb"
+
+// AdUnitsKey is the key of []AdUnit in processed adapter config. If TagID template of the definition has ad unit macros,
+// every ad unit is requested as a separate impression of one bid request.
+const AdUnitsKey = "ad_units"
+
+// AdUnit is an RTB ad unit of the demand source.
+type AdUnit struct {
+	UID   string
+	Extra map[string]any
+}
+
+// Placement is an impression of the bid request and the ad unit it is made for.
+type Placement struct {
+	TagID     string
+	AdUnitUID string
+}
+
+const (
+	defaultTokenField  = "token"
+	defaultTokenTarget = "user.buyeruid"
+	defaultPayload     = "adm"
+)
+
+var defaultVideoMIMEs = []string{"video/mp4"}
+
+// Adapter requests a demand source as defined by adapter.OpenRTBBidder of the demand source.
+type Adapter struct {
+	Key        adapter.Key
+	Definition *adapter.OpenRTBBidder
+	// Endpoint, Headers and TagID are templates of Definition with macros replaced.
+	Endpoint string
+	Headers  map[string]string
+	TagID    string
+	// Placements are requested as impressions of the bid request, TagID is of the first of them.
+	Placements []Placement
+}
+
+var bannerFormats = map[ad.Format][2]int64{
+	ad.BannerFormat:      {320, 50},
+	ad.LeaderboardFormat: {728, 90},
+	ad.MRECFormat:        {300, 250},
+	ad.AdaptiveFormat:    {320, 50},
+	ad.EmptyFormat:       {320, 50}, // Default
+}
+
+func (a *Adapter) imp(impDef adapter.OpenRTBImp, auctionRequest *schema.AuctionRequest) *openrtb2.Imp {
+	adType := auctionRequest.AdObject.Type()
+
+	var size [2]int64
+	if adType == ad.BannerType {
+		size = bannerFormats[auctionRequest.AdObject.Format()]
+		if auctionRequest.AdObject.IsAdaptive() && auctionRequest.Device.IsTablet() {
+			size = bannerFormats[ad.LeaderboardFormat]
+		}
+	} else {
+		size = adapters.FullscreenFormats[string(auctionRequest.Device.Type)]
+		if !auctionRequest.AdObject.IsPortrait() {
+			size[0], size[1] = size[1], size[0]
+		}
+	}
+	w, h := size[0], size[1]
+
+	pos := adcom1.PositionAboveFold
+	imp := &openrtb2.Imp{}
+	if adType != ad.BannerType {
+		pos = adcom1.PositionFullScreen
+		imp.Instl = 1
+	}
+	if adType == ad.RewardedType {
+		imp.Rwdd = 1
+	}
+
+	if impDef.Banner {
+		imp.Banner = &openrtb2.Banner{
+			W:   &w,
+			H:   &h,
+			Pos: pos.Ptr(),
+		}
+	}
+	if impDef.Video {
+		mimes := impDef.VideoMIMEs
+		if len(mimes) == 0 {
+			mimes = defaultVideoMIMEs
+		}
+		imp.Video = &openrtb2.Video{
+			W:     w,
+			H:     h,
+			Pos:   pos.Ptr(),
+			MIMEs: mimes,
+		}
+	}
+
+	return imp
+}
+
+func (a *Adapter) CreateRequest(request openrtb.BidRequest, auctionRequest *schema.AuctionRequest) (openrtb.BidRequest, error) {
+	impDef, ok := a.Definition.Imps[string(auctionRequest.AdObject.Type())]
+	if !ok {
+		return request, fmt.Errorf("ad type %q is not supported", auctionRequest.AdObject.Type())
+	}
+
+	tokenField := a.Definition.Token.Field
+	if tokenField == "" {
+		tokenField = defaultTokenField
+	}
+	token, _ := auctionRequest.AdObject.Demands[a.Key][tokenField].(string)
+	if token == "" {
+		return request, errors.New("token is empty")
+	}
+
+	secure := int8(1)
+	imp := a.imp(impDef, auctionRequest)
+	imp.DisplayManager = string(a.Key)
+	imp.DisplayManagerVer = auctionRequest.Adapters[a.Key].SDKVersion
+	imp.Secure = &secure
+	imp.BidFloor = adapters.CalculatePriceFloor(&request, auctionRequest)
+	imp.BidFloorCur = "USD"
+
+	request.User = &openrtb.User{}
+	if err := setToken(&request, imp, a.Definition.Token.Target, token); err != nil {
+		return request, err
+	}
+
+	request.Imp = make([]openrtb2.Imp, 0, len(a.Placements))
+	for _, placement := range a.Placements {
+		placementImp := *imp
+		impID, _ := uuid.NewV4()
+		placementImp.ID = impID.String()
+		placementImp.TagID = placement.TagID
+		request.Imp = append(request.Imp, placementImp)
+	}
+	request.Cur = []string{"USD"}
+
+	return request, nil
+}
+
+// setToken sets the token to the target field of bid request or impression.
+func setToken(request *openrtb.BidRequest, imp *openrtb2.Imp, target, token string) error {
+	if target == "" {
+		target = defaultTokenTarget
+	}
+
+	if target == "user.buyeruid" {
+		request.User.BuyerUID = token
+		return nil
+	}
+
+	ext, err := json.Marshal(map[string]string{target[strings.LastIndex(target, ".")+1:]: token})
+	if err != nil {
+		return err
+	}
+	switch {
+	case strings.HasPrefix(target, "user.ext."):
+		request.User.Ext = ext
+	case strings.HasPrefix(target, "imp.ext."):
+		imp.Ext = ext
+	default:
+		return fmt.Errorf("unknown token target %q", target)
+	}
+
+	return nil
+}
+
+func (a *Adapter) ExecuteRequest(ctx context.Context, client *http.Client, request openrtb.BidRequest) *adapters.DemandResponse {
+	dr := &adapters.DemandResponse{
+		DemandID:  a.Key,
+		RequestID: request.ID,
+		TagID:     a.TagID,
+	}
+	requestBody, err := json.Marshal(request)
+	if err != nil {
+		dr.Error = err
+		return dr
+	}
+	dr.RawRequest = string(requestBody)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, a.Endpoint, bytes.NewBuffer(requestBody))
+	if err != nil {
+		dr.Error = err
+		return dr
+	}
+	httpReq.Header.Add("Content-Type", "application/json")
+	httpReq.Header.Add("X-Openrtb-Version", "2.6")
+	for key, value := range a.Headers {
+		httpReq.Header.Set(key, value)
+	}
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		dr.Error = err
+		return dr
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		dr.Error = err
+		return dr
+	}
+
+	dr.RawResponse = string(respBody)
+	dr.Status = httpResp.StatusCode
+
+	return dr
+}
+
+func (a *Adapter) ParseBids(dr *adapters.DemandResponse) (*adapters.DemandResponse, error) {
+	switch dr.Status {
+	case http.StatusNoContent:
+		return dr, nil
+	case http.StatusOK:
+		break
+	default:
+		return dr, fmt.Errorf("unexpected status code: %s", strconv.Itoa(dr.Status))
+	}
+
+	var bidResponse openrtb2.BidResponse
+	err := json.Unmarshal([]byte(dr.RawResponse), &bidResponse)
+	if err != nil {
+		return dr, err
+	}
+
+	payloadField := a.Definition.Payload
+	if payloadField == "" {
+		payloadField = defaultPayload
+	}
+	placements := a.impPlacements(dr.RawRequest)
+
+	// All bids of all seats take part in the auction
+	var bids []*adapters.BidDemandResponse
+	for _, seat := range bidResponse.SeatBid {
+		for _, bid := range seat.Bid {
+			payload, err := bidField(&bid, payloadField)
+			if err != nil {
+				return dr, err
+			}
+			signaldata, err := bidField(&bid, a.Definition.Signaldata)
+			if err != nil {
+				return dr, err
+			}
+
+			placement := placements[bid.ImpID]
+			bids = append(bids, &adapters.BidDemandResponse{
+				ID:         bid.ID,
+				ImpID:      bid.ImpID,
+				Price:      bid.Price,
+				Payload:    payload,
+				Signaldata: signaldata,
+				DemandID:   a.Key,
+				AdID:       bid.AdID,
+				SeatID:     seat.Seat,
+				LURL:       bid.LURL,
+				NURL:       bid.NURL,
+				BURL:       bid.BURL,
+				DealID:     bid.DealID,
+				TagID:      placement.TagID,
+				AdUnitUID:  placement.AdUnitUID,
+			})
+		}
+	}
+	dr.SetBids(bids)
+
+	return dr, nil
+}
+
+// impPlacements returns placements of the impressions of raw bid request, keyed by impression ID.
+func (a *Adapter) impPlacements(rawRequest string) map[string]Placement {
+	var request struct {
+		Imp []struct {
+			ID    string `json:"id"`
+			TagID string `json:"tagid"`
+		} `json:"imp"`
+	}
+	if err := json.Unmarshal([]byte(rawRequest), &request); err != nil {
+		return nil
+	}
+
+	placements := make(map[string]Placement, len(request.Imp))
+	for _, imp := range request.Imp {
+		for _, placement := range a.Placements {
+			if placement.TagID == imp.TagID {
+				placements[imp.ID] = placement
+				break
+			}
+		}
+	}
+
+	return placements
+}
+
+// bidField returns value of the bid field: "adm" or "ext.<key>". Empty field returns empty string.
+func bidField(bid *openrtb2.Bid, field string) (string, error) {
+	if field == "" {
+		return "", nil
+	}
+	if field == "adm" {
+		return bid.AdM, nil
+	}
+
+	key, ok := strings.CutPrefix(field, "ext.")
+	if !ok {
+		return "", fmt.Errorf("unknown bid field %q", field)
+	}
+
+	var ext map[string]any
+	if len(bid.Ext) > 0 {
+		if err := json.Unmarshal(bid.Ext, &ext); err != nil {
+			return "", fmt.Errorf("unmarshal bid ext: %v", err)
+		}
+	}
+	value, _ := ext[key].(string)
+
+	return value, nil
+}
+
+// CachePolicy doesn't allow caching by default, it can be enabled by bid_cache of demand source account.
+func (a *Adapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{}
+}
+
+// Builder returns builder of generic adapter for the demand source key. Its config must have DefinitionKey set.
+func Builder(key adapter.Key) adapters.Builder {
+	return func(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
+		keyCfg := cfg[key]
+
+		definition, ok := keyCfg[DefinitionKey].(*adapter.OpenRTBBidder)
+		if !ok || definition == nil {
+			return nil, fmt.Errorf("openrtb definition of %s is not set", key)
+		}
+
+		endpoint, err := expand(definition.Endpoint, keyCfg)
+		if err != nil {
+			return nil, fmt.Errorf("endpoint: %v", err)
+		}
+		placements, err := buildPlacements(definition, keyCfg)
+		if err != nil {
+			return nil, fmt.Errorf("tag_id: %v", err)
+		}
+		headers := make(map[string]string, len(definition.Headers))
+		for name, template := range definition.Headers {
+			if headers[name], err = expand(template, keyCfg); err != nil {
+				return nil, fmt.Errorf("header %s: %v", name, err)
+			}
+		}
+
+		adpt := &Adapter{
+			Key:        key,
+			Definition: definition,
+			Endpoint:   endpoint,
+			Headers:    headers,
+			TagID:      placements[0].TagID,
+			Placements: placements,
+		}
+
+		bidder := &adapters.Bidder{
+			Adapter: adpt,
+			Client:  client,
+		}
+
+		return bidder, nil
+	}
+}
+
+// buildPlacements returns placement for every ad unit of AdUnitsKey if TagID template has ad unit macros.
+// Ad units without macro values are skipped. Otherwise, the only placement has TagID expanded with config values.
+func buildPlacements(definition *adapter.OpenRTBBidder, cfg map[string]any) ([]Placement, error) {
+	adUnits, _ := cfg[AdUnitsKey].([]AdUnit)
+	if len(adUnits) == 0 || !strings.Contains(definition.TagID, "{{"+adapter.OpenRTBMacroAdUnit+".") {
+		tagID, err := expand(definition.TagID, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		return []Placement{{TagID: tagID}}, nil
+	}
+
+	adUnitCfg := maps.Clone(cfg)
+	placements := make([]Placement, 0, len(adUnits))
+	var err error
+	for _, adUnit := range adUnits {
+		adUnitCfg[adapter.OpenRTBMacroAdUnit] = adUnit.Extra
+
+		var tagID string
+		tagID, err = expand(definition.TagID, adUnitCfg)
+		if err != nil {
+			continue
+		}
+		if !slices.ContainsFunc(placements, func(p Placement) bool { return p.TagID == tagID }) {
+			placements = append(placements, Placement{TagID: tagID, AdUnitUID: adUnit.UID})
+		}
+	}
+	if len(placements) == 0 {
+		return nil, err
+	}
+
+	return placements, nil
+}
+
+// expand replaces template macros with values of the config. Macros without value are an error.
+func expand(template string, cfg map[string]any) (string, error) {
+	var err error
+	result := adapter.OpenRTBMacroRegexp.ReplaceAllStringFunc(template, func(macro string) string {
+		match := adapter.OpenRTBMacroRegexp.FindStringSubmatch(macro)
+		values, _ := cfg[match[1]].(map[string]any)
+
+		switch value := values[match[2]].(type) {
+		case string:
+			if value != "" {
+				return value
+			}
+		case float64:
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		case int, int64:
+			return fmt.Sprint(value)
+		}
+
+		err = fmt.Errorf("value of %s is not set", macro)
+		return macro
+	})
+
+	return result, err
+}
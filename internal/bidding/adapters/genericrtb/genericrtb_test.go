@@ -0,0 +1,405 @@
+package genericrtb_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/prebid/openrtb/v19/adcom1"
+	"github.com/prebid/openrtb/v19/openrtb2"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/adapterstest"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/genericrtb"
+	"github.com/bidon-io/bidon-backend/internal/bidding/openrtb"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+)
+
+const acmeKey adapter.Key = "acme"
+
+type TestTransport func(req *http.Request) *http.Response
+
+func (f TestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+func ptr[T any](t T) *T {
+	return &t
+}
+
+func testDefinition() *adapter.OpenRTBBidder {
+	return &adapter.OpenRTBBidder{
+		Endpoint: "https://{{account.region}}.acme.test/bid?app={{app.app_id}}",
+		Headers:  map[string]string{"Authorization": "Bearer {{account.token}}"},
+		TagID:    "{{ad_unit.placement_id}}",
+		Imps: map[string]adapter.OpenRTBImp{
+			"banner":   {Banner: true},
+			"rewarded": {Banner: true, Video: true},
+		},
+		Token:      adapter.OpenRTBToken{Field: "bid_token", Target: "imp.ext.bidder_token"},
+		Payload:    "ext.payload",
+		Signaldata: "ext.signaldata",
+	}
+}
+
+func testConfig(definition *adapter.OpenRTBBidder) adapter.ProcessedConfigsMap {
+	return adapter.ProcessedConfigsMap{
+		acmeKey: {
+			genericrtb.DefinitionKey:    definition,
+			adapter.OpenRTBMacroAccount: map[string]any{"region": "eu", "token": "secret"},
+			adapter.OpenRTBMacroApp:     map[string]any{"app_id": float64(42)},
+			adapter.OpenRTBMacroAdUnit:  map[string]any{"placement_id": "placement-1"},
+		},
+	}
+}
+
+func testAuctionRequest(adType ad.Type) *schema.AuctionRequest {
+	adObject := schema.AdObject{
+		Orientation: "PORTRAIT",
+		Demands: map[adapter.Key]map[string]any{
+			acmeKey: {"bid_token": "token-1"},
+		},
+	}
+	switch adType {
+	case ad.BannerType:
+		adObject.Banner = &schema.BannerAdObject{Format: ad.BannerFormat}
+	case ad.InterstitialType:
+		adObject.Interstitial = &schema.InterstitialAdObject{}
+	case ad.RewardedType:
+		adObject.Rewarded = &schema.RewardedAdObject{}
+	}
+
+	return &schema.AuctionRequest{
+		AdType:   adType,
+		AdObject: adObject,
+		BaseRequest: schema.BaseRequest{
+			Device: schema.Device{Type: "PHONE"},
+		},
+		Adapters: schema.Adapters{
+			acmeKey: {Version: "1.0.0", SDKVersion: "2.0.0"},
+		},
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	bidder, err := genericrtb.Builder(acmeKey)(testConfig(testDefinition()), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Builder() error = %v", err)
+	}
+
+	adpt := bidder.Adapter.(*genericrtb.Adapter)
+	if adpt.Endpoint != "https://eu.acme.test/bid?app=42" {
+		t.Errorf("Endpoint = %q", adpt.Endpoint)
+	}
+	if adpt.Headers["Authorization"] != "Bearer secret" {
+		t.Errorf("Headers = %v", adpt.Headers)
+	}
+	if adpt.TagID != "placement-1" {
+		t.Errorf("TagID = %q", adpt.TagID)
+	}
+
+	cfg := testConfig(testDefinition())
+	delete(cfg[acmeKey], adapter.OpenRTBMacroAdUnit)
+	if _, err := genericrtb.Builder(acmeKey)(cfg, http.DefaultClient); err == nil {
+		t.Errorf("Builder() without ad unit, want error")
+	}
+
+	if _, err := genericrtb.Builder(acmeKey)(adapter.ProcessedConfigsMap{acmeKey: {}}, http.DefaultClient); err == nil {
+		t.Errorf("Builder() without definition, want error")
+	}
+}
+
+func TestAdapter_CreateRequest(t *testing.T) {
+	bidder, err := genericrtb.Builder(acmeKey)(testConfig(testDefinition()), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Builder() error = %v", err)
+	}
+	baseRequest := openrtb.BidRequest{ID: "request-1", Imp: []openrtb2.Imp{{BidFloor: 0.5}}}
+
+	tests := []struct {
+		name    string
+		adType  ad.Type
+		want    openrtb2.Imp
+		wantErr bool
+	}{
+		{
+			name:   "banner",
+			adType: ad.BannerType,
+			want: openrtb2.Imp{
+				TagID:             "placement-1",
+				DisplayManager:    "acme",
+				DisplayManagerVer: "2.0.0",
+				Secure:            ptr(int8(1)),
+				BidFloor:          0.5,
+				BidFloorCur:       "USD",
+				Banner:            &openrtb2.Banner{W: ptr(int64(320)), H: ptr(int64(50)), Pos: adcom1.PositionAboveFold.Ptr()},
+				Ext:               json.RawMessage(`{"bidder_token":"token-1"}`),
+			},
+		},
+		{
+			name:   "rewarded",
+			adType: ad.RewardedType,
+			want: openrtb2.Imp{
+				TagID:             "placement-1",
+				DisplayManager:    "acme",
+				DisplayManagerVer: "2.0.0",
+				Secure:            ptr(int8(1)),
+				BidFloor:          0.5,
+				BidFloorCur:       "USD",
+				Instl:             1,
+				Rwdd:              1,
+				Banner:            &openrtb2.Banner{W: ptr(int64(320)), H: ptr(int64(480)), Pos: adcom1.PositionFullScreen.Ptr()},
+				Video:             &openrtb2.Video{W: 320, H: 480, Pos: adcom1.PositionFullScreen.Ptr(), MIMEs: []string{"video/mp4"}},
+				Ext:               json.RawMessage(`{"bidder_token":"token-1"}`),
+			},
+		},
+		{
+			name:    "ad type without imp",
+			adType:  ad.InterstitialType,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := bidder.Adapter.CreateRequest(baseRequest, testAuctionRequest(tt.adType))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(request.Imp) != 1 {
+				t.Fatalf("CreateRequest() imps = %v, want 1 imp", request.Imp)
+			}
+			if diff := cmp.Diff(tt.want, request.Imp[0], cmpopts.IgnoreFields(openrtb2.Imp{}, "ID")); diff != "" {
+				t.Errorf("CreateRequest() imp mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]string{"USD"}, request.Cur); diff != "" {
+				t.Errorf("CreateRequest() cur mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdapter_CreateRequest_BuyerUID(t *testing.T) {
+	definition := testDefinition()
+	definition.Token = adapter.OpenRTBToken{}
+	bidder, err := genericrtb.Builder(acmeKey)(testConfig(definition), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Builder() error = %v", err)
+	}
+
+	auctionRequest := testAuctionRequest(ad.BannerType)
+	auctionRequest.AdObject.Demands[acmeKey] = map[string]any{"token": "token-2"}
+	request, err := bidder.Adapter.CreateRequest(openrtb.BidRequest{Imp: []openrtb2.Imp{{}}}, auctionRequest)
+	if err != nil {
+		t.Fatalf("CreateRequest() error = %v", err)
+	}
+
+	if request.User == nil || request.User.BuyerUID != "token-2" {
+		t.Errorf("CreateRequest() user = %+v, want buyeruid token-2", request.User)
+	}
+}
+
+func TestAdapter_ExecuteRequest(t *testing.T) {
+	var gotReq *http.Request
+	client := &http.Client{
+		Transport: TestTransport(func(req *http.Request) *http.Response {
+			gotReq = req
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"id":"request-1"}`)),
+			}
+		}),
+	}
+	bidder, err := genericrtb.Builder(acmeKey)(testConfig(testDefinition()), client)
+	if err != nil {
+		t.Fatalf("Builder() error = %v", err)
+	}
+
+	dr := bidder.Adapter.ExecuteRequest(context.Background(), bidder.Client, openrtb.BidRequest{ID: "request-1"})
+	if dr.Error != nil {
+		t.Fatalf("ExecuteRequest() error = %v", dr.Error)
+	}
+
+	if gotReq.URL.String() != "https://eu.acme.test/bid?app=42" {
+		t.Errorf("ExecuteRequest() url = %v", gotReq.URL)
+	}
+	if gotReq.Header.Get("Authorization") != "Bearer secret" || gotReq.Header.Get("X-Openrtb-Version") != "2.6" {
+		t.Errorf("ExecuteRequest() headers = %v", gotReq.Header)
+	}
+	want := &adapters.DemandResponse{
+		DemandID:    acmeKey,
+		RequestID:   "request-1",
+		TagID:       "placement-1",
+		RawRequest:  `{"id":"request-1","imp":null}`,
+		RawResponse: `{"id":"request-1"}`,
+		Status:      http.StatusOK,
+	}
+	if diff := cmp.Diff(want, dr); diff != "" {
+		t.Errorf("ExecuteRequest() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAdapter_ParseBids(t *testing.T) {
+	bidder, err := genericrtb.Builder(acmeKey)(testConfig(testDefinition()), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Builder() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		dr      adapters.DemandResponse
+		want    *adapters.BidDemandResponse
+		wantErr bool
+	}{
+		{
+			name: "highest bid",
+			dr: adapters.DemandResponse{
+				Status: http.StatusOK,
+				RawResponse: `{"id":"1","seatbid":[
+					{"seat":"a","bid":[{"id":"bid-1","impid":"imp","price":1.2,"ext":{"payload":"p1"}}]},
+					{"seat":"b","bid":[{"id":"bid-2","impid":"imp","price":2.5,"nurl":"https://nurl","ext":{"payload":"p2","signaldata":"s2"}}]}
+				]}`,
+			},
+			want: &adapters.BidDemandResponse{
+				ID:         "bid-2",
+				ImpID:      "imp",
+				Price:      2.5,
+				Payload:    "p2",
+				Signaldata: "s2",
+				DemandID:   acmeKey,
+				SeatID:     "b",
+				NURL:       "https://nurl",
+			},
+		},
+		{
+			name: "empty seatbid",
+			dr:   adapters.DemandResponse{Status: http.StatusOK, RawResponse: `{"id":"1","seatbid":[]}`},
+		},
+		{
+			name: "no content",
+			dr:   adapters.DemandResponse{Status: http.StatusNoContent},
+		},
+		{
+			name:    "bad request",
+			dr:      adapters.DemandResponse{Status: http.StatusBadRequest},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr, err := bidder.Adapter.ParseBids(&tt.dr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBids() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, dr.Bid); diff != "" {
+				t.Errorf("ParseBids() bid mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdapter_MultipleAdUnits(t *testing.T) {
+	cfg := testConfig(testDefinition())
+	cfg[acmeKey][genericrtb.AdUnitsKey] = []genericrtb.AdUnit{
+		{UID: "unit-1", Extra: map[string]any{"placement_id": "placement-1"}},
+		{UID: "unit-2", Extra: map[string]any{"placement_id": "placement-2"}},
+		{UID: "unit-3", Extra: map[string]any{}},
+	}
+	bidder, err := genericrtb.Builder(acmeKey)(cfg, http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Builder() error = %v", err)
+	}
+
+	request, err := bidder.Adapter.CreateRequest(openrtb.BidRequest{ID: "request-1", Imp: []openrtb2.Imp{{}}}, testAuctionRequest(ad.BannerType))
+	if err != nil {
+		t.Fatalf("CreateRequest() error = %v", err)
+	}
+	if len(request.Imp) != 2 || request.Imp[0].TagID != "placement-1" || request.Imp[1].TagID != "placement-2" {
+		t.Fatalf("CreateRequest() imps = %+v, want placement-1 and placement-2", request.Imp)
+	}
+	if request.Imp[0].ID == request.Imp[1].ID {
+		t.Errorf("CreateRequest() imps have the same id %q", request.Imp[0].ID)
+	}
+
+	rawRequest, _ := json.Marshal(request)
+	dr, err := bidder.Adapter.ParseBids(&adapters.DemandResponse{
+		Status:     http.StatusOK,
+		RawRequest: string(rawRequest),
+		RawResponse: `{"id":"request-1","seatbid":[
+			{"seat":"a","bid":[{"id":"bid-1","impid":"` + request.Imp[0].ID + `","price":1.2,"ext":{"payload":"p1"}}]},
+			{"seat":"b","bid":[{"id":"bid-2","impid":"` + request.Imp[1].ID + `","price":2.5,"ext":{"payload":"p2"}}]}
+		]}`,
+	})
+	if err != nil {
+		t.Fatalf("ParseBids() error = %v", err)
+	}
+
+	want := []*adapters.BidDemandResponse{
+		{
+			ID:        "bid-2",
+			ImpID:     request.Imp[1].ID,
+			Price:     2.5,
+			Payload:   "p2",
+			DemandID:  acmeKey,
+			SeatID:    "b",
+			TagID:     "placement-2",
+			AdUnitUID: "unit-2",
+		},
+		{
+			ID:        "bid-1",
+			ImpID:     request.Imp[0].ID,
+			Price:     1.2,
+			Payload:   "p1",
+			DemandID:  acmeKey,
+			SeatID:    "a",
+			TagID:     "placement-1",
+			AdUnitUID: "unit-1",
+		},
+	}
+	if diff := cmp.Diff(want, dr.Bids); diff != "" {
+		t.Errorf("ParseBids() bids mismatch (-want +got):\n%s", diff)
+	}
+	if dr.Bid != dr.Bids[0] {
+		t.Errorf("ParseBids() bid = %+v, want the highest", dr.Bid)
+	}
+
+	responses := dr.Split()
+	if len(responses) != 2 || responses[0].TagID != "placement-2" || responses[1].Bid.ID != "bid-1" {
+		t.Errorf("Split() = %+v, want response per bid", responses)
+	}
+}
+
+func TestConformance(t *testing.T) {
+	definition := &adapter.OpenRTBBidder{
+		Endpoint: "https://{{account.region}}.acme.test/bid",
+		TagID:    "{{ad_unit.placement_id}}",
+		Imps: map[string]adapter.OpenRTBImp{
+			"banner":       {Banner: true},
+			"interstitial": {Banner: true, Video: true},
+			"rewarded":     {Video: true},
+		},
+		Signaldata: "ext.signaldata",
+	}
+
+	adapterstest.Run(t, adapterstest.Suite{
+		Key:     acmeKey,
+		Builder: genericrtb.Builder(acmeKey),
+		Config: map[string]any{
+			genericrtb.DefinitionKey:    definition,
+			adapter.OpenRTBMacroAccount: map[string]any{"region": "eu"},
+			adapter.OpenRTBMacroAdUnit:  map[string]any{"placement_id": "placement-1"},
+		},
+	})
+}
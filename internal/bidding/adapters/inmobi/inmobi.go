@@ -204,33 +204,33 @@ func (a *InMobiAdapter) ParseBids(dr *adapters.DemandResponse) (*adapters.Demand
 		return dr, err
 	}
 
-	if len(bidResponse.SeatBid) == 0 {
-		return dr, nil
-	}
-
-	seat := bidResponse.SeatBid[0]
-	if len(seat.Bid) == 0 {
-		return dr, nil
-	}
-
-	bid := seat.Bid[0]
-
-	dr.Bid = &adapters.BidDemandResponse{
-		ID:       bid.ID,
-		ImpID:    bid.ImpID,
-		Price:    bid.Price,
-		Payload:  bid.AdM,
-		DemandID: adapter.InmobiKey,
-		AdID:     bid.AdID,
-		SeatID:   seat.Seat,
-		LURL:     bid.LURL,
-		NURL:     bid.NURL,
-		BURL:     bid.BURL,
+	var bids []*adapters.BidDemandResponse
+	for _, seat := range bidResponse.SeatBid {
+		for _, bid := range seat.Bid {
+			bids = append(bids, &adapters.BidDemandResponse{
+				ID:       bid.ID,
+				ImpID:    bid.ImpID,
+				Price:    bid.Price,
+				Payload:  bid.AdM,
+				DemandID: adapter.InmobiKey,
+				AdID:     bid.AdID,
+				SeatID:   seat.Seat,
+				LURL:     bid.LURL,
+				NURL:     bid.NURL,
+				BURL:     bid.BURL,
+				DealID:   bid.DealID,
+			})
+		}
 	}
+	dr.SetBids(bids)
 
 	return dr, nil
 }
 
+func (a *InMobiAdapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{Cacheable: true}
+}
+
 // Builder builds a new instance of the InMobi adapter for the given bidder with the given config.
 func Builder(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
 	inmobiCfg := cfg[adapter.InmobiKey]
@@ -89,8 +89,9 @@ func (a *MetaAdapter) rewarded(auctionRequest *schema.AuctionRequest) *openrtb2.
 	}
 }
 
-func (a *MetaAdapter) timeoutURL(platformID string) string {
-	return "https://www.facebook.com/audiencenetwork/nurl/?partner=" + platformID + "&app=" + a.AppID + "&auction=${AUCTION_ID}&ortb_loss_code=2"
+// TimeoutURL returns Meta notice URL for bid requests that time out.
+func (a *MetaAdapter) TimeoutURL(_ openrtb.BidRequest) string {
+	return "https://www.facebook.com/audiencenetwork/nurl/?partner=" + a.PlatformID + "&app=" + a.AppID + "&auction=${AUCTION_ID}&ortb_loss_code=2"
 }
 
 func (a *MetaAdapter) CreateRequest(request openrtb.BidRequest, auctionRequest *schema.AuctionRequest) (openrtb.BidRequest, error) {
@@ -147,7 +148,7 @@ func (a *MetaAdapter) ExecuteRequest(ctx context.Context, client *http.Client, r
 	dr := &adapters.DemandResponse{
 		DemandID:   adapter.MetaKey,
 		RequestID:  request.ID,
-		TimeoutURL: a.timeoutURL(a.PlatformID),
+		TimeoutURL: a.TimeoutURL(request),
 		TagID:      a.TagID,
 	}
 	requestBody, err := json.Marshal(request)
@@ -211,25 +212,33 @@ func (a *MetaAdapter) ParseBids(dr *adapters.DemandResponse) (*adapters.DemandRe
 		return dr, err
 	}
 
-	seat := bidResponse.SeatBid[0]
-	bid := seat.Bid[0]
-
-	dr.Bid = &adapters.BidDemandResponse{
-		ID:       bid.ID,
-		ImpID:    bid.ImpID,
-		Price:    bid.Price,
-		Payload:  bid.AdM,
-		DemandID: adapter.MetaKey,
-		AdID:     bid.AdID,
-		SeatID:   seat.Seat,
-		LURL:     bid.LURL,
-		NURL:     bid.NURL,
-		BURL:     bid.BURL,
+	var bids []*adapters.BidDemandResponse
+	for _, seat := range bidResponse.SeatBid {
+		for _, bid := range seat.Bid {
+			bids = append(bids, &adapters.BidDemandResponse{
+				ID:       bid.ID,
+				ImpID:    bid.ImpID,
+				Price:    bid.Price,
+				Payload:  bid.AdM,
+				DemandID: adapter.MetaKey,
+				AdID:     bid.AdID,
+				SeatID:   seat.Seat,
+				LURL:     bid.LURL,
+				NURL:     bid.NURL,
+				BURL:     bid.BURL,
+				DealID:   bid.DealID,
+			})
+		}
 	}
+	dr.SetBids(bids)
 
 	return dr, nil
 }
 
+func (a *MetaAdapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{Cacheable: true}
+}
+
 // Builder builds a new instance of the Meta adapter for the given bidder with the given config.
 func Builder(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
 	mCfg := cfg[adapter.MetaKey]
@@ -203,29 +203,33 @@ func (a *MintegralAdapter) ParseBids(dr *adapters.DemandResponse) (*adapters.Dem
 		return dr, err
 	}
 
-	if bidResponse.SeatBid == nil {
-		return dr, nil
-	}
-
-	seat := bidResponse.SeatBid[0]
-	bid := seat.Bid[0]
-
-	dr.Bid = &adapters.BidDemandResponse{
-		ID:       bid.ID,
-		ImpID:    bid.ImpID,
-		Price:    bid.Price,
-		Payload:  bid.AdM,
-		DemandID: adapter.MintegralKey,
-		AdID:     bid.AdID,
-		SeatID:   seat.Seat,
-		LURL:     bid.LURL,
-		NURL:     bid.NURL,
-		BURL:     bid.BURL,
+	var bids []*adapters.BidDemandResponse
+	for _, seat := range bidResponse.SeatBid {
+		for _, bid := range seat.Bid {
+			bids = append(bids, &adapters.BidDemandResponse{
+				ID:       bid.ID,
+				ImpID:    bid.ImpID,
+				Price:    bid.Price,
+				Payload:  bid.AdM,
+				DemandID: adapter.MintegralKey,
+				AdID:     bid.AdID,
+				SeatID:   seat.Seat,
+				LURL:     bid.LURL,
+				NURL:     bid.NURL,
+				BURL:     bid.BURL,
+				DealID:   bid.DealID,
+			})
+		}
 	}
+	dr.SetBids(bids)
 
 	return dr, nil
 }
 
+func (a *MintegralAdapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{Cacheable: true}
+}
+
 // Builder builds a new instance of the Mintegral adapter for the given bidder with the given config.
 func Builder(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
 	mCfg := cfg[adapter.MintegralKey]
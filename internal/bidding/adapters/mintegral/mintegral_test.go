@@ -349,6 +349,17 @@ func TestMintegral_ParseBids(t *testing.T) {
 	}`
 	adapter := buildAdapter()
 
+	bid := &adapters.BidDemandResponse{
+		ID:       "0",
+		ImpID:    "6579ca7b-7e2c-48b6-8915-46efa6530fb5",
+		Price:    1.5,
+		Payload:  "0692d0a0efdbd5bd470dafea742cef6a1f6b840c5c83240e165bc33a038b3d5487e25a52",
+		DemandID: "mintegral",
+		AdID:     "Mintegralad5e0471131b8a4e3c",
+		LURL:     "https://api.gov-static.tech/Ad/AdxEvent?sid=0&sslot=10182906-10163778",
+		NURL:     "https://api.gov-static.tech/Ad/AdxEvent?sid=0&sslot=10182906-10163778&adtype=4",
+	}
+
 	testCases := []struct {
 		name   string
 		params ParseBidsTestParams
@@ -366,16 +377,8 @@ func TestMintegral_ParseBids(t *testing.T) {
 				DemandResponse: adapters.DemandResponse{
 					Status:      200,
 					RawResponse: rawResponse,
-					Bid: &adapters.BidDemandResponse{
-						ID:       "0",
-						ImpID:    "6579ca7b-7e2c-48b6-8915-46efa6530fb5",
-						Price:    1.5,
-						Payload:  "0692d0a0efdbd5bd470dafea742cef6a1f6b840c5c83240e165bc33a038b3d5487e25a52",
-						DemandID: "mintegral",
-						AdID:     "Mintegralad5e0471131b8a4e3c",
-						LURL:     "https://api.gov-static.tech/Ad/AdxEvent?sid=0&sslot=10182906-10163778",
-						NURL:     "https://api.gov-static.tech/Ad/AdxEvent?sid=0&sslot=10182906-10163778&adtype=4",
-					},
+					Bid:         bid,
+					Bids:        []*adapters.BidDemandResponse{bid},
 				},
 				Err: nil,
 			},
@@ -207,33 +207,41 @@ func (a *MobileFuseAdapter) ParseBids(dr *adapters.DemandResponse) (*adapters.De
 		return dr, err
 	}
 
-	seat := bidResponse.SeatBid[0]
-	bid := seat.Bid[0]
-
-	var extParam map[string]any
-	err = json.Unmarshal(bid.Ext, &extParam)
-	if err != nil {
-		return dr, err
-	}
-	signaldata := extParam["signaldata"].(string)
-
-	dr.Bid = &adapters.BidDemandResponse{
-		ID:         bid.ID,
-		ImpID:      bid.ImpID,
-		Price:      bid.Price,
-		Payload:    bid.AdM,
-		Signaldata: signaldata,
-		DemandID:   adapter.MobileFuseKey,
-		AdID:       bid.AdID,
-		SeatID:     seat.Seat,
-		LURL:       bid.LURL,
-		NURL:       bid.NURL,
-		BURL:       bid.BURL,
+	var bids []*adapters.BidDemandResponse
+	for _, seat := range bidResponse.SeatBid {
+		for _, bid := range seat.Bid {
+			var extParam map[string]any
+			err := json.Unmarshal(bid.Ext, &extParam)
+			if err != nil {
+				return dr, err
+			}
+			signaldata := extParam["signaldata"].(string)
+
+			bids = append(bids, &adapters.BidDemandResponse{
+				ID:         bid.ID,
+				ImpID:      bid.ImpID,
+				Price:      bid.Price,
+				Payload:    bid.AdM,
+				Signaldata: signaldata,
+				DemandID:   adapter.MobileFuseKey,
+				AdID:       bid.AdID,
+				SeatID:     seat.Seat,
+				LURL:       bid.LURL,
+				NURL:       bid.NURL,
+				BURL:       bid.BURL,
+				DealID:     bid.DealID,
+			})
+		}
 	}
+	dr.SetBids(bids)
 
 	return dr, nil
 }
 
+func (a *MobileFuseAdapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{Cacheable: true}
+}
+
 // Builder builds a new instance of the MobileFuse adapter for the given bidder with the given config.
 func Builder(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
 	mobileFuseCfg := cfg[adapter.MobileFuseKey]
@@ -426,6 +426,18 @@ func TestMobileFuse_ParseBids(t *testing.T) {
 	}`
 	adapter := buildAdapter()
 
+	bid := &adapters.BidDemandResponse{
+		ID:         "9f21299b7b7b6ad7fe30226748c57abf_banner",
+		ImpID:      "1",
+		Price:      1.904,
+		Payload:    "",
+		Signaldata: "H4sIAAAAAAAAA41Qy27CMBD8lcqqOBESm0dwBFQV6gGpKhW0t0iRYzbCkMSW16FUiH/v0l565LaPmZ3ZuTCzYxkbigkXIhWVUDxVMp1KBSCFVFKJpJQj1mfaNSzjA5mMqPa/rAAYigMWpg3gMZhgVE3I8O2AtqVqW/DUqx0x2QyJ5cIDej3P2T4Eh1ke53FTnaMOI1AYIj5obGlqqDqEgbZNHh8w+vLKOfBPZj5JqulYas5hJNNKqqoc82malqClHkquiyRni1ke/yktSBrOznhAlg0nSdJntUUsOl+TnXsN1AS/S7p3Oz5/vDx/Lj9W67fidb3dXnvOGw3/pu+b1fLlSs6aLgBlyCnM2uhjUcJenYz1t+Bs2BOirC3N8WhcgaBtu6M3xn12Ypm4/gBBRP5ctwEAAA==",
+		DemandID:   "mobilefuse",
+		AdID:       "",
+		LURL:       "https://mfx-us-east-1.mobilefuse.com/lurl?i=60f859c11e497f9afb51877bec9c391c_0&loss=${AUCTION_LOSS}&price=${AUCTION_PRICE}",
+		NURL:       "",
+	}
+
 	testCases := []struct {
 		name   string
 		params ParseBidsTestParams
@@ -443,17 +455,8 @@ func TestMobileFuse_ParseBids(t *testing.T) {
 				DemandResponse: adapters.DemandResponse{
 					Status:      200,
 					RawResponse: rawResponse,
-					Bid: &adapters.BidDemandResponse{
-						ID:         "9f21299b7b7b6ad7fe30226748c57abf_banner",
-						ImpID:      "1",
-						Price:      1.904,
-						Payload:    "",
-						Signaldata: "H4sIAAAAAAAAA41Qy27CMBD8lcqqOBESm0dwBFQV6gGpKhW0t0iRYzbCkMSW16FUiH/v0l565LaPmZ3ZuTCzYxkbigkXIhWVUDxVMp1KBSCFVFKJpJQj1mfaNSzjA5mMqPa/rAAYigMWpg3gMZhgVE3I8O2AtqVqW/DUqx0x2QyJ5cIDej3P2T4Eh1ke53FTnaMOI1AYIj5obGlqqDqEgbZNHh8w+vLKOfBPZj5JqulYas5hJNNKqqoc82malqClHkquiyRni1ke/yktSBrOznhAlg0nSdJntUUsOl+TnXsN1AS/S7p3Oz5/vDx/Lj9W67fidb3dXnvOGw3/pu+b1fLlSs6aLgBlyCnM2uhjUcJenYz1t+Bs2BOirC3N8WhcgaBtu6M3xn12Ypm4/gBBRP5ctwEAAA==",
-						DemandID:   "mobilefuse",
-						AdID:       "",
-						LURL:       "https://mfx-us-east-1.mobilefuse.com/lurl?i=60f859c11e497f9afb51877bec9c391c_0&loss=${AUCTION_LOSS}&price=${AUCTION_PRICE}",
-						NURL:       "",
-					},
+					Bid:         bid,
+					Bids:        []*adapters.BidDemandResponse{bid},
 				},
 				Err: nil,
 			},
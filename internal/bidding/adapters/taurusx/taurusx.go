@@ -240,13 +240,6 @@ func (a *TaurusXAdapter) ParseBids(dr *adapters.DemandResponse) (*adapters.Deman
 		return dr, err
 	}
 
-	if len(bidResponse.SeatBid) == 0 || len(bidResponse.SeatBid[0].Bid) == 0 {
-		return dr, nil
-	}
-
-	seat := bidResponse.SeatBid[0]
-	bid := seat.Bid[0]
-
 	payload := ""
 	if bidResponse.Ext != nil {
 		var extData map[string]interface{}
@@ -260,22 +253,33 @@ func (a *TaurusXAdapter) ParseBids(dr *adapters.DemandResponse) (*adapters.Deman
 		}
 	}
 
-	dr.Bid = &adapters.BidDemandResponse{
-		ID:       bid.ID,
-		ImpID:    bid.ImpID,
-		Price:    bid.Price,
-		Payload:  payload,
-		DemandID: adapter.TaurusXKey,
-		AdID:     bid.AdID,
-		SeatID:   seat.Seat,
-		LURL:     bid.LURL,
-		NURL:     bid.NURL,
-		BURL:     bid.BURL,
+	var bids []*adapters.BidDemandResponse
+	for _, seat := range bidResponse.SeatBid {
+		for _, bid := range seat.Bid {
+			bids = append(bids, &adapters.BidDemandResponse{
+				ID:       bid.ID,
+				ImpID:    bid.ImpID,
+				Price:    bid.Price,
+				Payload:  payload,
+				DemandID: adapter.TaurusXKey,
+				AdID:     bid.AdID,
+				SeatID:   seat.Seat,
+				LURL:     bid.LURL,
+				NURL:     bid.NURL,
+				BURL:     bid.BURL,
+				DealID:   bid.DealID,
+			})
+		}
 	}
+	dr.SetBids(bids)
 
 	return dr, nil
 }
 
+func (a *TaurusXAdapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{Cacheable: true}
+}
+
 // Builder builds a new instance of the TaurusX adapter for the given bidder with the given config.
 func Builder(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
 	tCfg := cfg[adapter.TaurusXKey]
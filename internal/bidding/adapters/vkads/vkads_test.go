@@ -357,6 +357,16 @@ func TestVKAds_ParseBids(t *testing.T) {
 	}`
 	a := buildAdapter()
 
+	bid := &adapters.BidDemandResponse{
+		ID:       "2:1::669e29a737559894",
+		ImpID:    "7703af66-0ec1-475f-b5a8-eda9d65c44e6",
+		Price:    1.5,
+		DemandID: "vkads",
+		AdID:     "162456424",
+		LURL:     "https://rs.mail.ru",
+		NURL:     "https://rs.mail.ru/pixel/Q.gif?price=37.27&currency=RUB",
+	}
+
 	testCases := []struct {
 		name   string
 		params ParseBidsTestParams
@@ -374,15 +384,8 @@ func TestVKAds_ParseBids(t *testing.T) {
 				DemandResponse: adapters.DemandResponse{
 					Status:      200,
 					RawResponse: rawResponse,
-					Bid: &adapters.BidDemandResponse{
-						ID:       "2:1::669e29a737559894",
-						ImpID:    "7703af66-0ec1-475f-b5a8-eda9d65c44e6",
-						Price:    1.5,
-						DemandID: "vkads",
-						AdID:     "162456424",
-						LURL:     "https://rs.mail.ru",
-						NURL:     "https://rs.mail.ru/pixel/Q.gif?price=37.27&currency=RUB",
-					},
+					Bid:         bid,
+					Bids:        []*adapters.BidDemandResponse{bid},
 				},
 				Err: nil,
 			},
@@ -210,46 +210,46 @@ func (a *YandexAdapter) ParseBids(dr *adapters.DemandResponse) (*adapters.Demand
 		return dr, err
 	}
 
-	if bidResponse.SeatBid == nil || len(bidResponse.SeatBid) == 0 {
-		return dr, nil
-	}
-
-	seat := bidResponse.SeatBid[0]
-	if len(seat.Bid) == 0 {
-		return dr, nil
-	}
-
-	bid := seat.Bid[0]
-
 	// Extract signaldata from bid.ext
 	type BidExt struct {
 		SignalData string `json:"signaldata"`
 	}
 
-	var bidExt BidExt
-	if bid.Ext != nil {
-		if err := json.Unmarshal(bid.Ext, &bidExt); err != nil {
-			return dr, err
+	var bids []*adapters.BidDemandResponse
+	for _, seat := range bidResponse.SeatBid {
+		for _, bid := range seat.Bid {
+			var bidExt BidExt
+			if bid.Ext != nil {
+				if err := json.Unmarshal(bid.Ext, &bidExt); err != nil {
+					return dr, err
+				}
+			}
+
+			bids = append(bids, &adapters.BidDemandResponse{
+				ID:         bid.ID,
+				ImpID:      bid.ImpID,
+				Price:      bid.Price,
+				Payload:    bid.AdM,
+				Signaldata: bidExt.SignalData,
+				DemandID:   adapter.YandexKey,
+				AdID:       bid.AdID,
+				SeatID:     seat.Seat,
+				LURL:       bid.LURL,
+				NURL:       bid.NURL,
+				BURL:       bid.BURL,
+				DealID:     bid.DealID,
+			})
 		}
 	}
-
-	dr.Bid = &adapters.BidDemandResponse{
-		ID:         bid.ID,
-		ImpID:      bid.ImpID,
-		Price:      bid.Price,
-		Payload:    bid.AdM,
-		Signaldata: bidExt.SignalData,
-		DemandID:   adapter.YandexKey,
-		AdID:       bid.AdID,
-		SeatID:     seat.Seat,
-		LURL:       bid.LURL,
-		NURL:       bid.NURL,
-		BURL:       bid.BURL,
-	}
+	dr.SetBids(bids)
 
 	return dr, nil
 }
 
+func (a *YandexAdapter) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{Cacheable: true}
+}
+
 // Builder builds a new instance of the Yandex adapter for the given bidder with the given config.
 func Builder(cfg adapter.ProcessedConfigsMap, client *http.Client) (*adapters.Bidder, error) {
 	yandexCfg := cfg[adapter.YandexKey]
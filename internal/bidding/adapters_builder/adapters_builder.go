@@ -9,8 +9,10 @@ import (
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/auction"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/amazon"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/bidmachine"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/bigoads"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/genericrtb"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/inmobi"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/meta"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/mintegral"
@@ -25,6 +27,7 @@ import (
 )
 
 var biddingAdapters = map[adapter.Key]adapters.Builder{
+	adapter.AmazonKey:     amazon.Builder,
 	adapter.BidmachineKey: bidmachine.Builder,
 	adapter.BigoAdsKey:    bigoads.Builder,
 	adapter.InmobiKey:     inmobi.Builder,
@@ -50,8 +53,19 @@ type AdaptersBuilder struct {
 }
 
 func (b AdaptersBuilder) Build(adapterKey adapter.Key, cfg adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
-	if f, ok := b.AdaptersMap[adapterKey]; ok {
-		return f(cfg, b.Client)
+	f, ok := b.AdaptersMap[adapterKey]
+	if !ok && cfg[adapterKey][genericrtb.DefinitionKey] != nil {
+		// Demand source without dedicated adapter defined in admin panel
+		f, ok = genericrtb.Builder(adapterKey), true
+	}
+	if ok {
+		bidder, err := f(cfg, b.Client)
+		if err != nil {
+			return nil, err
+		}
+
+		bidder.CachePolicyOverride = adapters.CachePolicyOverrideFromConfig(cfg[adapterKey])
+		return bidder, nil
 	}
 
 	return nil, fmt.Errorf("adapter %s not found", adapterKey)
@@ -106,6 +120,7 @@ func (b *AdaptersConfigBuilder) Build(ctx context.Context, appID int64, adapterK
 		switch key {
 		case adapter.AmazonKey:
 			adaptersMap[key]["price_points_map"] = extra["price_points_map"]
+			adaptersMap[key][amazon.SlotsKey] = amazonSlots(adUnitsMap)
 		case adapter.BidmachineKey:
 			adaptersMap[key]["seller_id"] = extra["seller_id"]
 			adaptersMap[key]["endpoint"] = extra["endpoint"]
@@ -193,9 +208,55 @@ func (b *AdaptersConfigBuilder) Build(ctx context.Context, appID int64, adapterK
 				adaptersMap[key]["ad_unit_id"] = adUnit.Extra["ad_unit_id"]
 			}
 		default:
-			adaptersMap[key] = extra
+			if profile.OpenRTB != nil {
+				adaptersMap[key] = openRTBConfig(profile, adUnitsMap, key)
+			} else {
+				adaptersMap[key] = extra
+			}
+		}
+
+		if _, ok := adaptersMap[key]["bid_cache"]; !ok && extra["bid_cache"] != nil {
+			adaptersMap[key]["bid_cache"] = extra["bid_cache"]
 		}
+		adaptersMap[key][adapter.AccountIDConfigKey] = profile.AccountID
 	}
 
 	return adaptersMap, nil
 }
+
+// amazonSlots returns UIDs of Amazon RTB ad units keyed by their slot UUIDs.
+func amazonSlots(adUnitsMap *auction.AdUnitsMap) map[string]string {
+	adUnits, _ := adUnitsMap.All(adapter.AmazonKey, schema.RTBBidType)
+	slots := make(map[string]string, len(adUnits))
+	for _, adUnit := range adUnits {
+		if slotUUID, ok := adUnit.Extra["slot_uuid"].(string); ok {
+			slots[slotUUID] = adUnit.UID
+		}
+	}
+
+	return slots
+}
+
+// openRTBConfig returns config of generic OpenRTB adapter: bidder definition, values of its template macros and RTB
+// ad units of the demand source.
+func openRTBConfig(profile adapter.Config, adUnitsMap *auction.AdUnitsMap, key adapter.Key) map[string]any {
+	cfg := map[string]any{
+		genericrtb.DefinitionKey:    profile.OpenRTB,
+		adapter.OpenRTBMacroAccount: profile.AccountExtra,
+		adapter.OpenRTBMacroApp:     profile.AppData,
+	}
+
+	adUnit, _ := adUnitsMap.First(key, schema.RTBBidType)
+	if adUnit != nil {
+		cfg[adapter.OpenRTBMacroAdUnit] = adUnit.Extra
+	}
+
+	rtbAdUnits, _ := adUnitsMap.All(key, schema.RTBBidType)
+	adUnits := make([]genericrtb.AdUnit, 0, len(rtbAdUnits))
+	for _, adUnit := range rtbAdUnits {
+		adUnits = append(adUnits, genericrtb.AdUnit{UID: adUnit.UID, Extra: adUnit.Extra})
+	}
+	cfg[genericrtb.AdUnitsKey] = adUnits
+
+	return cfg
+}
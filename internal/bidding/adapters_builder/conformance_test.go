@@ -0,0 +1,124 @@
+package adapters_builder_test
+
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/adapterstest"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters_builder"
+)
+
+// conformanceSuites are the conformance suites of registered adapters. Key and Builder are taken from the registry.
+var conformanceSuites = map[adapter.Key]adapterstest.Suite{
+	adapter.BidmachineKey: {
+		Config: map[string]any{
+			"endpoint":  "https://api-eu.bidmachine.io/auction/prebid/bidon",
+			"seller_id": "1",
+		},
+	},
+	adapter.BigoAdsKey: {
+		Config: map[string]any{
+			"seller_id":    "seller-1",
+			"app_id":       "app-1",
+			"tag_id":       "tag-1",
+			"placement_id": "placement-1",
+		},
+		Unsupported: []string{"adaptive_tablet"},
+	},
+	adapter.InmobiKey: {
+		Config: map[string]any{
+			"app_id":       "app-1",
+			"placement_id": "placement-1",
+		},
+	},
+	adapter.MetaKey: {
+		Config: map[string]any{
+			"app_id":      "app-1",
+			"app_secret":  "secret",
+			"platform_id": "platform-1",
+			"tag_id":      "tag-1",
+		},
+	},
+	adapter.MintegralKey: {
+		Config: map[string]any{
+			"seller_id":    "seller-1",
+			"app_id":       "app-1",
+			"tag_id":       "tag-1",
+			"placement_id": "placement-1",
+		},
+	},
+	adapter.MobileFuseKey: {
+		Config: map[string]any{
+			"tag_id": "tag-1",
+		},
+	},
+	adapter.MolocoKey: {
+		Config: map[string]any{
+			"tag_id":  "tag-1",
+			"app_id":  "app-1",
+			"api_key": "api-key",
+		},
+	},
+	adapter.StartIOKey: {
+		Config: map[string]any{
+			"tag_id":  "tag-1",
+			"app_id":  "app-1",
+			"account": "account-1",
+		},
+	},
+	adapter.TaurusXKey: {
+		Config: map[string]any{
+			"app_id": "app-1",
+			"tag_id": "tag-1",
+		},
+		Demand: map[string]any{"token": `{"tag-1":"placement-token"}`},
+	},
+	adapter.VKAdsKey: {
+		Config: map[string]any{
+			"app_id": "app-1",
+			"tag_id": "tag-1",
+		},
+	},
+	adapter.VungleKey: {
+		Config: map[string]any{
+			"seller_id": "seller-1",
+			"app_id":    "app-1",
+			"tag_id":    "tag-1",
+		},
+	},
+	adapter.YandexKey: {
+		Config: map[string]any{
+			"ad_unit_id": "ad-unit-1",
+		},
+	},
+}
+
+// withoutConformance are registered adapters the suite doesn't apply to, with the reason.
+var withoutConformance = map[adapter.Key]string{
+	adapter.AmazonKey: "bids are decoded from auction request, no bid request is sent to DSP",
+}
+
+func TestConformance(t *testing.T) {
+	registry := adapters_builder.BuildBiddingAdapters(nil).AdaptersMap
+
+	for _, key := range slices.Sorted(maps.Keys(registry)) {
+		t.Run(string(key), func(t *testing.T) {
+			if reason, ok := withoutConformance[key]; ok {
+				t.Skip(reason)
+			}
+
+			suite, ok := conformanceSuites[key]
+			if !ok {
+				t.Fatalf("adapter %s has no conformance suite, add it to conformanceSuites", key)
+			}
+			suite.Key = key
+			suite.Builder = registry[key]
+			suite.Dir = filepath.Join("..", "adapters", string(key))
+
+			adapterstest.Run(t, suite)
+		})
+	}
+}
@@ -8,19 +8,21 @@ import (
 	"log"
 	"time"
 
+	"github.com/prebid/openrtb/v19/openrtb3"
 	"github.com/redis/go-redis/v9"
 
+	"github.com/bidon-io/bidon-backend/internal/ad"
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
-	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
 	"github.com/bidon-io/bidon-backend/pkg/clock"
 )
 
-const TTL = 5 * 60 * time.Second
-
 type BidCache struct {
-	Redis *redis.ClusterClient
-	Clock clock.Clock
+	Redis               *redis.ClusterClient
+	Clock               clock.Clock
+	NotificationHandler NotificationHandler
+	EventLogger         *event.Logger
 }
 
 func (c *Cache) MarshalBinary() ([]byte, error) {
@@ -39,7 +41,19 @@ type Cache struct {
 type CacheEntry struct {
 	Bid       CachedBid
 	CreatedAt time.Time
-	AuctionID string // AuctionID for which the bid was made, it will help to send notifications in future
+	AuctionID string // AuctionID of the auction the bid was made in, loss notifications of the bid are sent for it
+	AdFormat  ad.Format
+	Policy    adapters.CachePolicy
+}
+
+// expired returns true if the entry outlived TTL of its cache policy.
+func (e CacheEntry) expired(c clock.Clock) bool {
+	return c.Since(e.CreatedAt) > e.Policy.TTL()
+}
+
+// servableFor returns true if the entry can be served for the given ad format.
+func (e CacheEntry) servableFor(format ad.Format) bool {
+	return e.AdFormat == format || e.Policy.CrossFormat
 }
 
 type CachedBid struct {
@@ -48,7 +62,7 @@ type CachedBid struct {
 	Status      int
 	TagID       string
 	PlacementID string
-	SlotUUID    string
+	AdUnitUID   string
 	TimeoutURL  string
 	StartTS     int64
 	EndTS       int64
@@ -63,6 +77,7 @@ type CachedBid struct {
 	LURL        string
 	NURL        string
 	BURL        string
+	DealID      string
 }
 
 func cachedBidFromDemandResponse(dr adapters.DemandResponse) CachedBid {
@@ -72,7 +87,7 @@ func cachedBidFromDemandResponse(dr adapters.DemandResponse) CachedBid {
 		Status:      dr.Status,
 		TagID:       dr.TagID,
 		PlacementID: dr.PlacementID,
-		SlotUUID:    dr.SlotUUID,
+		AdUnitUID:   dr.Bid.AdUnitUID,
 		TimeoutURL:  dr.TimeoutURL,
 		StartTS:     dr.StartTS,
 		EndTS:       dr.EndTS,
@@ -86,6 +101,7 @@ func cachedBidFromDemandResponse(dr adapters.DemandResponse) CachedBid {
 		LURL:        dr.Bid.LURL,
 		NURL:        dr.Bid.NURL,
 		BURL:        dr.Bid.BURL,
+		DealID:      dr.Bid.DealID,
 		Token:       dr.Token,
 	}
 }
@@ -109,11 +125,13 @@ func (cb CachedBid) toDemandResponse() adapters.DemandResponse {
 			LURL:       cb.LURL,
 			NURL:       cb.NURL,
 			BURL:       cb.BURL,
+			DealID:     cb.DealID,
+			TagID:      cb.TagID,
+			AdUnitUID:  cb.AdUnitUID,
 		},
 		Error:       nil,
 		TagID:       cb.TagID,
 		PlacementID: cb.PlacementID,
-		SlotUUID:    cb.SlotUUID,
 		TimeoutURL:  cb.TimeoutURL,
 		StartTS:     cb.StartTS,
 		EndTS:       cb.EndTS,
@@ -123,8 +141,11 @@ func (cb CachedBid) toDemandResponse() adapters.DemandResponse {
 
 // ApplyBidCache gets the auction result, stores it in the cache and enhances response with the cache data if available
 // The cache key is generated based on the session ID and the ad type
-// TTL is set to 5 minutes
-func (b *BidCache) ApplyBidCache(ctx context.Context, auctionRequest *schema.AuctionRequest, result *AuctionResult) []adapters.DemandResponse {
+// Each bid stays in cache for TTL of its bidder cache policy. Bids made for another ad format are served only if the policy allows it.
+// Bids evicted from the cache, either expired or outbid by a bid of the same demand, get loss notifications.
+// Cache hits, misses and evictions are logged as ad events.
+func (b *BidCache) ApplyBidCache(ctx context.Context, params *BuildParams, result *AuctionResult) []adapters.DemandResponse {
+	auctionRequest := &params.AuctionRequest
 	if _, ok := auctionRequest.GetNestedExtData()["bid_cache"]; !ok { // If the request has bid_cache field, only then cache the bids
 		return result.Bids
 	}
@@ -138,12 +159,23 @@ func (b *BidCache) ApplyBidCache(ctx context.Context, auctionRequest *schema.Auc
 
 	// Remove expired cache entries
 	for key, entry := range inCache.Bids {
-		if b.Clock.Since(entry.CreatedAt) > TTL {
+		if entry.expired(b.Clock) {
 			delete(inCache.Bids, key)
-			// TODO: Send LURL notification here
+			b.evict(ctx, params, entry, openrtb3.LossExpired, 0)
 		}
 	}
 
+	// Bids that came from previous auctions, used to tell cache hits from bids cached in this auction
+	fromCache := make(map[adapter.Key]bool, len(inCache.Bids))
+	for key := range inCache.Bids {
+		fromCache[key] = true
+	}
+	// It's a miss if no bid of previous auctions can be served for the ad format, even if the cache is not empty
+	format := auctionRequest.AdObject.Format()
+	if _, _, ok := getMax(inCache.Bids, format); !ok {
+		b.logEvent(params, "bid_cache_miss", "", CacheEntry{})
+	}
+
 	// If the cache is empty and the result is empty, do nothing
 	if len(inCache.Bids) == 0 && len(result.Bids) == 0 {
 		return []adapters.DemandResponse{}
@@ -155,26 +187,41 @@ func (b *BidCache) ApplyBidCache(ctx context.Context, auctionRequest *schema.Auc
 	// Select the highest bid for each adapter
 	now := b.Clock.Now()
 	for _, bid := range toCache {
-		cacheEntry := CacheEntry{Bid: cachedBidFromDemandResponse(bid), CreatedAt: now, AuctionID: auctionRequest.Session.ID}
+		cacheEntry := CacheEntry{
+			Bid:       cachedBidFromDemandResponse(bid),
+			CreatedAt: now,
+			AuctionID: auctionRequest.AdObject.AuctionID,
+			AdFormat:  format,
+			Policy:    bid.CachePolicy,
+		}
 		if existing, ok := inCache.Bids[bid.DemandID]; ok {
 			if bid.Price() > existing.Bid.Price {
 				inCache.Bids[bid.DemandID] = cacheEntry
-				// TODO: Send LURL notification here for the existing bid
+				fromCache[bid.DemandID] = false
+				b.evict(ctx, params, existing, openrtb3.LossLostToHigherBid, bid.Price())
+			} else {
+				b.evict(ctx, params, cacheEntry, openrtb3.LossLostToHigherBid, existing.Bid.Price)
 			}
 		} else {
 			inCache.Bids[bid.DemandID] = cacheEntry
 		}
 	}
 
-	// Get the highest bid from cache and put it in the response
-	demand, bid := getMax(&inCache.Bids)
-	delete(inCache.Bids, demand)
-	toResponse = append(toResponse, bid.Bid.toDemandResponse())
+	// Get the highest bid servable for the ad format from cache and put it in the response
+	if demand, bid, ok := getMax(inCache.Bids, format); ok {
+		delete(inCache.Bids, demand)
+		dr := bid.Bid.toDemandResponse()
+		dr.CachePolicy = bid.Policy
+		toResponse = append(toResponse, dr)
+		if fromCache[demand] {
+			b.logEvent(params, "bid_cache_hit", "SUCCESS", bid)
+		}
+	}
 
 	// Write the rest cache back to Redis if not empty
 	if len(inCache.Bids) > 0 {
 		bytes, _ := inCache.MarshalBinary()
-		err = b.Redis.Set(ctx, cacheKey, string(bytes), TTL).Err()
+		err = b.Redis.Set(ctx, cacheKey, string(bytes), inCache.ttl()).Err()
 		if err != nil {
 			log.Printf("Error writing bid cache: %v\n", err)
 		}
@@ -183,6 +230,55 @@ func (b *BidCache) ApplyBidCache(ctx context.Context, auctionRequest *schema.Auc
 	return toResponse
 }
 
+// evict sends loss notification for the bid removed from cache and logs eviction event.
+// winPrice is the price of the bid that replaced evicted one, zero if the bid expired.
+func (b *BidCache) evict(ctx context.Context, params *BuildParams, entry CacheEntry, reason openrtb3.LossReason, winPrice float64) {
+	status := "EXPIRED"
+	firstPrice, secondPrice := params.AuctionRequest.AdObject.GetBidFloor(), entry.Bid.Price
+	if reason == openrtb3.LossLostToHigherBid {
+		status = "LOST_TO_HIGHER_BID"
+		firstPrice = winPrice
+	}
+
+	if b.NotificationHandler != nil {
+		b.NotificationHandler.HandleCachedBidLoss(
+			ctx, entry.AuctionID, entry.Bid.toDemandResponse(), reason, firstPrice, secondPrice,
+			params.AuctionRequest.App.Bundle, string(params.AuctionRequest.AdType),
+		)
+	}
+
+	b.logEvent(params, "bid_cache_eviction", status, entry)
+}
+
+func (b *BidCache) logEvent(params *BuildParams, eventType, status string, entry CacheEntry) {
+	if b.EventLogger == nil {
+		return
+	}
+
+	var appID int64
+	if params.App != nil {
+		appID = params.App.ID
+	}
+
+	adObject := params.AuctionRequest.AdObject
+	e := event.NewAdEvent(&params.AuctionRequest.BaseRequest, event.AdRequestParams{
+		EventType:              eventType,
+		AppID:                  appID,
+		AdType:                 string(params.AuctionRequest.AdType),
+		AdFormat:               string(adObject.Format()),
+		AuctionID:              adObject.AuctionID,
+		AuctionConfigurationID: adObject.AuctionConfigurationID,
+		Status:                 status,
+		DemandID:               string(entry.Bid.DemandID),
+		ECPM:                   entry.Bid.Price,
+		PriceFloor:             adObject.PriceFloor,
+		Bidding:                true,
+	}, params.GeoData)
+	b.EventLogger.Log(e, func(err error) {
+		log.Printf("Error logging bid cache event: %v\n", err)
+	})
+}
+
 // splitBids splits the given bids into two slices: one for bids that can be cached and one for bids that should be included in the response.
 func splitBids(bids []adapters.DemandResponse) (toResponse, toCache []adapters.DemandResponse) {
 	for _, bid := range bids {
@@ -195,19 +291,29 @@ func splitBids(bids []adapters.DemandResponse) (toResponse, toCache []adapters.D
 	return
 }
 
-// getMaxAndRemove get max bid from map and remove it, mutates the map
-func getMax(m *map[adapter.Key]CacheEntry) (adapter.Key, CacheEntry) {
-	var maxDemand adapter.Key
-	var maxValue CacheEntry
-	first := true
+// ttl returns the longest TTL of cached bids, so Redis keeps the cache while any of them is valid.
+func (c *Cache) ttl() time.Duration {
+	var ttl time.Duration
+	for _, entry := range c.Bids {
+		ttl = max(ttl, entry.Policy.TTL())
+	}
+
+	return ttl
+}
+
+// getMax returns the highest bid from map that can be served for the given ad format. ok is false if there is no such bid.
+func getMax(m map[adapter.Key]CacheEntry, format ad.Format) (maxDemand adapter.Key, maxValue CacheEntry, ok bool) {
+	for demand, entry := range m {
+		if !entry.servableFor(format) {
+			continue
+		}
 
-	for demand, entry := range *m {
-		if first || entry.Bid.Price > maxValue.Bid.Price {
+		if !ok || entry.Bid.Price > maxValue.Bid.Price {
 			maxDemand = demand
 			maxValue = entry
-			first = false
+			ok = true
 		}
 	}
 
-	return maxDemand, maxValue
+	return maxDemand, maxValue, ok
 }
@@ -3,16 +3,22 @@ package bidding_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/go-redis/redismock/v9"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/prebid/openrtb/v19/openrtb3"
 
+	"github.com/bidon-io/bidon-backend/internal/ad"
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/bidding"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/bidding/mocks"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event/engine"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
 	"github.com/bidon-io/bidon-backend/pkg/clock"
 )
@@ -21,7 +27,6 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 	redisClient, mock := redismock.NewClusterMock()
 	mockTime := clock.NewMock()
 	mockTime.Set(time.Now())
-	bidCache := &bidding.BidCache{Redis: redisClient, Clock: mockTime}
 
 	ctx := context.Background()
 	auctionRequest := &schema.AuctionRequest{
@@ -29,30 +34,39 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 			Session: schema.Session{ID: "session1"},
 			Ext:     "{\"ext\":{\"bid_cache\": true}}",
 		},
-		AdType: "banner",
+		AdObject: schema.AdObject{AuctionID: "auction2"},
+		AdType:   "banner",
 	}
 	auctionRequest.NormalizeValues()
+	params := &bidding.BuildParams{App: testApp(1), AuctionRequest: *auctionRequest}
+	cacheable := adapters.CachePolicy{Cacheable: true}
 
 	tests := []struct {
 		name     string
 		bids     []adapters.DemandResponse
 		cacheGet bidding.Cache
 		cacheSet bidding.Cache
+		// cacheTTL is TTL of the cache written back to Redis, adapters.DefaultCacheTTL if zero
+		cacheTTL time.Duration
 		want     []adapters.DemandResponse
+		// wantLosses are loss reasons of bids evicted from cache
+		wantLosses map[adapter.Key]openrtb3.LossReason
+		wantEvents []string
 	}{
 		{
-			name:     "no cache, no bids",
-			bids:     []adapters.DemandResponse{},
-			cacheGet: bidding.Cache{},
-			cacheSet: bidding.Cache{},
-			want:     []adapters.DemandResponse{},
+			name:       "no cache, no bids",
+			bids:       []adapters.DemandResponse{},
+			cacheGet:   bidding.Cache{},
+			cacheSet:   bidding.Cache{},
+			want:       []adapters.DemandResponse{},
+			wantEvents: []string{"bid_cache_miss"},
 		},
 		{
 			name: "no cache, has bids",
 			bids: []adapters.DemandResponse{
 				{DemandID: adapter.BidmachineKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.BidmachineKey, Price: 1.0}},
-				{DemandID: adapter.ApplovinKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.ApplovinKey, Price: 2.0}},
-				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 3.0}},
+				{DemandID: adapter.ApplovinKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.ApplovinKey, Price: 2.0}, CachePolicy: cacheable},
+				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 3.0}, CachePolicy: cacheable},
 			},
 			cacheGet: bidding.Cache{},
 			cacheSet: bidding.Cache{
@@ -60,14 +74,16 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 					adapter.ApplovinKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.ApplovinKey, Price: 2.0},
 						CreatedAt: mockTime.Now(),
-						AuctionID: "session1",
+						AuctionID: "auction2",
+						Policy:    cacheable,
 					},
 				},
 			},
 			want: []adapters.DemandResponse{
 				{DemandID: adapter.BidmachineKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.BidmachineKey, Price: 1.0}},
-				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 3.0}},
+				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 3.0}, CachePolicy: cacheable},
 			},
+			wantEvents: []string{"bid_cache_miss"},
 		},
 		{
 			name: "no bids, has cache",
@@ -77,12 +93,14 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 					adapter.ApplovinKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.ApplovinKey, Price: 2.0},
 						CreatedAt: mockTime.Now(),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 					adapter.VKAdsKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.VKAdsKey, Price: 1.0},
 						CreatedAt: mockTime.Now(),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 				},
 			},
@@ -91,13 +109,15 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 					adapter.VKAdsKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.VKAdsKey, Price: 1.0},
 						CreatedAt: mockTime.Now(),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 				},
 			},
 			want: []adapters.DemandResponse{
-				{DemandID: adapter.ApplovinKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.ApplovinKey, Price: 2.0}},
+				{DemandID: adapter.ApplovinKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.ApplovinKey, Price: 2.0}, CachePolicy: cacheable},
 			},
+			wantEvents: []string{"bid_cache_hit"},
 		},
 		{
 			name: "no bids, has expired cache",
@@ -107,19 +127,23 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 					adapter.ApplovinKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.ApplovinKey, Price: 2.0},
 						CreatedAt: mockTime.Now().Add(-6 * time.Minute), // Highest bid, but expired
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 					adapter.VKAdsKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.VKAdsKey, Price: 1.0},
 						CreatedAt: mockTime.Now(),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 				},
 			},
 			cacheSet: bidding.Cache{},
 			want: []adapters.DemandResponse{
-				{DemandID: adapter.VKAdsKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VKAdsKey, Price: 1.0}},
+				{DemandID: adapter.VKAdsKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VKAdsKey, Price: 1.0}, CachePolicy: cacheable},
 			},
+			wantLosses: map[adapter.Key]openrtb3.LossReason{adapter.ApplovinKey: openrtb3.LossExpired},
+			wantEvents: []string{"bid_cache_eviction", "bid_cache_hit"},
 		},
 		{
 			name: "no valid bids, has cache",
@@ -131,17 +155,20 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 					adapter.ApplovinKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.ApplovinKey, Price: 2.0},
 						CreatedAt: mockTime.Now().Add(-6 * time.Minute), // Highest bid, but expired
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 					adapter.VKAdsKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.VKAdsKey, Price: 1.0},
 						CreatedAt: mockTime.Now(),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 					adapter.MetaKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.MetaKey, Price: 1.5},
 						CreatedAt: mockTime.Now(),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 				},
 			},
@@ -150,33 +177,38 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 					adapter.VKAdsKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.VKAdsKey, Price: 1.0},
 						CreatedAt: mockTime.Now(),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 				},
 			},
 			want: []adapters.DemandResponse{
 				{DemandID: adapter.BigoAdsKey, Bid: nil, Error: errors.New("some error")},
-				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 1.5}},
+				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 1.5}, CachePolicy: cacheable},
 			},
+			wantLosses: map[adapter.Key]openrtb3.LossReason{adapter.ApplovinKey: openrtb3.LossExpired},
+			wantEvents: []string{"bid_cache_eviction", "bid_cache_hit"},
 		},
 		{
 			name: "has bids, has cache",
 			bids: []adapters.DemandResponse{
 				{DemandID: adapter.BidmachineKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.BidmachineKey, Price: 1.0}},
-				{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 2.0}},
-				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 2.5}},
+				{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 2.0}, CachePolicy: cacheable},
+				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 2.5}, CachePolicy: cacheable},
 			},
 			cacheGet: bidding.Cache{
 				Bids: map[adapter.Key]bidding.CacheEntry{
 					adapter.VungleKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.VungleKey, Price: 3.0},
 						CreatedAt: mockTime.Now().Add(-1 * time.Minute),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 					adapter.VKAdsKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.VKAdsKey, Price: 1.0},
 						CreatedAt: mockTime.Now().Add(-3 * time.Minute),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 				},
 			},
@@ -185,31 +217,36 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 					adapter.VKAdsKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.VKAdsKey, Price: 1.0},
 						CreatedAt: mockTime.Now().Add(-3 * time.Minute),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 					adapter.MetaKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.MetaKey, Price: 2.5},
 						CreatedAt: mockTime.Now(),
-						AuctionID: "session1",
+						AuctionID: "auction2",
+						Policy:    cacheable,
 					},
 				},
 			},
 			want: []adapters.DemandResponse{
 				{DemandID: adapter.BidmachineKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.BidmachineKey, Price: 1.0}},
-				{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 3.0}},
+				{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 3.0}, CachePolicy: cacheable},
 			},
+			wantLosses: map[adapter.Key]openrtb3.LossReason{adapter.VungleKey: openrtb3.LossLostToHigherBid},
+			wantEvents: []string{"bid_cache_eviction", "bid_cache_hit"},
 		},
 		{
 			name: "has bids, has cheap cache",
 			bids: []adapters.DemandResponse{
-				{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 3.0}},
+				{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 3.0}, CachePolicy: cacheable},
 			},
 			cacheGet: bidding.Cache{
 				Bids: map[adapter.Key]bidding.CacheEntry{
 					adapter.VungleKey: {
 						Bid:       bidding.CachedBid{DemandID: adapter.VungleKey, Price: 2.0},
 						CreatedAt: mockTime.Now().Add(-1 * time.Minute),
-						AuctionID: "session1",
+						AuctionID: "auction1",
+						Policy:    cacheable,
 					},
 				},
 			},
@@ -217,8 +254,140 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 				Bids: map[adapter.Key]bidding.CacheEntry{},
 			},
 			want: []adapters.DemandResponse{
-				{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 3.0}},
+				{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 3.0}, CachePolicy: cacheable},
+			},
+			wantLosses: map[adapter.Key]openrtb3.LossReason{adapter.VungleKey: openrtb3.LossLostToHigherBid},
+			wantEvents: []string{"bid_cache_eviction"},
+		},
+		{
+			name: "no bids, has cache expired by adapter ttl",
+			bids: []adapters.DemandResponse{},
+			cacheGet: bidding.Cache{
+				Bids: map[adapter.Key]bidding.CacheEntry{
+					adapter.VungleKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.VungleKey, Price: 2.0},
+						CreatedAt: mockTime.Now().Add(-2 * time.Minute),
+						AuctionID: "auction1",
+						Policy:    adapters.CachePolicy{Cacheable: true, MaxTTL: time.Minute},
+					},
+					adapter.MetaKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.MetaKey, Price: 1.0},
+						CreatedAt: mockTime.Now().Add(-2 * time.Minute),
+						AuctionID: "auction1",
+						Policy:    cacheable,
+					},
+				},
+			},
+			cacheSet: bidding.Cache{},
+			want: []adapters.DemandResponse{
+				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 1.0}, CachePolicy: cacheable},
+			},
+			wantLosses: map[adapter.Key]openrtb3.LossReason{adapter.VungleKey: openrtb3.LossExpired},
+			wantEvents: []string{"bid_cache_eviction", "bid_cache_hit"},
+		},
+		{
+			name: "has bids with long ttl, cache is kept for the longest ttl",
+			bids: []adapters.DemandResponse{
+				{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 2.0}, CachePolicy: adapters.CachePolicy{Cacheable: true, MaxTTL: 10 * time.Minute}},
+				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 3.0}, CachePolicy: cacheable},
+			},
+			cacheGet: bidding.Cache{},
+			cacheSet: bidding.Cache{
+				Bids: map[adapter.Key]bidding.CacheEntry{
+					adapter.VungleKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.VungleKey, Price: 2.0},
+						CreatedAt: mockTime.Now(),
+						AuctionID: "auction2",
+						Policy:    adapters.CachePolicy{Cacheable: true, MaxTTL: 10 * time.Minute},
+					},
+				},
+			},
+			cacheTTL: 10 * time.Minute,
+			want: []adapters.DemandResponse{
+				{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 3.0}, CachePolicy: cacheable},
+			},
+			wantEvents: []string{"bid_cache_miss"},
+		},
+		{
+			name: "no bids, has cache of another format",
+			bids: []adapters.DemandResponse{},
+			cacheGet: bidding.Cache{
+				Bids: map[adapter.Key]bidding.CacheEntry{
+					adapter.MetaKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.MetaKey, Price: 3.0},
+						CreatedAt: mockTime.Now(),
+						AuctionID: "auction1",
+						AdFormat:  ad.MRECFormat,
+						Policy:    cacheable,
+					},
+					adapter.VungleKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.VungleKey, Price: 2.0},
+						CreatedAt: mockTime.Now(),
+						AuctionID: "auction1",
+						AdFormat:  ad.MRECFormat,
+						Policy:    adapters.CachePolicy{Cacheable: true, CrossFormat: true},
+					},
+					adapter.VKAdsKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.VKAdsKey, Price: 1.0},
+						CreatedAt: mockTime.Now(),
+						AuctionID: "auction1",
+						Policy:    cacheable,
+					},
+				},
+			},
+			cacheSet: bidding.Cache{
+				Bids: map[adapter.Key]bidding.CacheEntry{
+					adapter.MetaKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.MetaKey, Price: 3.0},
+						CreatedAt: mockTime.Now(),
+						AuctionID: "auction1",
+						AdFormat:  ad.MRECFormat,
+						Policy:    cacheable,
+					},
+					adapter.VKAdsKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.VKAdsKey, Price: 1.0},
+						CreatedAt: mockTime.Now(),
+						AuctionID: "auction1",
+						Policy:    cacheable,
+					},
+				},
+			},
+			want: []adapters.DemandResponse{
+				{
+					DemandID:    adapter.VungleKey,
+					Bid:         &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 2.0},
+					CachePolicy: adapters.CachePolicy{Cacheable: true, CrossFormat: true},
+				},
 			},
+			wantEvents: []string{"bid_cache_hit"},
+		},
+		{
+			name: "no bids, has cache of another format only",
+			bids: []adapters.DemandResponse{},
+			cacheGet: bidding.Cache{
+				Bids: map[adapter.Key]bidding.CacheEntry{
+					adapter.MetaKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.MetaKey, Price: 3.0},
+						CreatedAt: mockTime.Now(),
+						AuctionID: "auction1",
+						AdFormat:  ad.MRECFormat,
+						Policy:    cacheable,
+					},
+				},
+			},
+			cacheSet: bidding.Cache{
+				Bids: map[adapter.Key]bidding.CacheEntry{
+					adapter.MetaKey: {
+						Bid:       bidding.CachedBid{DemandID: adapter.MetaKey, Price: 3.0},
+						CreatedAt: mockTime.Now(),
+						AuctionID: "auction1",
+						AdFormat:  ad.MRECFormat,
+						Policy:    cacheable,
+					},
+				},
+			},
+			want:       nil,
+			wantEvents: []string{"bid_cache_miss"},
 		},
 	}
 
@@ -235,18 +404,120 @@ func TestBidCache_ApplyBidCache(t *testing.T) {
 			}
 			if len(tt.cacheSet.Bids) > 0 {
 				bytes, _ := tt.cacheSet.MarshalBinary()
-				mock.ExpectSet("bidding:session1:banner", string(bytes), bidding.TTL).SetVal("OK")
+				cacheTTL := tt.cacheTTL
+				if cacheTTL == 0 {
+					cacheTTL = adapters.DefaultCacheTTL
+				}
+				mock.ExpectSet("bidding:session1:banner", string(bytes), cacheTTL).SetVal("OK")
+			}
+
+			losses := make(map[adapter.Key]openrtb3.LossReason)
+			events := &eventRecorder{}
+			bidCache := &bidding.BidCache{
+				Redis: redisClient,
+				Clock: mockTime,
+				NotificationHandler: &mocks.NotificationHandlerMock{
+					HandleCachedBidLossFunc: func(_ context.Context, _ string, bid adapters.DemandResponse, reason openrtb3.LossReason, _, _ float64, _, _ string) {
+						losses[bid.DemandID] = reason
+					},
+				},
+				EventLogger: &event.Logger{Engine: &engine.Log{}, Observers: []event.Observer{events}},
 			}
 
-			got := bidCache.ApplyBidCache(ctx, auctionRequest, aucResult)
+			got := bidCache.ApplyBidCache(ctx, params, aucResult)
 
 			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreFields(adapters.DemandResponse{}, "Error")); diff != "" {
 				t.Errorf("Create() mismatch (-want +got):\n%s", diff)
 			}
 
+			if diff := cmp.Diff(tt.wantLosses, losses, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("loss notifications mismatch (-want +got):\n%s", diff)
+			}
+
+			slices.Sort(events.types)
+			if diff := cmp.Diff(tt.wantEvents, events.types); diff != "" {
+				t.Errorf("events mismatch (-want +got):\n%s", diff)
+			}
+
 			if err := mock.ExpectationsWereMet(); err != nil {
 				t.Errorf("unmet redis expectations: %+v", err)
 			}
 		})
 	}
 }
+
+func TestBidCache_ApplyBidCache_LossAuctionID(t *testing.T) {
+	redisClient, mock := redismock.NewClusterMock()
+	mockTime := clock.NewMock()
+	mockTime.Set(time.Now())
+
+	auctionRequest := &schema.AuctionRequest{
+		BaseRequest: schema.BaseRequest{
+			Session: schema.Session{ID: "session1"},
+			Ext:     "{\"ext\":{\"bid_cache\": true}}",
+		},
+		AdObject: schema.AdObject{AuctionID: "auction2"},
+		AdType:   "banner",
+	}
+	auctionRequest.NormalizeValues()
+	params := &bidding.BuildParams{App: testApp(1), AuctionRequest: *auctionRequest}
+	cacheable := adapters.CachePolicy{Cacheable: true}
+
+	cacheGet := bidding.Cache{
+		Bids: map[adapter.Key]bidding.CacheEntry{
+			adapter.VungleKey: {
+				Bid:       bidding.CachedBid{DemandID: adapter.VungleKey, Price: 1.0},
+				CreatedAt: mockTime.Now(),
+				AuctionID: "auction1",
+				Policy:    cacheable,
+			},
+			adapter.MetaKey: {
+				Bid:       bidding.CachedBid{DemandID: adapter.MetaKey, Price: 3.0},
+				CreatedAt: mockTime.Now(),
+				AuctionID: "auction1",
+				Policy:    cacheable,
+			},
+		},
+	}
+	bytes, _ := cacheGet.MarshalBinary()
+	mock.ExpectGetDel("bidding:session1:banner").SetVal(string(bytes))
+	mock.Regexp().ExpectSet("bidding:session1:banner", ".*", adapters.DefaultCacheTTL).SetVal("OK")
+
+	lossAuctionIDs := make(map[adapter.Key]string)
+	bidCache := &bidding.BidCache{
+		Redis: redisClient,
+		Clock: mockTime,
+		NotificationHandler: &mocks.NotificationHandlerMock{
+			HandleCachedBidLossFunc: func(_ context.Context, auctionID string, bid adapters.DemandResponse, _ openrtb3.LossReason, _, _ float64, _, _ string) {
+				lossAuctionIDs[bid.DemandID] = auctionID
+			},
+		},
+	}
+
+	bidCache.ApplyBidCache(context.Background(), params, &bidding.AuctionResult{
+		Bids: []adapters.DemandResponse{
+			{DemandID: adapter.VungleKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.VungleKey, Price: 2.0}, CachePolicy: cacheable},
+			{DemandID: adapter.MetaKey, Bid: &adapters.BidDemandResponse{DemandID: adapter.MetaKey, Price: 2.0}, CachePolicy: cacheable},
+		},
+	})
+
+	// Outbid cached bid is lost in the auction it was made in, cheaper bid of this auction is lost in this auction.
+	want := map[adapter.Key]string{adapter.VungleKey: "auction1", adapter.MetaKey: "auction2"}
+	if diff := cmp.Diff(want, lossAuctionIDs); diff != "" {
+		t.Errorf("loss notification auction IDs mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet redis expectations: %+v", err)
+	}
+}
+
+type eventRecorder struct {
+	types []string
+}
+
+func (r *eventRecorder) Observe(e event.Event) {
+	if adEvent, ok := e.(*event.AdEvent); ok {
+		r.types = append(r.types, adEvent.EventType)
+	}
+}
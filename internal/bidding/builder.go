@@ -12,11 +12,15 @@ import (
 	"github.com/gofrs/uuid/v5"
 	"github.com/prebid/openrtb/v19/adcom1"
 	"github.com/prebid/openrtb/v19/openrtb2"
+	"github.com/prebid/openrtb/v19/openrtb3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/maps"
 
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
-	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/amazon"
 	"github.com/bidon-io/bidon-backend/internal/bidding/openrtb"
 	"github.com/bidon-io/bidon-backend/internal/device"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi"
@@ -28,10 +32,20 @@ type Builder struct {
 	AdaptersBuilder     AdaptersBuilder
 	NotificationHandler NotificationHandler
 	BidCacher           BidCacher
+	CircuitBreaker      *CircuitBreaker
 }
 
 var ErrNoAdaptersMatched = errors.New("no adapters matched")
 
+const (
+	// DefaultTMax is the bidding deadline used when neither auction configuration nor SDK request sets one.
+	DefaultTMax = 2000 * time.Millisecond
+	// lateBidderGrace is how long bidding round waits after the deadline for adapters to report their own timeouts.
+	lateBidderGrace = 20 * time.Millisecond
+)
+
+var tracer = otel.Tracer("github.com/bidon-io/bidon-backend/internal/bidding")
+
 //go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out mocks/mocks.go -pkg mocks . AdaptersBuilder NotificationHandler BidCacher
 
 type AdaptersBuilder interface {
@@ -40,10 +54,11 @@ type AdaptersBuilder interface {
 
 type NotificationHandler interface {
 	HandleBiddingRound(context.Context, *schema.AdObject, AuctionResult, string, string) error
+	HandleCachedBidLoss(ctx context.Context, auctionID string, bid adapters.DemandResponse, reason openrtb3.LossReason, firstPrice, secondPrice float64, bundle, adType string)
 }
 
 type BidCacher interface {
-	ApplyBidCache(ctx context.Context, ar *schema.AuctionRequest, result *AuctionResult) []adapters.DemandResponse
+	ApplyBidCache(ctx context.Context, params *BuildParams, result *AuctionResult) []adapters.DemandResponse
 }
 
 type BuildParams struct {
@@ -53,6 +68,28 @@ type BuildParams struct {
 	AdapterConfigs  adapter.ProcessedConfigsMap
 	BiddingAdapters []adapter.Key
 	StartTS         int64
+	// TMax is the deadline of bidding round. DefaultTMax is used if zero.
+	TMax time.Duration
+	// AdapterTimeouts are optional timeouts of individual adapters, capped by TMax.
+	AdapterTimeouts map[adapter.Key]time.Duration
+	// DryRun runs the auction on recorded DSP responses without side effects if set.
+	DryRun *DryRun
+	// Deals are private marketplace deals offered to adapters in imp.pmp, keyed by adapter.
+	Deals map[adapter.Key][]openrtb2.Deal
+}
+
+// biddingBudget returns the time adapters have to respond: TMax of build params, additionally limited by tmax of SDK request.
+func (p *BuildParams) biddingBudget() time.Duration {
+	budget := p.TMax
+	if budget <= 0 {
+		budget = DefaultTMax
+	}
+
+	if requestTMax := time.Duration(p.AuctionRequest.TMax) * time.Millisecond; requestTMax > 0 && requestTMax < budget {
+		budget = requestTMax
+	}
+
+	return budget
 }
 
 type AuctionResult struct {
@@ -69,11 +106,6 @@ func (a AuctionResult) GetMaxBidPrice() float64 {
 	return maxPrice
 }
 
-type AmazonSlot struct {
-	SlotUUID   string `json:"slot_uuid"`
-	PricePoint string `json:"price_point"`
-}
-
 func (b *Builder) HoldAuction(ctx context.Context, params *BuildParams) (AuctionResult, error) {
 	// get config
 	// build openrtb request
@@ -82,8 +114,12 @@ func (b *Builder) HoldAuction(ctx context.Context, params *BuildParams) (Auction
 	// build requests and send them to adapters in parallel
 	// collect results
 	// build response
+	ctx, span := tracer.Start(ctx, "bidding.HoldAuction")
+	defer span.End()
+
 	emptyResponse := AuctionResult{}
 	auctionRequest := params.AuctionRequest
+	deadline := time.Now().Add(params.biddingBudget())
 
 	bidID, err := uuid.NewV4()
 	if err != nil {
@@ -93,7 +129,6 @@ func (b *Builder) HoldAuction(ctx context.Context, params *BuildParams) (Auction
 		ID:     bidID.String(),
 		Test:   *bool2int(auctionRequest.Test),
 		AT:     1,
-		TMax:   2000,
 		App:    b.buildApp(auctionRequest.App, params),
 		Device: b.BuildDevice(auctionRequest.Device, auctionRequest.User, params.GeoData),
 
@@ -137,19 +172,32 @@ func (b *Builder) HoldAuction(ctx context.Context, params *BuildParams) (Auction
 	}
 
 	bids := make(chan adapters.DemandResponse)
-	handleError := func(adapterKey adapter.Key, err error) {
-		bids <- adapters.DemandResponse{
+	handleError := func(ctx context.Context, adapterKey adapter.Key, err error) {
+		demandResponse := adapters.DemandResponse{
 			DemandID: adapterKey,
 			Error:    err,
 			StartTS:  params.StartTS,
 			EndTS:    time.Now().UnixMilli(),
 		}
+		recordBid(ctx, &demandResponse)
+		bids <- demandResponse
 	}
 	wg := sync.WaitGroup{}
+	sent := &sentRequests{}
 
 	for _, adapterKey := range adapterKeys {
+		adapterCtx, cancel := context.WithDeadline(ctx, params.adapterDeadline(adapterKey, deadline))
+
 		wg.Add(1)
-		go b.processAdapter(ctx, adapterKey, auctionRequest, baseBidRequest, params, bids, &wg, handleError)
+		go func() {
+			defer cancel()
+			adapterCtx, adapterSpan := tracer.Start(adapterCtx, "bidding.RequestBid", trace.WithAttributes(
+				attribute.String("adapter.key", string(adapterKey)),
+			))
+			defer adapterSpan.End()
+
+			b.processAdapter(adapterCtx, adapterKey, auctionRequest, baseBidRequest, params, bids, sent, &wg, handleError)
+		}()
 	}
 
 	go func() {
@@ -157,12 +205,16 @@ func (b *Builder) HoldAuction(ctx context.Context, params *BuildParams) (Auction
 		close(bids)
 	}()
 
-	for bid := range bids {
-		auctionResult.Bids = append(auctionResult.Bids, bid)
+	auctionResult.Bids = collectBids(bids, adapterKeys, sent, deadline.Add(lateBidderGrace), params.StartTS)
+
+	if params.DryRun != nil {
+		return auctionResult, nil
 	}
 
 	// Cache Bids
-	auctionResult.Bids = b.BidCacher.ApplyBidCache(ctx, &auctionRequest, &auctionResult)
+	cacheCtx, cacheSpan := tracer.Start(ctx, "bidding.ApplyBidCache")
+	auctionResult.Bids = b.BidCacher.ApplyBidCache(cacheCtx, params, &auctionResult)
+	cacheSpan.End()
 
 	b.NotificationHandler.HandleBiddingRound(ctx, &auctionRequest.AdObject, auctionResult, auctionRequest.App.Bundle, string(auctionRequest.AdType)) //nolint:errcheck
 
@@ -176,63 +228,218 @@ func (b *Builder) processAdapter(
 	baseBidRequest openrtb.BidRequest,
 	params *BuildParams,
 	bids chan adapters.DemandResponse,
+	sent *sentRequests,
 	wg *sync.WaitGroup,
-	handleError func(adapter.Key, error),
+	handleError func(context.Context, adapter.Key, error),
 ) {
 	defer wg.Done()
 
-	if adapterKey == adapter.AmazonKey {
-		bidder, err := amazon.Builder(params.AdapterConfigs)
-		if err != nil {
-			handleError(adapterKey, err)
-			return
-		}
-		demandResponses, err := bidder.FetchBids(&auctionRequest)
+	// adapter build bid request from baseBidRequest
+	// adapter send bid request
+	// adapter parse bid response
+	bidder, err := b.AdaptersBuilder.Build(adapterKey, params.AdapterConfigs)
+	if err != nil {
+		handleError(ctx, adapterKey, err)
+		return
+	}
+
+	// Client-side token bidders have bids in the auction request, they don't send bid requests
+	if tokenBidder, ok := bidder.Adapter.(adapters.TokenBidder); ok {
+		demandResponse, err := tokenBidder.DecodeBids(&auctionRequest)
 		if err != nil {
-			handleError(adapterKey, err)
+			handleError(ctx, adapterKey, err)
 			return
 		}
-		for _, demandResponse := range demandResponses {
-			demandResponse.StartTS = params.StartTS
-			demandResponse.EndTS = time.Now().UnixMilli()
-			b.setTokenResponse(demandResponse, &auctionRequest)
-
-			bids <- *demandResponse
-		}
+		demandResponse.StartTS = params.StartTS
+		demandResponse.EndTS = time.Now().UnixMilli()
+		demandResponse.CachePolicy = bidder.CachePolicy()
+		b.setTokenResponse(demandResponse, &auctionRequest)
 
+		sendBids(ctx, demandResponse, bids)
 		return
 	}
 
-	// adapter build bid request from baseBidRequest
-	// adapter send bid request
-	// adapter parse bid response
-	bidder, err := b.AdaptersBuilder.Build(adapterKey, params.AdapterConfigs)
-	if err != nil {
-		handleError(adapterKey, err)
-		return
+	// Send DSP the budget left for this adapter.
+	if deadline, ok := ctx.Deadline(); ok {
+		baseBidRequest.TMax = max(time.Until(deadline).Milliseconds(), 0)
 	}
 
 	bidRequest, err := bidder.Adapter.CreateRequest(baseBidRequest, &auctionRequest)
 	if err != nil {
-		handleError(adapterKey, err)
+		handleError(ctx, adapterKey, err)
 		return
 	}
+	setDeals(&bidRequest, params.Deals[adapterKey])
+
+	accountID, _ := params.AdapterConfigs[adapterKey][adapter.AccountIDConfigKey].(int64)
+	circuitKey := b.CircuitBreaker.Key(adapterKey, accountID)
+	if params.DryRun != nil {
+		bidder.Client = params.DryRun.client(adapterKey)
+	} else if !b.CircuitBreaker.Allow(circuitKey) {
+		handleError(ctx, adapterKey, ErrCircuitOpen)
+		return
+	}
+	sent.add(adapterKey, bidder.Adapter, bidRequest)
 
 	demandResponse := bidder.Adapter.ExecuteRequest(ctx, bidder.Client, bidRequest)
+	if params.DryRun == nil {
+		if isCanceled(demandResponse) {
+			b.CircuitBreaker.Release(circuitKey)
+		} else {
+			b.CircuitBreaker.Record(circuitKey, isAdapterFailure(demandResponse))
+		}
+	}
 	demandResponse.StartTS = params.StartTS
 	demandResponse.EndTS = time.Now().UnixMilli()
 	b.setTokenResponse(demandResponse, &auctionRequest)
 	if demandResponse.Error != nil {
+		recordBid(ctx, demandResponse)
 		bids <- *demandResponse
 		return
 	}
 
 	demandResponse, err = bidder.Adapter.ParseBids(demandResponse)
 	demandResponse.Error = err
+	demandResponse.CachePolicy = bidder.CachePolicy()
 
+	sendBids(ctx, demandResponse, bids)
+}
+
+// setDeals offers private marketplace deals in every impression of the bid request, bids of other buyers are allowed.
+func setDeals(request *openrtb.BidRequest, deals []openrtb2.Deal) {
+	if len(deals) == 0 {
+		return
+	}
+
+	for i := range request.Imp {
+		request.Imp[i].PMP = &openrtb2.PMP{Deals: deals}
+	}
+}
+
+// sendBids records the demand response on the adapter span and sends it to the auction with all its bids at once,
+// so that cutoff never takes a part of them.
+func sendBids(ctx context.Context, demandResponse *adapters.DemandResponse, bids chan<- adapters.DemandResponse) {
+	recordBid(ctx, demandResponse)
 	bids <- *demandResponse
 }
 
+// recordBid sets status and price of the demand response on the adapter span of ctx, price is of the highest bid.
+func recordBid(ctx context.Context, demandResponse *adapters.DemandResponse) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.Int("bid.status", demandResponse.Status),
+		attribute.Float64("bid.price", demandResponse.Price()),
+	)
+	if len(demandResponse.Bids) > 1 {
+		span.SetAttributes(attribute.Int("bids.count", len(demandResponse.Bids)))
+	}
+	if demandResponse.Error != nil {
+		span.RecordError(demandResponse.Error)
+		span.SetStatus(codes.Error, demandResponse.Error.Error())
+	}
+}
+
+// adapterDeadline returns deadline of the adapter request: its own timeout if set, but no later than bidding deadline.
+func (p *BuildParams) adapterDeadline(adapterKey adapter.Key, deadline time.Time) time.Time {
+	timeout, ok := p.AdapterTimeouts[adapterKey]
+	if !ok || timeout <= 0 {
+		return deadline
+	}
+
+	if adapterDeadline := time.Now().Add(timeout); adapterDeadline.Before(deadline) {
+		return adapterDeadline
+	}
+
+	return deadline
+}
+
+// sentRequests keeps bid requests sent to adapters, so that adapters timed out by cutoff are reported with request ID
+// and timeout URL of their bid request.
+type sentRequests struct {
+	mu        sync.Mutex
+	responses map[adapter.Key]adapters.DemandResponse
+}
+
+func (r *sentRequests) add(adapterKey adapter.Key, bidder adapters.BidderInterface, request openrtb.BidRequest) {
+	response := adapters.DemandResponse{
+		DemandID:  adapterKey,
+		RequestID: request.ID,
+	}
+	if notifier, ok := bidder.(adapters.TimeoutNotifier); ok {
+		response.TimeoutURL = notifier.TimeoutURL(request)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.responses == nil {
+		r.responses = make(map[adapter.Key]adapters.DemandResponse)
+	}
+	r.responses[adapterKey] = response
+}
+
+// timedOut returns demand response of adapter that hasn't responded by cutoff.
+func (r *sentRequests) timedOut(adapterKey adapter.Key, startTS, endTS int64) adapters.DemandResponse {
+	r.mu.Lock()
+	response, ok := r.responses[adapterKey]
+	r.mu.Unlock()
+	if !ok {
+		response.DemandID = adapterKey
+	}
+
+	response.Error = context.DeadlineExceeded
+	response.StartTS = startTS
+	response.EndTS = endTS
+
+	return response
+}
+
+// collectBids reads demand responses until all adapters are done or cutoff is reached.
+// Every bid of a response is ranked in the auction separately, so responses are split into a response per bid.
+// Adapters that haven't responded by cutoff are recorded as timed out, their responses are discarded.
+func collectBids(
+	bids <-chan adapters.DemandResponse,
+	adapterKeys []adapter.Key,
+	sent *sentRequests,
+	cutoff time.Time,
+	startTS int64,
+) []adapters.DemandResponse {
+	result := make([]adapters.DemandResponse, 0, len(adapterKeys))
+	responded := make(map[adapter.Key]bool, len(adapterKeys))
+
+	timer := time.NewTimer(time.Until(cutoff))
+	defer timer.Stop()
+
+	for {
+		select {
+		case bid, ok := <-bids:
+			if !ok {
+				return result
+			}
+
+			responded[bid.DemandID] = true
+			for _, response := range bid.Split() {
+				result = append(result, *response)
+			}
+		case <-timer.C:
+			// Drain late responses so that adapter goroutines can finish.
+			go func() {
+				for range bids {
+				}
+			}()
+
+			endTS := time.Now().UnixMilli()
+			for _, adapterKey := range adapterKeys {
+				if !responded[adapterKey] {
+					result = append(result, sent.timedOut(adapterKey, startTS, endTS))
+				}
+			}
+
+			return result
+		}
+	}
+}
+
 func (b *Builder) buildApp(schemaApp schema.App, params *BuildParams) *openrtb2.App {
 	app := &openrtb2.App{
 		Ver:    schemaApp.Version,
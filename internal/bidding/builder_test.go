@@ -2,19 +2,33 @@ package bidding_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/prebid/openrtb/v19/openrtb2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/goleak"
 
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/auction"
 	"github.com/bidon-io/bidon-backend/internal/bidding"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/amazon"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters/bidmachine"
 	"github.com/bidon-io/bidon-backend/internal/bidding/mocks"
+	"github.com/bidon-io/bidon-backend/internal/bidding/openrtb"
+	"github.com/bidon-io/bidon-backend/internal/notification"
+	notificationmocks "github.com/bidon-io/bidon-backend/internal/notification/mocks"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
 )
 
 func testApp(id int64) *sdkapi.App {
@@ -60,7 +74,7 @@ func TestBuilder_Build(t *testing.T) {
 	}
 
 	bidCacher := &mocks.BidCacherMock{ // Pass through bids
-		ApplyBidCacheFunc: func(_ context.Context, _ *schema.AuctionRequest, aucRes *bidding.AuctionResult) []adapters.DemandResponse {
+		ApplyBidCacheFunc: func(_ context.Context, _ *bidding.BuildParams, aucRes *bidding.AuctionResult) []adapters.DemandResponse {
 			return aucRes.Bids
 		},
 	}
@@ -199,3 +213,625 @@ func TestBuilder_Build(t *testing.T) {
 		})
 	}
 }
+
+type stubBidder struct {
+	key           adapter.Key
+	delay         time.Duration
+	ignoreContext <-chan struct{}
+	tmax          chan int64
+}
+
+func (b *stubBidder) CreateRequest(request openrtb.BidRequest, _ *schema.AuctionRequest) (openrtb.BidRequest, error) {
+	return request, nil
+}
+
+func (b *stubBidder) ExecuteRequest(ctx context.Context, _ *http.Client, request openrtb.BidRequest) *adapters.DemandResponse {
+	b.tmax <- request.TMax
+
+	if b.ignoreContext != nil {
+		<-b.ignoreContext
+		return &adapters.DemandResponse{DemandID: b.key, Status: http.StatusNoContent}
+	}
+
+	select {
+	case <-time.After(b.delay):
+		return &adapters.DemandResponse{DemandID: b.key, Status: http.StatusNoContent}
+	case <-ctx.Done():
+		return &adapters.DemandResponse{DemandID: b.key, Error: ctx.Err()}
+	}
+}
+
+func (b *stubBidder) ParseBids(dr *adapters.DemandResponse) (*adapters.DemandResponse, error) {
+	return dr, nil
+}
+
+func (b *stubBidder) CachePolicy() adapters.CachePolicy {
+	return adapters.CachePolicy{}
+}
+
+func TestBuilder_HoldAuction_Timeouts(t *testing.T) {
+	release := make(chan struct{})
+	bidders := map[adapter.Key]*stubBidder{
+		adapter.BidmachineKey: {key: adapter.BidmachineKey},
+		adapter.MintegralKey:  {key: adapter.MintegralKey, delay: time.Second},
+		adapter.VungleKey:     {key: adapter.VungleKey, ignoreContext: release},
+	}
+
+	params := &bidding.BuildParams{
+		App:            testApp(1),
+		AdapterConfigs: adapter.ProcessedConfigsMap{},
+		AuctionRequest: schema.AuctionRequest{
+			AdObject: schema.AdObject{Demands: map[adapter.Key]map[string]any{}},
+			Adapters: schema.Adapters{},
+		},
+		TMax: 100 * time.Millisecond,
+		AdapterTimeouts: map[adapter.Key]time.Duration{
+			adapter.MintegralKey: 20 * time.Millisecond,
+		},
+	}
+	for key, bidder := range bidders {
+		bidder.tmax = make(chan int64, 1)
+		params.BiddingAdapters = append(params.BiddingAdapters, key)
+		params.AdapterConfigs[key] = map[string]any{}
+		params.AuctionRequest.AdObject.Demands[key] = map[string]any{"token": "token"}
+		params.AuctionRequest.Adapters[key] = schema.Adapter{Version: "1.0.0", SDKVersion: "1.0.0"}
+	}
+
+	builder := &bidding.Builder{
+		AdaptersBuilder: &mocks.AdaptersBuilderMock{
+			BuildFunc: func(key adapter.Key, _ adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+				return &adapters.Bidder{Adapter: bidders[key]}, nil
+			},
+		},
+		NotificationHandler: &mocks.NotificationHandlerMock{
+			HandleBiddingRoundFunc: func(_ context.Context, _ *schema.AdObject, _ bidding.AuctionResult, _ string, _ string) error {
+				return nil
+			},
+		},
+		BidCacher: &mocks.BidCacherMock{
+			ApplyBidCacheFunc: func(_ context.Context, _ *bidding.BuildParams, aucRes *bidding.AuctionResult) []adapters.DemandResponse {
+				return aucRes.Bids
+			},
+		},
+	}
+
+	start := time.Now()
+	result, err := builder.HoldAuction(context.Background(), params)
+	elapsed := time.Since(start)
+	close(release)
+	if err != nil {
+		t.Fatalf("HoldAuction() error = %v", err)
+	}
+
+	if elapsed > 500*time.Millisecond {
+		t.Errorf("HoldAuction() took %v, want it to return shortly after tmax", elapsed)
+	}
+
+	responses := make(map[adapter.Key]adapters.DemandResponse)
+	for _, bid := range result.Bids {
+		responses[bid.DemandID] = bid
+	}
+	if len(responses) != len(bidders) {
+		t.Fatalf("got responses of %d adapters, want %d: %+v", len(responses), len(bidders), result.Bids)
+	}
+	if resp := responses[adapter.BidmachineKey]; resp.Error != nil || resp.Status != http.StatusNoContent {
+		t.Errorf("fast adapter response = %+v, want no bid", resp)
+	}
+	if resp := responses[adapter.MintegralKey]; !errors.Is(resp.Error, context.DeadlineExceeded) {
+		t.Errorf("slow adapter error = %v, want %v", resp.Error, context.DeadlineExceeded)
+	}
+	if resp := responses[adapter.VungleKey]; !errors.Is(resp.Error, context.DeadlineExceeded) {
+		t.Errorf("late adapter error = %v, want %v", resp.Error, context.DeadlineExceeded)
+	}
+
+	if tmax := <-bidders[adapter.BidmachineKey].tmax; tmax <= 20 || tmax > 100 {
+		t.Errorf("tmax sent to adapter = %d, want remaining bidding budget", tmax)
+	}
+	if tmax := <-bidders[adapter.MintegralKey].tmax; tmax <= 0 || tmax > 20 {
+		t.Errorf("tmax sent to adapter with own timeout = %d, want at most 20", tmax)
+	}
+}
+
+// timeoutNotifierStubBidder is stubBidder that expects a notification when its bid request times out.
+type timeoutNotifierStubBidder struct {
+	*stubBidder
+}
+
+func (b *timeoutNotifierStubBidder) TimeoutURL(request openrtb.BidRequest) string {
+	return "https://dsp.example.com/timeout?request=" + request.ID
+}
+
+func TestBuilder_HoldAuction_TimeoutNotification(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	bidder := &timeoutNotifierStubBidder{
+		stubBidder: &stubBidder{key: adapter.MetaKey, ignoreContext: release, tmax: make(chan int64, 1)},
+	}
+
+	params := &bidding.BuildParams{
+		App:            testApp(1),
+		AdapterConfigs: adapter.ProcessedConfigsMap{adapter.MetaKey: {}},
+		AuctionRequest: schema.AuctionRequest{
+			AdObject: schema.AdObject{
+				AuctionID: "auction-1",
+				Demands:   map[adapter.Key]map[string]any{adapter.MetaKey: {"token": "token"}},
+			},
+			Adapters: schema.Adapters{adapter.MetaKey: {Version: "1.0.0", SDKVersion: "1.0.0"}},
+		},
+		BiddingAdapters: []adapter.Key{adapter.MetaKey},
+		TMax:            20 * time.Millisecond,
+	}
+
+	sent := make(chan notification.Params, 1)
+	builder := &bidding.Builder{
+		AdaptersBuilder: &mocks.AdaptersBuilderMock{
+			BuildFunc: func(_ adapter.Key, _ adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+				return &adapters.Bidder{Adapter: bidder}, nil
+			},
+		},
+		NotificationHandler: notification.Handler{
+			Sender: &notificationmocks.SenderMock{
+				SendEventFunc: func(_ context.Context, p notification.Params) {
+					sent <- p
+				},
+			},
+		},
+		BidCacher: &mocks.BidCacherMock{
+			ApplyBidCacheFunc: func(_ context.Context, _ *bidding.BuildParams, aucRes *bidding.AuctionResult) []adapters.DemandResponse {
+				return aucRes.Bids
+			},
+		},
+	}
+
+	result, err := builder.HoldAuction(context.Background(), params)
+	if err != nil {
+		t.Fatalf("HoldAuction() error = %v", err)
+	}
+	if len(result.Bids) != 1 || !errors.Is(result.Bids[0].Error, context.DeadlineExceeded) {
+		t.Fatalf("HoldAuction() bids = %+v, want timed out response", result.Bids)
+	}
+
+	requestID := result.Bids[0].RequestID
+	if requestID == "" {
+		t.Fatalf("timed out response has no request ID")
+	}
+
+	select {
+	case p := <-sent:
+		want := "https://dsp.example.com/timeout?request=" + requestID
+		if p.NotificationType != "TimeoutURL" || p.URL != want || p.Bid.RequestID != requestID {
+			t.Errorf("SendEvent() params = %+v, want TimeoutURL notification %q", p, want)
+		}
+	default:
+		t.Errorf("timeout notification is not sent")
+	}
+}
+
+func TestBuilder_HoldAuction_DryRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		responses  map[adapter.Key]bidding.RecordedResponse
+		wantStatus int
+		wantPrice  float64
+	}{
+		{
+			name: "recorded response",
+			responses: map[adapter.Key]bidding.RecordedResponse{
+				adapter.BidmachineKey: {Body: `{"id":"1","seatbid":[{"bid":[{"id":"bid-1","impid":"imp-1","price":1.5}]}]}`},
+			},
+			wantStatus: http.StatusOK,
+			wantPrice:  1.5,
+		},
+		{
+			name:       "no recorded response",
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &bidding.BuildParams{
+				App: testApp(1),
+				AdapterConfigs: adapter.ProcessedConfigsMap{
+					adapter.BidmachineKey: {"endpoint": "https://bidmachine.invalid", "seller_id": "1"},
+				},
+				AuctionRequest: schema.AuctionRequest{
+					AdObject: schema.AdObject{
+						Demands: map[adapter.Key]map[string]any{
+							adapter.BidmachineKey: {"token": "token"},
+						},
+					},
+					Adapters: schema.Adapters{
+						adapter.BidmachineKey: {Version: "1.0.0", SDKVersion: "1.0.0"},
+					},
+				},
+				BiddingAdapters: []adapter.Key{adapter.BidmachineKey},
+				DryRun:          &bidding.DryRun{Responses: tt.responses},
+			}
+
+			// Bid cacher and notification handler mocks panic if called
+			builder := &bidding.Builder{
+				AdaptersBuilder: &mocks.AdaptersBuilderMock{
+					BuildFunc: func(key adapter.Key, cfg adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+						return bidmachine.Builder(cfg, http.DefaultClient)
+					},
+				},
+				NotificationHandler: &mocks.NotificationHandlerMock{},
+				BidCacher:           &mocks.BidCacherMock{},
+			}
+
+			result, err := builder.HoldAuction(context.Background(), params)
+			if err != nil {
+				t.Fatalf("HoldAuction() error = %v", err)
+			}
+			if len(result.Bids) != 1 {
+				t.Fatalf("HoldAuction() bids = %+v, want 1 bid", result.Bids)
+			}
+
+			bid := result.Bids[0]
+			if bid.Error != nil || bid.Status != tt.wantStatus || bid.Price() != tt.wantPrice {
+				t.Errorf("HoldAuction() bid = %+v, want status %d and price %v", bid, tt.wantStatus, tt.wantPrice)
+			}
+		})
+	}
+}
+
+func TestBuilder_HoldAuction_CircuitBreaker(t *testing.T) {
+	newParams := func(dryRun *bidding.DryRun) *bidding.BuildParams {
+		return &bidding.BuildParams{
+			App: testApp(1),
+			AdapterConfigs: adapter.ProcessedConfigsMap{
+				adapter.BidmachineKey: {"endpoint": "https://bidmachine.invalid", "seller_id": "1"},
+			},
+			AuctionRequest: schema.AuctionRequest{
+				AdObject: schema.AdObject{
+					Demands: map[adapter.Key]map[string]any{
+						adapter.BidmachineKey: {"token": "token"},
+					},
+				},
+				Adapters: schema.Adapters{
+					adapter.BidmachineKey: {Version: "1.0.0", SDKVersion: "1.0.0"},
+				},
+			},
+			BiddingAdapters: []adapter.Key{adapter.BidmachineKey},
+			DryRun:          dryRun,
+		}
+	}
+	newCircuitBreaker := func() *bidding.CircuitBreaker {
+		return &bidding.CircuitBreaker{
+			Clock:       clock.NewMock(),
+			Window:      time.Minute,
+			MinRequests: 1,
+			FailureRate: 0.5,
+			OpenTimeout: time.Minute,
+		}
+	}
+
+	t.Run("open circuit skips adapter", func(t *testing.T) {
+		circuitBreaker := newCircuitBreaker()
+		circuitBreaker.Record(bidding.CircuitKey{Adapter: adapter.BidmachineKey}, true)
+
+		builder := &bidding.Builder{
+			AdaptersBuilder: &mocks.AdaptersBuilderMock{
+				BuildFunc: func(_ adapter.Key, cfg adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+					client := &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+						t.Error("bid request sent to adapter with open circuit")
+						return nil, errors.New("unexpected request")
+					})}
+					return bidmachine.Builder(cfg, client)
+				},
+			},
+			NotificationHandler: &mocks.NotificationHandlerMock{
+				HandleBiddingRoundFunc: func(_ context.Context, _ *schema.AdObject, _ bidding.AuctionResult, _ string, _ string) error {
+					return nil
+				},
+			},
+			BidCacher: &mocks.BidCacherMock{
+				ApplyBidCacheFunc: func(_ context.Context, _ *bidding.BuildParams, aucRes *bidding.AuctionResult) []adapters.DemandResponse {
+					return aucRes.Bids
+				},
+			},
+			CircuitBreaker: circuitBreaker,
+		}
+
+		result, err := builder.HoldAuction(context.Background(), newParams(nil))
+		if err != nil {
+			t.Fatalf("HoldAuction() error = %v", err)
+		}
+		if len(result.Bids) != 1 || !errors.Is(result.Bids[0].Error, bidding.ErrCircuitOpen) {
+			t.Errorf("HoldAuction() bids = %+v, want a response with ErrCircuitOpen", result.Bids)
+		}
+	})
+
+	t.Run("open circuit of account skips adapter of the account", func(t *testing.T) {
+		circuitBreaker := newCircuitBreaker()
+		circuitBreaker.PerAccount = true
+		circuitBreaker.Record(circuitBreaker.Key(adapter.BidmachineKey, 7), true)
+
+		var requests int
+		builder := &bidding.Builder{
+			AdaptersBuilder: &mocks.AdaptersBuilderMock{
+				BuildFunc: func(_ adapter.Key, cfg adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+					client := &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+						requests++
+						return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+					})}
+					return bidmachine.Builder(cfg, client)
+				},
+			},
+			NotificationHandler: &mocks.NotificationHandlerMock{
+				HandleBiddingRoundFunc: func(_ context.Context, _ *schema.AdObject, _ bidding.AuctionResult, _ string, _ string) error {
+					return nil
+				},
+			},
+			BidCacher: &mocks.BidCacherMock{
+				ApplyBidCacheFunc: func(_ context.Context, _ *bidding.BuildParams, aucRes *bidding.AuctionResult) []adapters.DemandResponse {
+					return aucRes.Bids
+				},
+			},
+			CircuitBreaker: circuitBreaker,
+		}
+
+		for _, accountID := range []int64{7, 8} {
+			params := newParams(nil)
+			params.AdapterConfigs[adapter.BidmachineKey][adapter.AccountIDConfigKey] = accountID
+			if _, err := builder.HoldAuction(context.Background(), params); err != nil {
+				t.Fatalf("HoldAuction() error = %v", err)
+			}
+		}
+		if requests != 1 {
+			t.Errorf("bid requests sent = %d, want %d to the account with closed circuit", requests, 1)
+		}
+	})
+
+	t.Run("canceled probe is not recorded", func(t *testing.T) {
+		clk := clock.NewMock()
+		circuitBreaker := newCircuitBreaker()
+		circuitBreaker.Clock = clk
+		key := bidding.CircuitKey{Adapter: adapter.BidmachineKey}
+		circuitBreaker.Record(key, true)
+		clk.Add(time.Minute)
+
+		builder := &bidding.Builder{
+			AdaptersBuilder: &mocks.AdaptersBuilderMock{
+				BuildFunc: func(_ adapter.Key, cfg adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+					client := &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+						return nil, context.Canceled
+					})}
+					return bidmachine.Builder(cfg, client)
+				},
+			},
+			NotificationHandler: &mocks.NotificationHandlerMock{
+				HandleBiddingRoundFunc: func(_ context.Context, _ *schema.AdObject, _ bidding.AuctionResult, _ string, _ string) error {
+					return nil
+				},
+			},
+			BidCacher: &mocks.BidCacherMock{
+				ApplyBidCacheFunc: func(_ context.Context, _ *bidding.BuildParams, aucRes *bidding.AuctionResult) []adapters.DemandResponse {
+					return aucRes.Bids
+				},
+			},
+			CircuitBreaker: circuitBreaker,
+		}
+
+		if _, err := builder.HoldAuction(context.Background(), newParams(nil)); err != nil {
+			t.Fatalf("HoldAuction() error = %v", err)
+		}
+		if got := circuitBreaker.Statuses()[key].State; got != bidding.CircuitHalfOpen {
+			t.Errorf("state after canceled probe = %v, want %v", got, bidding.CircuitHalfOpen)
+		}
+		if !circuitBreaker.Allow(key) {
+			t.Errorf("Allow() after canceled probe = false, want another probe")
+		}
+	})
+
+	t.Run("dry run bypasses circuit breaker", func(t *testing.T) {
+		circuitBreaker := newCircuitBreaker()
+		circuitBreaker.Record(bidding.CircuitKey{Adapter: adapter.BidmachineKey}, true)
+		wantStatuses := circuitBreaker.Statuses()
+
+		// Bid cacher and notification handler mocks panic if called
+		builder := &bidding.Builder{
+			AdaptersBuilder: &mocks.AdaptersBuilderMock{
+				BuildFunc: func(_ adapter.Key, cfg adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+					return bidmachine.Builder(cfg, http.DefaultClient)
+				},
+			},
+			NotificationHandler: &mocks.NotificationHandlerMock{},
+			BidCacher:           &mocks.BidCacherMock{},
+			CircuitBreaker:      circuitBreaker,
+		}
+
+		// Recorded server error would be a failure of the adapter
+		dryRun := &bidding.DryRun{Responses: map[adapter.Key]bidding.RecordedResponse{
+			adapter.BidmachineKey: {Status: http.StatusInternalServerError},
+		}}
+		result, err := builder.HoldAuction(context.Background(), newParams(dryRun))
+		if err != nil {
+			t.Fatalf("HoldAuction() error = %v", err)
+		}
+		if len(result.Bids) != 1 || result.Bids[0].Status != http.StatusInternalServerError {
+			t.Errorf("HoldAuction() bids = %+v, want recorded response", result.Bids)
+		}
+		if diff := cmp.Diff(wantStatuses, circuitBreaker.Statuses()); diff != "" {
+			t.Errorf("CircuitBreaker.Statuses() mismatch, dry run must not be recorded (-want +got):\n%s", diff)
+		}
+	})
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestBuilder_HoldAuction_Deals(t *testing.T) {
+	deals := []openrtb2.Deal{{ID: "pmp-1", BidFloor: 1.5, BidFloorCur: "USD"}}
+	params := &bidding.BuildParams{
+		App: testApp(1),
+		AdapterConfigs: adapter.ProcessedConfigsMap{
+			adapter.BidmachineKey: {"endpoint": "https://bidmachine.invalid", "seller_id": "1"},
+		},
+		AuctionRequest: schema.AuctionRequest{
+			AdObject: schema.AdObject{
+				Demands: map[adapter.Key]map[string]any{
+					adapter.BidmachineKey: {"token": "token"},
+				},
+			},
+			Adapters: schema.Adapters{
+				adapter.BidmachineKey: {Version: "1.0.0", SDKVersion: "1.0.0"},
+			},
+		},
+		BiddingAdapters: []adapter.Key{adapter.BidmachineKey},
+		Deals:           map[adapter.Key][]openrtb2.Deal{adapter.BidmachineKey: deals},
+		DryRun: &bidding.DryRun{Responses: map[adapter.Key]bidding.RecordedResponse{
+			adapter.BidmachineKey: {Body: `{"id":"1","seatbid":[{"bid":[{"id":"bid-1","impid":"imp-1","price":2,"dealid":"pmp-1"}]}]}`},
+		}},
+	}
+
+	// Bid cacher and notification handler mocks panic if called
+	builder := &bidding.Builder{
+		AdaptersBuilder: &mocks.AdaptersBuilderMock{
+			BuildFunc: func(_ adapter.Key, cfg adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+				return bidmachine.Builder(cfg, http.DefaultClient)
+			},
+		},
+		NotificationHandler: &mocks.NotificationHandlerMock{},
+		BidCacher:           &mocks.BidCacherMock{},
+	}
+
+	result, err := builder.HoldAuction(context.Background(), params)
+	if err != nil {
+		t.Fatalf("HoldAuction() error = %v", err)
+	}
+	if len(result.Bids) != 1 || !result.Bids[0].IsBid() {
+		t.Fatalf("HoldAuction() bids = %+v, want 1 bid", result.Bids)
+	}
+
+	bid := result.Bids[0]
+	if bid.Bid.DealID != "pmp-1" {
+		t.Errorf("HoldAuction() bid deal id = %q, want %q", bid.Bid.DealID, "pmp-1")
+	}
+
+	var request openrtb2.BidRequest
+	if err := json.Unmarshal([]byte(bid.RawRequest), &request); err != nil {
+		t.Fatalf("json.Unmarshal(RawRequest) error = %v", err)
+	}
+	for _, imp := range request.Imp {
+		if diff := cmp.Diff(&openrtb2.PMP{Deals: deals}, imp.PMP); diff != "" {
+			t.Errorf("imp.pmp mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestBuilder_HoldAuction_TokenBidder(t *testing.T) {
+	params := &bidding.BuildParams{
+		App: testApp(1),
+		AdapterConfigs: adapter.ProcessedConfigsMap{
+			adapter.AmazonKey: {
+				"price_points_map": map[string]any{
+					"pp1": map[string]any{"price": 0.5, "price_point": "pp1"},
+					"pp2": map[string]any{"price": 1.5, "price_point": "pp2"},
+				},
+				amazon.SlotsKey: map[string]string{"slot-1": "unit-1", "slot-2": "unit-2"},
+			},
+		},
+		AuctionRequest: schema.AuctionRequest{
+			AdObject: schema.AdObject{
+				Demands: map[adapter.Key]map[string]any{
+					adapter.AmazonKey: {"token": `[{"slot_uuid":"slot-1","price_point":"pp1"},{"slot_uuid":"slot-2","price_point":"pp2"}]`},
+				},
+			},
+			Adapters: schema.Adapters{
+				adapter.AmazonKey: {Version: "1.0.0", SDKVersion: "1.0.0"},
+			},
+		},
+		BiddingAdapters: []adapter.Key{adapter.AmazonKey},
+		DryRun:          &bidding.DryRun{},
+	}
+
+	// Bid requests are not sent, bidder client is nil
+	builder := &bidding.Builder{
+		AdaptersBuilder: &mocks.AdaptersBuilderMock{
+			BuildFunc: func(_ adapter.Key, cfg adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+				return amazon.Builder(cfg, nil)
+			},
+		},
+	}
+
+	result, err := builder.HoldAuction(context.Background(), params)
+	if err != nil {
+		t.Fatalf("HoldAuction() error = %v", err)
+	}
+
+	got := make(map[string]float64, len(result.Bids))
+	for _, bid := range result.Bids {
+		if !bid.IsBid() {
+			t.Fatalf("HoldAuction() response = %+v, want bid", bid)
+		}
+		got[bid.Bid.AdUnitUID] = bid.Price()
+	}
+	want := map[string]float64{"unit-1": 0.5, "unit-2": 1.5}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("HoldAuction() bids mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestBuilder_HoldAuction_Spans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	params := &bidding.BuildParams{
+		App: testApp(1),
+		AdapterConfigs: adapter.ProcessedConfigsMap{
+			adapter.BidmachineKey: {"endpoint": "https://bidmachine.invalid", "seller_id": "1"},
+		},
+		AuctionRequest: schema.AuctionRequest{
+			AdObject: schema.AdObject{
+				Demands: map[adapter.Key]map[string]any{
+					adapter.BidmachineKey: {"token": "token"},
+				},
+			},
+			Adapters: schema.Adapters{
+				adapter.BidmachineKey: {Version: "1.0.0", SDKVersion: "1.0.0"},
+			},
+		},
+		BiddingAdapters: []adapter.Key{adapter.BidmachineKey},
+		DryRun: &bidding.DryRun{Responses: map[adapter.Key]bidding.RecordedResponse{
+			adapter.BidmachineKey: {Body: `{"id":"1","seatbid":[{"bid":[{"id":"bid-1","impid":"imp-1","price":1.5}]}]}`},
+		}},
+	}
+	builder := &bidding.Builder{
+		AdaptersBuilder: &mocks.AdaptersBuilderMock{
+			BuildFunc: func(key adapter.Key, cfg adapter.ProcessedConfigsMap) (*adapters.Bidder, error) {
+				return bidmachine.Builder(cfg, http.DefaultClient)
+			},
+		},
+	}
+
+	if _, err := builder.HoldAuction(context.Background(), params); err != nil {
+		t.Fatalf("HoldAuction() error = %v", err)
+	}
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	auctionSpan, bidSpan := spans["bidding.HoldAuction"], spans["bidding.RequestBid"]
+	if auctionSpan == nil || bidSpan == nil {
+		t.Fatalf("HoldAuction() spans = %v, want bidding.HoldAuction and bidding.RequestBid", recorder.Ended())
+	}
+	if bidSpan.Parent().SpanID() != auctionSpan.SpanContext().SpanID() {
+		t.Errorf("bidding.RequestBid parent = %v, want bidding.HoldAuction", bidSpan.Parent().SpanID())
+	}
+
+	wantAttributes := []attribute.KeyValue{
+		attribute.String("adapter.key", string(adapter.BidmachineKey)),
+		attribute.Int("bid.status", http.StatusOK),
+		attribute.Float64("bid.price", 1.5),
+	}
+	gotAttributes := attribute.NewSet(bidSpan.Attributes()...)
+	if want := attribute.NewSet(wantAttributes...); !gotAttributes.Equals(&want) {
+		t.Errorf("bidding.RequestBid attributes = %v, want %v", gotAttributes.Encoded(attribute.DefaultEncoder()), want.Encoded(attribute.DefaultEncoder()))
+	}
+}
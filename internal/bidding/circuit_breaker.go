@@ -0,0 +1,257 @@
+package bidding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
+)
+
+// ErrCircuitOpen is set as an error of demand response when adapter was skipped because its circuit is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"
+	CircuitOpen     CircuitState = "open"
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+const (
+	DefaultCircuitWindow      = 30 * time.Second
+	DefaultCircuitMinRequests = 20
+	DefaultCircuitFailureRate = 0.5
+	DefaultCircuitOpenTimeout = 30 * time.Second
+)
+
+// CircuitBreaker tracks failures of bidding adapters and stops calling adapters that keep failing.
+//
+// Circuit of an adapter opens when at least MinRequests were made during Window and FailureRate of them failed.
+// While open, adapter is skipped. After OpenTimeout circuit becomes half-open and lets a single probe request through:
+// circuit closes if the probe succeeds and opens again otherwise.
+//
+// If PerAccount is set, adapter has a separate circuit for every demand source account,
+// so that failures caused by misconfigured account don't stop requests of other accounts.
+//
+// Nil CircuitBreaker allows all requests.
+type CircuitBreaker struct {
+	Clock       clock.Clock
+	Window      time.Duration
+	MinRequests int
+	FailureRate float64
+	OpenTimeout time.Duration
+	PerAccount  bool
+
+	mu       sync.Mutex
+	circuits map[CircuitKey]*circuit
+}
+
+// CircuitKey identifies a circuit. AccountID is zero unless circuits are tracked per account.
+type CircuitKey struct {
+	Adapter   adapter.Key
+	AccountID int64
+}
+
+// MarshalText makes CircuitKey usable as a JSON object key of health endpoint response.
+func (k CircuitKey) MarshalText() ([]byte, error) {
+	if k.AccountID == 0 {
+		return []byte(k.Adapter), nil
+	}
+
+	return fmt.Appendf(nil, "%s:%d", k.Adapter, k.AccountID), nil
+}
+
+type circuit struct {
+	state       CircuitState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probing     bool
+}
+
+// CircuitStatus is a snapshot of adapter circuit, exposed by health endpoint.
+type CircuitStatus struct {
+	State    CircuitState `json:"state"`
+	Requests int          `json:"requests"`
+	Failures int          `json:"failures"`
+	OpenedAt *time.Time   `json:"opened_at,omitempty"`
+}
+
+func NewCircuitBreaker(clk clock.Clock) *CircuitBreaker {
+	return &CircuitBreaker{
+		Clock:       clk,
+		Window:      DefaultCircuitWindow,
+		MinRequests: DefaultCircuitMinRequests,
+		FailureRate: DefaultCircuitFailureRate,
+		OpenTimeout: DefaultCircuitOpenTimeout,
+	}
+}
+
+// Key returns key of the circuit of the adapter account.
+func (cb *CircuitBreaker) Key(adapterKey adapter.Key, accountID int64) CircuitKey {
+	if cb == nil || !cb.PerAccount {
+		return CircuitKey{Adapter: adapterKey}
+	}
+
+	return CircuitKey{Adapter: adapterKey, AccountID: accountID}
+}
+
+// Allow reports whether a request to the adapter can be made.
+// Every allowed request must be followed by Record, or by Release if it says nothing about adapter health.
+func (cb *CircuitBreaker) Allow(key CircuitKey) bool {
+	if cb == nil {
+		return true
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c := cb.circuit(key)
+	switch c.state {
+	case CircuitOpen:
+		if cb.Clock.Since(c.openedAt) < cb.OpenTimeout {
+			return false
+		}
+
+		c.state = CircuitHalfOpen
+		c.probing = true
+		return true
+	case CircuitHalfOpen:
+		if c.probing {
+			return false
+		}
+
+		c.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Record registers outcome of a request allowed by Allow.
+func (cb *CircuitBreaker) Record(key CircuitKey, failed bool) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c := cb.circuit(key)
+	now := cb.Clock.Now()
+
+	if c.state == CircuitHalfOpen {
+		c.probing = false
+		if failed {
+			c.open(now)
+		} else {
+			c.close(now)
+		}
+		return
+	}
+
+	if now.Sub(c.windowStart) >= cb.Window {
+		c.windowStart = now
+		c.requests = 0
+		c.failures = 0
+	}
+
+	c.requests++
+	if failed {
+		c.failures++
+	}
+
+	if c.state == CircuitClosed && c.requests >= cb.MinRequests && float64(c.failures) >= cb.FailureRate*float64(c.requests) {
+		c.open(now)
+	}
+}
+
+// Release finishes a request allowed by Allow without registering its outcome, e.g. when the request was canceled.
+// Half-open circuit lets another probe through.
+func (cb *CircuitBreaker) Release(key CircuitKey) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.circuit(key).probing = false
+}
+
+// Statuses returns snapshot of all circuits that have seen any requests.
+func (cb *CircuitBreaker) Statuses() map[CircuitKey]CircuitStatus {
+	statuses := make(map[CircuitKey]CircuitStatus)
+	if cb == nil {
+		return statuses
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	for key, c := range cb.circuits {
+		status := CircuitStatus{
+			State:    c.state,
+			Requests: c.requests,
+			Failures: c.failures,
+		}
+		if c.state != CircuitClosed {
+			openedAt := c.openedAt
+			status.OpenedAt = &openedAt
+		}
+
+		statuses[key] = status
+	}
+
+	return statuses
+}
+
+func (cb *CircuitBreaker) circuit(key CircuitKey) *circuit {
+	if cb.circuits == nil {
+		cb.circuits = make(map[CircuitKey]*circuit)
+	}
+
+	c, ok := cb.circuits[key]
+	if !ok {
+		c = &circuit{state: CircuitClosed, windowStart: cb.Clock.Now()}
+		cb.circuits[key] = c
+	}
+
+	return c
+}
+
+func (c *circuit) open(now time.Time) {
+	c.state = CircuitOpen
+	c.openedAt = now
+}
+
+func (c *circuit) close(now time.Time) {
+	c.state = CircuitClosed
+	c.windowStart = now
+	c.requests = 0
+	c.failures = 0
+}
+
+// isCanceled reports whether request was canceled by the caller, such requests say nothing about adapter health.
+func isCanceled(dr *adapters.DemandResponse) bool {
+	return dr.Status == 0 && errors.Is(dr.Error, context.Canceled)
+}
+
+// isAdapterFailure reports whether demand response indicates that adapter is unhealthy:
+// request failed without response (including timeouts) or DSP responded with 5xx.
+// No-bids and 4xx are not failures.
+func isAdapterFailure(dr *adapters.DemandResponse) bool {
+	if dr.Status >= http.StatusInternalServerError {
+		return true
+	}
+
+	return dr.Status == 0 && dr.Error != nil
+}
@@ -0,0 +1,155 @@
+package bidding_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/bidding"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	clk := clock.NewMock()
+	cb := &bidding.CircuitBreaker{
+		Clock:       clk,
+		Window:      10 * time.Second,
+		MinRequests: 4,
+		FailureRate: 0.5,
+		OpenTimeout: 30 * time.Second,
+	}
+	key := bidding.CircuitKey{Adapter: adapter.BidmachineKey}
+
+	record := func(failed ...bool) {
+		t.Helper()
+		for _, f := range failed {
+			if !cb.Allow(key) {
+				t.Fatalf("Allow() = false, want true")
+			}
+			cb.Record(key, f)
+		}
+	}
+	wantState := func(want bidding.CircuitState) {
+		t.Helper()
+		if got := cb.Statuses()[key].State; got != want {
+			t.Fatalf("state = %v, want %v", got, want)
+		}
+	}
+
+	record(true, false, false)
+	wantState(bidding.CircuitClosed)
+
+	// Failures of the previous window are forgotten.
+	clk.Add(10 * time.Second)
+	record(true, false, false, false)
+	wantState(bidding.CircuitClosed)
+
+	record(true, true)
+	wantState(bidding.CircuitOpen)
+	if cb.Allow(key) {
+		t.Fatalf("Allow() of open circuit = true, want false")
+	}
+	if !cb.Allow(bidding.CircuitKey{Adapter: adapter.MintegralKey}) {
+		t.Fatalf("Allow() of another adapter = false, want true")
+	}
+
+	// Half-open circuit lets a single probe through, failed probe opens it again.
+	clk.Add(30 * time.Second)
+	if !cb.Allow(key) {
+		t.Fatalf("Allow() of half-open circuit = false, want probe")
+	}
+	wantState(bidding.CircuitHalfOpen)
+	if cb.Allow(key) {
+		t.Fatalf("Allow() during probe = true, want false")
+	}
+	cb.Record(key, true)
+	wantState(bidding.CircuitOpen)
+
+	// Successful probe closes the circuit.
+	clk.Add(30 * time.Second)
+	record(false)
+	wantState(bidding.CircuitClosed)
+	if status := cb.Statuses()[key]; status.Requests != 0 || status.Failures != 0 {
+		t.Errorf("status after close = %+v, want counters reset", status)
+	}
+}
+
+func TestCircuitBreaker_Nil(t *testing.T) {
+	var cb *bidding.CircuitBreaker
+
+	key := cb.Key(adapter.BidmachineKey, 1)
+	if !cb.Allow(key) {
+		t.Errorf("Allow() = false, want true")
+	}
+	cb.Record(key, true)
+	cb.Release(key)
+	if len(cb.Statuses()) != 0 {
+		t.Errorf("Statuses() = %v, want empty", cb.Statuses())
+	}
+}
+
+func TestCircuitBreaker_Release(t *testing.T) {
+	clk := clock.NewMock()
+	cb := &bidding.CircuitBreaker{
+		Clock:       clk,
+		Window:      10 * time.Second,
+		MinRequests: 1,
+		FailureRate: 0.5,
+		OpenTimeout: 30 * time.Second,
+	}
+	key := cb.Key(adapter.BidmachineKey, 0)
+
+	cb.Allow(key)
+	cb.Record(key, true)
+	clk.Add(30 * time.Second)
+
+	// Released probe neither closes nor opens the circuit, another probe is let through.
+	if !cb.Allow(key) {
+		t.Fatalf("Allow() of half-open circuit = false, want probe")
+	}
+	cb.Release(key)
+	if got := cb.Statuses()[key].State; got != bidding.CircuitHalfOpen {
+		t.Fatalf("state after release = %v, want %v", got, bidding.CircuitHalfOpen)
+	}
+	if !cb.Allow(key) {
+		t.Fatalf("Allow() after released probe = false, want probe")
+	}
+}
+
+func TestCircuitBreaker_PerAccount(t *testing.T) {
+	cb := &bidding.CircuitBreaker{
+		Clock:       clock.NewMock(),
+		Window:      10 * time.Second,
+		MinRequests: 1,
+		FailureRate: 0.5,
+		OpenTimeout: 30 * time.Second,
+	}
+	if key := cb.Key(adapter.BidmachineKey, 1); key != (bidding.CircuitKey{Adapter: adapter.BidmachineKey}) {
+		t.Fatalf("Key() = %v, want adapter key only unless circuits are per account", key)
+	}
+
+	cb.PerAccount = true
+	failing, healthy := cb.Key(adapter.BidmachineKey, 1), cb.Key(adapter.BidmachineKey, 2)
+	cb.Allow(failing)
+	cb.Record(failing, true)
+
+	if cb.Allow(failing) {
+		t.Errorf("Allow() of failing account = true, want false")
+	}
+	if !cb.Allow(healthy) {
+		t.Errorf("Allow() of another account = false, want true")
+	}
+
+	got, err := json.Marshal(cb.Statuses())
+	if err != nil {
+		t.Fatalf("json.Marshal(Statuses()) error = %v", err)
+	}
+	var statuses map[string]bidding.CircuitStatus
+	if err := json.Unmarshal(got, &statuses); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if statuses["bidmachine:1"].State != bidding.CircuitOpen {
+		t.Errorf("Statuses() = %s, want open circuit of bidmachine:1", got)
+	}
+}
@@ -0,0 +1,51 @@
+package bidding
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+)
+
+// DryRun makes HoldAuction run without side effects: adapters get recorded responses instead of sending requests
+// to DSPs, circuit breaker is bypassed, and bids are neither cached nor stored for notifications.
+type DryRun struct {
+	// Responses are recorded responses of adapters by adapter key. Adapters without one respond with no bid.
+	Responses map[adapter.Key]RecordedResponse
+}
+
+// RecordedResponse is HTTP response of a DSP, e.g. raw_response of captured bid_request event.
+type RecordedResponse struct {
+	Status int    `json:"status"`
+	Body   string `json:"body"`
+}
+
+// client returns HTTP client that responds to the adapter with its recorded response.
+func (d *DryRun) client(adapterKey adapter.Key) *http.Client {
+	return &http.Client{Transport: recordedTransport(d.Responses[adapterKey])}
+}
+
+type recordedTransport RecordedResponse
+
+func (t recordedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	status := t.Status
+	if status == 0 {
+		status = http.StatusNoContent
+		if t.Body != "" {
+			status = http.StatusOK
+		}
+	}
+
+	return &http.Response{
+		Status:     http.StatusText(status),
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.Body)),
+		Request:    req,
+	}, nil
+}
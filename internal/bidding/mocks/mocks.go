@@ -9,6 +9,7 @@ import (
 	"github.com/bidon-io/bidon-backend/internal/bidding"
 	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+	"github.com/prebid/openrtb/v19/openrtb3"
 	"sync"
 )
 
@@ -97,6 +98,9 @@ var _ bidding.NotificationHandler = &NotificationHandlerMock{}
 //			HandleBiddingRoundFunc: func(contextMoqParam context.Context, adObject *schema.AdObject, auctionResult bidding.AuctionResult, s1 string, s2 string) error {
 //				panic("mock out the HandleBiddingRound method")
 //			},
+//			HandleCachedBidLossFunc: func(ctx context.Context, auctionID string, bid adapters.DemandResponse, reason openrtb3.LossReason, firstPrice float64, secondPrice float64, bundle string, adType string)  {
+//				panic("mock out the HandleCachedBidLoss method")
+//			},
 //		}
 //
 //		// use mockedNotificationHandler in code that requires bidding.NotificationHandler
@@ -107,6 +111,9 @@ type NotificationHandlerMock struct {
 	// HandleBiddingRoundFunc mocks the HandleBiddingRound method.
 	HandleBiddingRoundFunc func(contextMoqParam context.Context, adObject *schema.AdObject, auctionResult bidding.AuctionResult, s1 string, s2 string) error
 
+	// HandleCachedBidLossFunc mocks the HandleCachedBidLoss method.
+	HandleCachedBidLossFunc func(ctx context.Context, auctionID string, bid adapters.DemandResponse, reason openrtb3.LossReason, firstPrice float64, secondPrice float64, bundle string, adType string)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// HandleBiddingRound holds details about calls to the HandleBiddingRound method.
@@ -122,8 +129,28 @@ type NotificationHandlerMock struct {
 			// S2 is the s2 argument value.
 			S2 string
 		}
+		// HandleCachedBidLoss holds details about calls to the HandleCachedBidLoss method.
+		HandleCachedBidLoss []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AuctionID is the auctionID argument value.
+			AuctionID string
+			// Bid is the bid argument value.
+			Bid adapters.DemandResponse
+			// Reason is the reason argument value.
+			Reason openrtb3.LossReason
+			// FirstPrice is the firstPrice argument value.
+			FirstPrice float64
+			// SecondPrice is the secondPrice argument value.
+			SecondPrice float64
+			// Bundle is the bundle argument value.
+			Bundle string
+			// AdType is the adType argument value.
+			AdType string
+		}
 	}
-	lockHandleBiddingRound sync.RWMutex
+	lockHandleBiddingRound  sync.RWMutex
+	lockHandleCachedBidLoss sync.RWMutex
 }
 
 // HandleBiddingRound calls HandleBiddingRoundFunc.
@@ -174,6 +201,66 @@ func (mock *NotificationHandlerMock) HandleBiddingRoundCalls() []struct {
 	return calls
 }
 
+// HandleCachedBidLoss calls HandleCachedBidLossFunc.
+func (mock *NotificationHandlerMock) HandleCachedBidLoss(ctx context.Context, auctionID string, bid adapters.DemandResponse, reason openrtb3.LossReason, firstPrice float64, secondPrice float64, bundle string, adType string) {
+	if mock.HandleCachedBidLossFunc == nil {
+		panic("NotificationHandlerMock.HandleCachedBidLossFunc: method is nil but NotificationHandler.HandleCachedBidLoss was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AuctionID   string
+		Bid         adapters.DemandResponse
+		Reason      openrtb3.LossReason
+		FirstPrice  float64
+		SecondPrice float64
+		Bundle      string
+		AdType      string
+	}{
+		Ctx:         ctx,
+		AuctionID:   auctionID,
+		Bid:         bid,
+		Reason:      reason,
+		FirstPrice:  firstPrice,
+		SecondPrice: secondPrice,
+		Bundle:      bundle,
+		AdType:      adType,
+	}
+	mock.lockHandleCachedBidLoss.Lock()
+	mock.calls.HandleCachedBidLoss = append(mock.calls.HandleCachedBidLoss, callInfo)
+	mock.lockHandleCachedBidLoss.Unlock()
+	mock.HandleCachedBidLossFunc(ctx, auctionID, bid, reason, firstPrice, secondPrice, bundle, adType)
+}
+
+// HandleCachedBidLossCalls gets all the calls that were made to HandleCachedBidLoss.
+// Check the length with:
+//
+//	len(mockedNotificationHandler.HandleCachedBidLossCalls())
+func (mock *NotificationHandlerMock) HandleCachedBidLossCalls() []struct {
+	Ctx         context.Context
+	AuctionID   string
+	Bid         adapters.DemandResponse
+	Reason      openrtb3.LossReason
+	FirstPrice  float64
+	SecondPrice float64
+	Bundle      string
+	AdType      string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AuctionID   string
+		Bid         adapters.DemandResponse
+		Reason      openrtb3.LossReason
+		FirstPrice  float64
+		SecondPrice float64
+		Bundle      string
+		AdType      string
+	}
+	mock.lockHandleCachedBidLoss.RLock()
+	calls = mock.calls.HandleCachedBidLoss
+	mock.lockHandleCachedBidLoss.RUnlock()
+	return calls
+}
+
 // Ensure, that BidCacherMock does implement bidding.BidCacher.
 // If this is not the case, regenerate this file with moq.
 var _ bidding.BidCacher = &BidCacherMock{}
@@ -184,7 +271,7 @@ var _ bidding.BidCacher = &BidCacherMock{}
 //
 //		// make and configure a mocked bidding.BidCacher
 //		mockedBidCacher := &BidCacherMock{
-//			ApplyBidCacheFunc: func(ctx context.Context, ar *schema.AuctionRequest, result *bidding.AuctionResult) []adapters.DemandResponse {
+//			ApplyBidCacheFunc: func(ctx context.Context, params *bidding.BuildParams, result *bidding.AuctionResult) []adapters.DemandResponse {
 //				panic("mock out the ApplyBidCache method")
 //			},
 //		}
@@ -195,7 +282,7 @@ var _ bidding.BidCacher = &BidCacherMock{}
 //	}
 type BidCacherMock struct {
 	// ApplyBidCacheFunc mocks the ApplyBidCache method.
-	ApplyBidCacheFunc func(ctx context.Context, ar *schema.AuctionRequest, result *bidding.AuctionResult) []adapters.DemandResponse
+	ApplyBidCacheFunc func(ctx context.Context, params *bidding.BuildParams, result *bidding.AuctionResult) []adapters.DemandResponse
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -203,8 +290,8 @@ type BidCacherMock struct {
 		ApplyBidCache []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
-			// Ar is the ar argument value.
-			Ar *schema.AuctionRequest
+			// Params is the params argument value.
+			Params *bidding.BuildParams
 			// Result is the result argument value.
 			Result *bidding.AuctionResult
 		}
@@ -213,23 +300,23 @@ type BidCacherMock struct {
 }
 
 // ApplyBidCache calls ApplyBidCacheFunc.
-func (mock *BidCacherMock) ApplyBidCache(ctx context.Context, ar *schema.AuctionRequest, result *bidding.AuctionResult) []adapters.DemandResponse {
+func (mock *BidCacherMock) ApplyBidCache(ctx context.Context, params *bidding.BuildParams, result *bidding.AuctionResult) []adapters.DemandResponse {
 	if mock.ApplyBidCacheFunc == nil {
 		panic("BidCacherMock.ApplyBidCacheFunc: method is nil but BidCacher.ApplyBidCache was just called")
 	}
 	callInfo := struct {
 		Ctx    context.Context
-		Ar     *schema.AuctionRequest
+		Params *bidding.BuildParams
 		Result *bidding.AuctionResult
 	}{
 		Ctx:    ctx,
-		Ar:     ar,
+		Params: params,
 		Result: result,
 	}
 	mock.lockApplyBidCache.Lock()
 	mock.calls.ApplyBidCache = append(mock.calls.ApplyBidCache, callInfo)
 	mock.lockApplyBidCache.Unlock()
-	return mock.ApplyBidCacheFunc(ctx, ar, result)
+	return mock.ApplyBidCacheFunc(ctx, params, result)
 }
 
 // ApplyBidCacheCalls gets all the calls that were made to ApplyBidCache.
@@ -238,12 +325,12 @@ func (mock *BidCacherMock) ApplyBidCache(ctx context.Context, ar *schema.Auction
 //	len(mockedBidCacher.ApplyBidCacheCalls())
 func (mock *BidCacherMock) ApplyBidCacheCalls() []struct {
 	Ctx    context.Context
-	Ar     *schema.AuctionRequest
+	Params *bidding.BuildParams
 	Result *bidding.AuctionResult
 } {
 	var calls []struct {
 		Ctx    context.Context
-		Ar     *schema.AuctionRequest
+		Params *bidding.BuildParams
 		Result *bidding.AuctionResult
 	}
 	mock.lockApplyBidCache.RLock()
@@ -0,0 +1,33 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const TableNameAdapterInitOverride = "adapter_init_overrides"
+
+// AdapterInitOverride mapped from table <adapter_init_overrides>
+type AdapterInitOverride struct {
+	ID        int64          `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true" json:"id"`
+	AppID     int64          `gorm:"column:app_id;type:bigint;not null;uniqueIndex:adapter_init_overrides_app_uniq_idx,priority:1;uniqueIndex:adapter_init_overrides_segment_uniq_idx,priority:1;index:index_adapter_init_overrides_on_app_id,priority:1" json:"app_id"`
+	SegmentID *sql.NullInt64 `gorm:"column:segment_id;type:bigint;uniqueIndex:adapter_init_overrides_segment_uniq_idx,priority:2" json:"segment_id"`
+	Adapters  pq.StringArray `gorm:"column:adapters;type:character varying[]" json:"adapters"`
+	Patches   map[string]any `gorm:"column:patches;type:jsonb;not null;default:{};serializer:json" json:"patches"`
+	Enabled   *bool          `gorm:"column:enabled;type:boolean;not null;default:true" json:"enabled"`
+	CreatedAt time.Time      `gorm:"column:created_at;type:timestamp(6) without time zone;not null" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;type:timestamp(6) without time zone;not null" json:"updated_at"`
+	App       App            `json:"app"`
+	Segment   *Segment       `json:"segment"`
+}
+
+// TableName AdapterInitOverride's table name
+func (*AdapterInitOverride) TableName() string {
+	return TableNameAdapterInitOverride
+}
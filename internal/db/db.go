@@ -213,6 +213,37 @@ func (id PlatformID) Value() (driver.Value, error) {
 	return int64(id), nil
 }
 
+// FloorPolicy is stored in auction_configurations.floor_policy column.
+type FloorPolicy struct {
+	IgnoreFloorForMediators    []string `json:"ignore_floor_for_mediators,omitempty"`
+	IgnorePrevAuctionPrice     bool     `json:"ignore_prev_auction_price,omitempty"`
+	IgnoreAdCachePrices        bool     `json:"ignore_ad_cache_prices,omitempty"`
+	DynamicFloor               bool     `json:"dynamic_floor,omitempty"`
+	DynamicFloorTargetFillRate float64  `json:"dynamic_floor_target_fill_rate,omitempty"`
+}
+
+// BiddingTimeouts is stored in auction_configurations.bidding_timeouts column. Timeouts are in milliseconds.
+type BiddingTimeouts struct {
+	TMax     int32            `json:"tmax,omitempty"`
+	Adapters map[string]int32 `json:"adapters,omitempty"`
+}
+
+// OpenRTBBidder is stored in demand_sources.openrtb column.
+type OpenRTBBidder = adapter.OpenRTBBidder
+
+// ExperimentVariant is stored in experiments.variants column.
+// Override fields that are not set keep values of experiment auction configuration.
+type ExperimentVariant struct {
+	ID          string       `json:"id"`
+	Weight      int32        `json:"weight"`
+	Demands     []string     `json:"demands,omitempty"`
+	Bidding     []string     `json:"bidding,omitempty"`
+	AdUnitIDs   []int64      `json:"ad_unit_ids,omitempty"`
+	Pricefloor  *float64     `json:"pricefloor,omitempty"`
+	Timeout     *int32       `json:"timeout,omitempty"`
+	FloorPolicy *FloorPolicy `json:"floor_policy,omitempty"`
+}
+
 func newLogger(ignoreRecordNotFoundError bool) logger.Interface {
 	// Same as logger.Default
 	return logger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), logger.Config{
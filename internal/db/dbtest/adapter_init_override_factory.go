@@ -0,0 +1,44 @@
+package dbtest
+
+import (
+	"testing"
+
+	"github.com/bidon-io/bidon-backend/internal/db"
+)
+
+func adapterInitOverrideDefaults(_ uint32) func(*db.AdapterInitOverride) {
+	return func(override *db.AdapterInitOverride) {
+		if override.AppID == 0 && override.App.ID == 0 {
+			override.App = BuildApp(func(app *db.App) {
+				*app = override.App
+			})
+		}
+		if override.Patches == nil {
+			override.Patches = map[string]any{}
+		}
+	}
+}
+
+func BuildAdapterInitOverride(opts ...func(*db.AdapterInitOverride)) db.AdapterInitOverride {
+	var override db.AdapterInitOverride
+
+	n := counter.get("adapter_init_override")
+
+	opts = append(opts, adapterInitOverrideDefaults(n))
+	for _, opt := range opts {
+		opt(&override)
+	}
+
+	return override
+}
+
+func CreateAdapterInitOverride(tb testing.TB, tx *db.DB, opts ...func(*db.AdapterInitOverride)) db.AdapterInitOverride {
+	tb.Helper()
+
+	override := BuildAdapterInitOverride(opts...)
+	if err := tx.Create(&override).Error; err != nil {
+		tb.Fatalf("Failed to create adapter init override: %v", err)
+	}
+
+	return override
+}
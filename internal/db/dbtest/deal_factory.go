@@ -0,0 +1,50 @@
+package dbtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bidon-io/bidon-backend/internal/db"
+)
+
+func dealDefaults(n uint32) func(*db.Deal) {
+	return func(deal *db.Deal) {
+		if deal.AppID == 0 && deal.App.ID == 0 {
+			deal.App = BuildApp(func(app *db.App) {
+				*app = deal.App
+			})
+		}
+		if deal.DemandSourceID == 0 && deal.DemandSource.ID == 0 {
+			deal.DemandSource = BuildDemandSource(func(source *db.DemandSource) {
+				*source = deal.DemandSource
+			})
+		}
+		if deal.DealID == "" {
+			deal.DealID = fmt.Sprintf("deal%d", n)
+		}
+	}
+}
+
+func BuildDeal(opts ...func(*db.Deal)) db.Deal {
+	var deal db.Deal
+
+	n := counter.get("deal")
+
+	opts = append(opts, dealDefaults(n))
+	for _, opt := range opts {
+		opt(&deal)
+	}
+
+	return deal
+}
+
+func CreateDeal(tb testing.TB, tx *db.DB, opts ...func(*db.Deal)) db.Deal {
+	tb.Helper()
+
+	deal := BuildDeal(opts...)
+	if err := tx.Create(&deal).Error; err != nil {
+		tb.Fatalf("Failed to create deal: %v", err)
+	}
+
+	return deal
+}
@@ -0,0 +1,33 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package db
+
+import (
+	"database/sql"
+	"time"
+)
+
+const TableNameExperiment = "experiments"
+
+// Experiment mapped from table <experiments>
+type Experiment struct {
+	ID                     int64                `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true" json:"id"`
+	AppID                  int64                `gorm:"column:app_id;type:bigint;not null;index:index_experiments_on_app_id,priority:1" json:"app_id"`
+	AuctionConfigurationID int64                `gorm:"column:auction_configuration_id;type:bigint;not null;uniqueIndex:experiments_active_auction_configuration_uniq_idx,priority:1" json:"auction_configuration_id"`
+	Name                   string               `gorm:"column:name;type:character varying;not null" json:"name"`
+	Status                 string               `gorm:"column:status;type:character varying;not null;default:running" json:"status"`
+	Variants               []ExperimentVariant  `gorm:"column:variants;type:jsonb;not null;default:[];serializer:json" json:"variants"`
+	WinnerVariantID        sql.NullString       `gorm:"column:winner_variant_id;type:character varying" json:"winner_variant_id"`
+	ConcludedAt            *time.Time           `gorm:"column:concluded_at;type:timestamp(6) without time zone" json:"concluded_at"`
+	CreatedAt              time.Time            `gorm:"column:created_at;type:timestamp(6) without time zone;not null" json:"created_at"`
+	UpdatedAt              time.Time            `gorm:"column:updated_at;type:timestamp(6) without time zone;not null" json:"updated_at"`
+	App                    App                  `json:"app"`
+	AuctionConfiguration   AuctionConfiguration `json:"auction_configuration"`
+}
+
+// TableName Experiment's table name
+func (*Experiment) TableName() string {
+	return TableNameExperiment
+}
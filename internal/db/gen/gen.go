@@ -42,7 +42,14 @@ func GenerateModels(db *sql.DB) {
 		gen.FieldType("categories", "pq.StringArray"),
 	)
 
-	demandSource := g.GenerateModel("demand_sources")
+	demandSource := g.GenerateModel(
+		"demand_sources",
+		gen.FieldRename("openrtb", "OpenRTB"),
+		gen.FieldType("openrtb", "*OpenRTBBidder"),
+		gen.FieldGORMTag("openrtb", func(tag field.GormTag) field.GormTag {
+			return tag.Set("serializer", "json")
+		}),
+	)
 
 	demandSourceAccount := g.GenerateModel(
 		"demand_source_accounts",
@@ -71,7 +78,7 @@ func GenerateModels(db *sql.DB) {
 		}),
 	)
 
-	g.GenerateModel(
+	auctionConfiguration := g.GenerateModel(
 		"auction_configurations",
 		gen.FieldRelate(field.BelongsTo, "App", app, &field.RelateConfig{}),
 		gen.FieldRelate(field.BelongsTo, "Segment", segment, &field.RelateConfig{
@@ -92,6 +99,39 @@ func GenerateModels(db *sql.DB) {
 		gen.FieldGORMTag("settings", func(tag field.GormTag) field.GormTag {
 			return tag.Set("serializer", "json")
 		}),
+		gen.FieldType("floor_policy", "*FloorPolicy"),
+		gen.FieldGORMTag("floor_policy", func(tag field.GormTag) field.GormTag {
+			return tag.Set("serializer", "json")
+		}),
+		gen.FieldType("bidding_timeouts", "*BiddingTimeouts"),
+		gen.FieldGORMTag("bidding_timeouts", func(tag field.GormTag) field.GormTag {
+			return tag.Set("serializer", "json")
+		}),
+	)
+
+	g.GenerateModel(
+		"adapter_init_overrides",
+		gen.FieldRelate(field.BelongsTo, "App", app, &field.RelateConfig{}),
+		gen.FieldRelate(field.BelongsTo, "Segment", segment, &field.RelateConfig{
+			RelatePointer: true,
+		}),
+		gen.FieldType("segment_id", "*sql.NullInt64"),
+		gen.FieldType("adapters", "pq.StringArray"),
+		gen.FieldType("patches", "map[string]any"),
+		gen.FieldGORMTag("patches", func(tag field.GormTag) field.GormTag {
+			return tag.Set("serializer", "json")
+		}),
+	)
+
+	g.GenerateModel(
+		"experiments",
+		gen.FieldRelate(field.BelongsTo, "App", app, &field.RelateConfig{}),
+		gen.FieldRelate(field.BelongsTo, "AuctionConfiguration", auctionConfiguration, &field.RelateConfig{}),
+		gen.FieldType("variants", "[]ExperimentVariant"),
+		gen.FieldGORMTag("variants", func(tag field.GormTag) field.GormTag {
+			return tag.Set("serializer", "json")
+		}),
+		gen.FieldType("concluded_at", "*time.Time"),
 	)
 
 	g.GenerateModel("countries")
@@ -108,6 +148,17 @@ func GenerateModels(db *sql.DB) {
 		gen.FieldRename("bidding", "IsBidding"),
 	)
 
+	g.GenerateModel(
+		"deals",
+		gen.FieldRelate(field.BelongsTo, "App", app, &field.RelateConfig{}),
+		gen.FieldRelate(field.BelongsTo, "DemandSource", demandSource, &field.RelateConfig{}),
+		gen.FieldRelate(field.BelongsTo, "Segment", segment, &field.RelateConfig{
+			RelatePointer: true,
+		}),
+		gen.FieldType("segment_id", "*sql.NullInt64"),
+		gen.FieldType("countries", "pq.StringArray"),
+	)
+
 	g.Execute()
 }
 
@@ -0,0 +1,101 @@
+package floor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+)
+
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out collector_mocks_test.go . Recorder
+
+// Recorder persists distributions collected since the previous call.
+type Recorder interface {
+	Record(ctx context.Context, distributions map[Key]Distribution) error
+}
+
+// Collector is an event.Observer that builds bid price distributions from auction events
+// and periodically flushes them to Recorder.
+//
+// Auction service logs "bid" events of an auction before its "auction_request" event,
+// so the highest bid is kept by auction ID until "auction_request" event completes the auction.
+type Collector struct {
+	Recorder Recorder
+
+	mu            sync.Mutex
+	highestBids   map[string]float64
+	distributions map[Key]Distribution
+}
+
+func (c *Collector) Observe(e event.Event) {
+	ev, ok := e.(*event.AdEvent)
+	if !ok || ev.AuctionID == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch ev.EventType {
+	case "bid":
+		if c.highestBids == nil {
+			c.highestBids = make(map[string]float64)
+		}
+		c.highestBids[ev.AuctionID] = max(c.highestBids[ev.AuctionID], ev.ECPM)
+	case "auction_request":
+		price := c.highestBids[ev.AuctionID]
+		delete(c.highestBids, ev.AuctionID)
+
+		// Auctions that failed before an auction configuration was selected never reached bidders.
+		if ev.AppID == 0 || ev.AuctionConfigurationID == 0 {
+			return
+		}
+
+		key := Key{
+			AppID:                  ev.AppID,
+			AdType:                 ad.Type(ev.AdType),
+			Country:                ev.CountryCode,
+			AuctionConfigurationID: ev.AuctionConfigurationID,
+		}
+
+		if c.distributions == nil {
+			c.distributions = make(map[Key]Distribution)
+		}
+		d := c.distributions[key]
+		d.Add(price)
+		c.distributions[key] = d
+	}
+}
+
+// Flush passes distributions collected since the previous flush to Recorder.
+func (c *Collector) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	distributions := c.distributions
+	c.distributions = nil
+	c.mu.Unlock()
+
+	if len(distributions) == 0 {
+		return nil
+	}
+
+	return c.Recorder.Record(ctx, distributions)
+}
+
+// Run flushes collected distributions every interval until ctx is done.
+func (c *Collector) Run(ctx context.Context, interval time.Duration, handleErr func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil {
+				handleErr(err)
+			}
+		}
+	}
+}
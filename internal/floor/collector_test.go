@@ -0,0 +1,126 @@
+package floor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+)
+
+func TestCollector(t *testing.T) {
+	var recorded map[Key]Distribution
+	recorder := &RecorderMock{
+		RecordFunc: func(_ context.Context, distributions map[Key]Distribution) error {
+			recorded = distributions
+			return nil
+		},
+	}
+	collector := &Collector{Recorder: recorder}
+
+	auctionEvent := func(eventType, auctionID string, price float64) *event.AdEvent {
+		return &event.AdEvent{
+			EventType:              eventType,
+			AppID:                  1,
+			AdType:                 string(ad.BannerType),
+			AuctionID:              auctionID,
+			AuctionConfigurationID: 10,
+			ECPM:                   price,
+			CountryCode:            "US",
+			SegmentID:              "5",
+		}
+	}
+
+	events := []event.Event{
+		auctionEvent("bid_request", "a1", 0),
+		auctionEvent("bid", "a1", 0.5),
+		auctionEvent("bid", "a1", 1.2),
+		auctionEvent("auction_request", "a1", 0),
+		auctionEvent("auction_request", "a2", 0),
+		&event.AdEvent{EventType: "auction_request", AuctionID: "a3"},
+		&event.NotificationEvent{EventType: "win", AuctionID: "a1"},
+	}
+	for _, e := range events {
+		collector.Observe(e)
+	}
+
+	if err := collector.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() = %v, want nil", err)
+	}
+
+	wantDistribution := Distribution{Auctions: 2}
+	wantDistribution.Buckets[8] = 1
+	want := map[Key]Distribution{
+		{AppID: 1, AdType: ad.BannerType, Country: "US", AuctionConfigurationID: 10}: wantDistribution,
+	}
+	if diff := cmp.Diff(want, recorded); diff != "" {
+		t.Errorf("recorded distributions mismatch (-want +got):\n%s", diff)
+	}
+	if len(collector.highestBids) != 0 {
+		t.Errorf("highestBids = %v, want empty", collector.highestBids)
+	}
+
+	if err := collector.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() = %v, want nil", err)
+	}
+	if len(recorder.RecordCalls()) != 1 {
+		t.Errorf("Record() called %d times, want %d", len(recorder.RecordCalls()), 1)
+	}
+}
+
+func TestCollector_RecommendationFollowsLowerBids(t *testing.T) {
+	var recorded map[Key]Distribution
+	collector := &Collector{
+		Recorder: &RecorderMock{
+			RecordFunc: func(_ context.Context, distributions map[Key]Distribution) error {
+				recorded = distributions
+				return nil
+			},
+		},
+	}
+	key := Key{AppID: 1, AdType: ad.InterstitialType, Country: "US", AuctionConfigurationID: 10}
+
+	// collect observes auctions with bids and auctions without bids for one flush period
+	// and returns the recommendation for the collected distribution.
+	collect := func(bidPrice float64, withBids, withoutBids int) Recommendation {
+		t.Helper()
+
+		for i := range withBids + withoutBids {
+			auctionID := fmt.Sprintf("auction-%d", i)
+			if i < withBids {
+				collector.Observe(&event.AdEvent{EventType: "bid", AuctionID: auctionID, ECPM: bidPrice})
+			}
+			collector.Observe(&event.AdEvent{
+				EventType:              "auction_request",
+				AppID:                  key.AppID,
+				AdType:                 string(key.AdType),
+				AuctionID:              auctionID,
+				AuctionConfigurationID: key.AuctionConfigurationID,
+				CountryCode:            key.Country,
+			})
+		}
+
+		if err := collector.Flush(context.Background()); err != nil {
+			t.Fatalf("Flush() = %v, want nil", err)
+		}
+
+		return Recommend(recorded[key], DefaultTargetFillRate)
+	}
+
+	if got := collect(1.2, 200, 0); got.Floor != 1 {
+		t.Fatalf("Recommend().Floor with bids at 1.2 = %v, want %v", got.Floor, 1)
+	}
+
+	// Bids shift to 0.4: they are below the applied floor of 1 and never observed, except for a few bids still above it.
+	if got := collect(1.2, 120, 280); got.Floor != 0 {
+		t.Fatalf("Recommend().Floor after bids shifted below floor = %v, want %v", got.Floor, 0)
+	}
+
+	// Without a floor, lower bids are observed again and the floor settles at the new bid level.
+	if got := collect(0.4, 200, 0); got.Floor != 0.3 {
+		t.Fatalf("Recommend().Floor with bids at 0.4 = %v, want %v", got.Floor, 0.3)
+	}
+}
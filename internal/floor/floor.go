@@ -0,0 +1,146 @@
+// Package floor maintains distributions of auction bid prices collected from auction events
+// and recommends price floors based on them.
+package floor
+
+import (
+	"fmt"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+)
+
+// Key identifies a bid price distribution.
+//
+// Distributions are kept per auction configuration that served the auctions, so the segment of the configuration
+// is the segment of the distribution. Requests of segments without a configuration of their own are served by
+// the configuration without segment and share its distribution, the same one its recommended floor is derived from.
+type Key struct {
+	AppID                  int64
+	AdType                 ad.Type
+	Country                string
+	AuctionConfigurationID int64
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%d:%s:%s:%d", k.AppID, k.AdType, k.Country, k.AuctionConfigurationID)
+}
+
+// BucketBounds are lower bounds of Distribution buckets, in USD CPM.
+// Prices below the first bound are treated as no bid.
+var BucketBounds = [...]float64{0.01, 0.02, 0.05, 0.1, 0.2, 0.3, 0.5, 0.75, 1, 1.5, 2, 3, 5, 7.5, 10, 15, 20, 30, 50, 100}
+
+// Distribution is a histogram of the highest bid prices of auctions.
+//
+// Bids below the price floor applied at that time are not observed, so the distribution is truncated
+// at the floors used while it was collected. Such auctions are still counted in Auctions as auctions without bids,
+// so a floor that is too high for the current bids lowers the fill rate and the recommended floor.
+type Distribution struct {
+	// Auctions is the number of observed auctions, including auctions without bids.
+	Auctions int64 `json:"auctions"`
+	// Buckets[i] is the number of auctions with the highest bid in [BucketBounds[i], BucketBounds[i+1]).
+	Buckets [len(BucketBounds)]int64 `json:"buckets"`
+}
+
+// Add records an auction with the highest bid price. Price is 0 for auctions without bids.
+func (d *Distribution) Add(price float64) {
+	d.Auctions++
+
+	if i := bucket(price); i >= 0 {
+		d.Buckets[i]++
+	}
+}
+
+// Merge adds observations of other distribution to d.
+func (d *Distribution) Merge(other Distribution) {
+	d.Auctions += other.Auctions
+	for i, count := range other.Buckets {
+		d.Buckets[i] += count
+	}
+}
+
+// Bids returns the number of auctions with bids.
+func (d *Distribution) Bids() int64 {
+	var bids int64
+	for _, count := range d.Buckets {
+		bids += count
+	}
+
+	return bids
+}
+
+func bucket(price float64) int {
+	for i := len(BucketBounds) - 1; i >= 0; i-- {
+		if price >= BucketBounds[i] {
+			return i
+		}
+	}
+
+	return -1
+}
+
+const (
+	// DefaultTargetFillRate is used when auction configuration does not specify a target fill rate.
+	DefaultTargetFillRate = 0.9
+	// MinBids is the number of auctions with bids needed before any floor is recommended.
+	MinBids = 100
+)
+
+// Recommendation is a recommended price floor together with the data it was derived from.
+type Recommendation struct {
+	Floor float64 `json:"floor"`
+	// TargetFillRate is the share of auctions that should remain filled with recommended floor.
+	TargetFillRate float64 `json:"target_fill_rate"`
+	// ExpectedFillRate is the share of auctions that would remain filled with recommended floor.
+	// It is less than TargetFillRate when no floor keeps the target fill rate.
+	ExpectedFillRate float64      `json:"expected_fill_rate"`
+	Auctions         int64        `json:"auctions"`
+	Bids             int64        `json:"bids"`
+	Buckets          []BucketStat `json:"buckets"`
+}
+
+// BucketStat is the number of auctions with the highest bid of at least MinPrice
+// and less than MinPrice of the next bucket.
+type BucketStat struct {
+	MinPrice float64 `json:"min_price"`
+	Auctions int64   `json:"auctions"`
+}
+
+// Recommend returns the highest bucket bound that keeps at least targetFillRate of all observed auctions filled.
+// Fill rate is counted against all auctions, including auctions without bids, because bids below the floor
+// are never observed. Floor is 0 until MinBids auctions with bids are observed, and when even the lowest bound
+// doesn't keep the target fill rate.
+func Recommend(d Distribution, targetFillRate float64) Recommendation {
+	if targetFillRate <= 0 || targetFillRate > 1 {
+		targetFillRate = DefaultTargetFillRate
+	}
+
+	rec := Recommendation{
+		TargetFillRate:   targetFillRate,
+		ExpectedFillRate: 1,
+		Auctions:         d.Auctions,
+		Bids:             d.Bids(),
+		Buckets:          make([]BucketStat, len(BucketBounds)),
+	}
+	for i, bound := range BucketBounds {
+		rec.Buckets[i] = BucketStat{MinPrice: bound, Auctions: d.Buckets[i]}
+	}
+
+	if rec.Bids < MinBids {
+		return rec
+	}
+
+	var filled int64
+	for i := len(d.Buckets) - 1; i >= 0; i-- {
+		filled += d.Buckets[i]
+
+		fillRate := float64(filled) / float64(d.Auctions)
+		if fillRate >= targetFillRate {
+			rec.Floor = BucketBounds[i]
+			rec.ExpectedFillRate = fillRate
+			return rec
+		}
+	}
+
+	rec.ExpectedFillRate = float64(rec.Bids) / float64(d.Auctions)
+
+	return rec
+}
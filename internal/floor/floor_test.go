@@ -0,0 +1,108 @@
+package floor
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDistribution_Add(t *testing.T) {
+	var d Distribution
+	d.Add(0)
+	d.Add(0.005)
+	d.Add(0.01)
+	d.Add(0.7)
+	d.Add(250)
+
+	want := Distribution{Auctions: 5}
+	want.Buckets[0] = 1
+	want.Buckets[6] = 1
+	want.Buckets[len(BucketBounds)-1] = 1
+
+	if diff := cmp.Diff(want, d); diff != "" {
+		t.Errorf("Distribution mismatch (-want +got):\n%s", diff)
+	}
+	if got := d.Bids(); got != 3 {
+		t.Errorf("Bids() = %d, want %d", got, 3)
+	}
+}
+
+func TestRecommend(t *testing.T) {
+	distribution := func(auctions int64, bids map[int]int64) Distribution {
+		d := Distribution{Auctions: auctions}
+		for i, count := range bids {
+			d.Buckets[i] = count
+		}
+		return d
+	}
+
+	tests := []struct {
+		name             string
+		distribution     Distribution
+		targetFillRate   float64
+		wantFloor        float64
+		wantExpectedFill float64
+	}{
+		{
+			name:             "not enough bids",
+			distribution:     distribution(500, map[int]int64{8: 99}),
+			targetFillRate:   0.9,
+			wantFloor:        0,
+			wantExpectedFill: 1,
+		},
+		{
+			name:             "floor keeps target fill rate",
+			distribution:     distribution(100, map[int]int64{2: 10, 5: 40, 8: 50}),
+			targetFillRate:   0.9,
+			wantFloor:        0.3,
+			wantExpectedFill: 0.9,
+		},
+		{
+			name:             "lower target fill rate gives higher floor",
+			distribution:     distribution(100, map[int]int64{2: 10, 5: 40, 8: 50}),
+			targetFillRate:   0.5,
+			wantFloor:        1,
+			wantExpectedFill: 0.5,
+		},
+		{
+			name:             "invalid target fill rate falls back to default",
+			distribution:     distribution(100, map[int]int64{2: 10, 5: 40, 8: 50}),
+			targetFillRate:   0,
+			wantFloor:        0.3,
+			wantExpectedFill: 0.9,
+		},
+		{
+			name:             "auctions without bids count against fill rate",
+			distribution:     distribution(200, map[int]int64{2: 10, 5: 40, 8: 50}),
+			targetFillRate:   0.5,
+			wantFloor:        0.05,
+			wantExpectedFill: 0.5,
+		},
+		{
+			name:             "no floor keeps target fill rate",
+			distribution:     distribution(1000, map[int]int64{2: 10, 5: 40, 8: 50}),
+			targetFillRate:   0.9,
+			wantFloor:        0,
+			wantExpectedFill: 0.1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Recommend(tt.distribution, tt.targetFillRate)
+
+			if got.Floor != tt.wantFloor {
+				t.Errorf("Recommend().Floor = %v, want %v", got.Floor, tt.wantFloor)
+			}
+			if got.ExpectedFillRate != tt.wantExpectedFill {
+				t.Errorf("Recommend().ExpectedFillRate = %v, want %v", got.ExpectedFillRate, tt.wantExpectedFill)
+			}
+			if got.Bids != tt.distribution.Bids() || got.Auctions != tt.distribution.Auctions {
+				t.Errorf("Recommend() auctions, bids = %v, %v; want %v, %v", got.Auctions, got.Bids, tt.distribution.Auctions, tt.distribution.Bids())
+			}
+			if len(got.Buckets) != len(BucketBounds) {
+				t.Errorf("len(Recommend().Buckets) = %v, want %v", len(got.Buckets), len(BucketBounds))
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package floor
+
+import (
+	"context"
+	"fmt"
+)
+
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out optimizer_mocks_test.go . DistributionFetcher
+
+type DistributionFetcher interface {
+	FetchCached(ctx context.Context, key Key) (Distribution, error)
+}
+
+// Optimizer recommends price floors applied by auction service at request time.
+type Optimizer struct {
+	Distributions DistributionFetcher
+}
+
+func (o *Optimizer) Recommend(ctx context.Context, key Key, targetFillRate float64) (Recommendation, error) {
+	d, err := o.Distributions.FetchCached(ctx, key)
+	if err != nil {
+		return Recommendation{}, fmt.Errorf("fetch %v distribution: %v", key, err)
+	}
+
+	return Recommend(d, targetFillRate), nil
+}
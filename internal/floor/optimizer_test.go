@@ -0,0 +1,39 @@
+package floor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+)
+
+func TestOptimizer_Recommend(t *testing.T) {
+	key := Key{AppID: 1, AdType: ad.InterstitialType, Country: "US"}
+
+	d := Distribution{Auctions: 180}
+	d.Buckets[8] = 150
+
+	fetcher := &DistributionFetcherMock{
+		FetchCachedFunc: func(_ context.Context, k Key) (Distribution, error) {
+			if k != key {
+				return Distribution{}, errors.New("unexpected key")
+			}
+			return d, nil
+		},
+	}
+	optimizer := &Optimizer{Distributions: fetcher}
+
+	got, err := optimizer.Recommend(context.Background(), key, 0.8)
+	if err != nil {
+		t.Fatalf("Recommend() = %v, want nil", err)
+	}
+	if got.Floor != 1 {
+		t.Errorf("Recommend().Floor = %v, want %v", got.Floor, 1)
+	}
+
+	_, err = optimizer.Recommend(context.Background(), Key{AppID: 2}, 0.8)
+	if err == nil {
+		t.Errorf("Recommend() = nil, want error")
+	}
+}
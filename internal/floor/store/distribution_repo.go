@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/bidon-io/bidon-backend/internal/floor"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
+)
+
+// Window is the number of most recent days distributions are built from.
+const Window = 7
+
+const auctionsField = "auctions"
+
+type cache[T any] interface {
+	Get(context.Context, []byte, func(ctx context.Context) (T, error)) (T, error)
+}
+
+// DistributionRepo keeps daily bid price distributions in Redis hashes, one hash per key and day.
+// Hash fields are auctions count and bucket indexes.
+type DistributionRepo struct {
+	Redis *redis.ClusterClient
+	Clock clock.Clock
+	Cache cache[floor.Distribution]
+}
+
+// Record adds distributions to the current day ones.
+func (r *DistributionRepo) Record(ctx context.Context, distributions map[floor.Key]floor.Distribution) error {
+	day := r.Clock.Now().UTC()
+
+	_, err := r.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, d := range distributions {
+			redisKey := dayKey(key, day)
+
+			pipe.HIncrBy(ctx, redisKey, auctionsField, d.Auctions)
+			for i, count := range d.Buckets {
+				if count > 0 {
+					pipe.HIncrBy(ctx, redisKey, strconv.Itoa(i), count)
+				}
+			}
+			pipe.Expire(ctx, redisKey, (Window+1)*24*time.Hour)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("record floor distributions: %v", err)
+	}
+
+	return nil
+}
+
+func (r *DistributionRepo) FetchCached(ctx context.Context, key floor.Key) (floor.Distribution, error) {
+	cacheKey := []byte("floor_distributions:" + key.String())
+
+	return r.Cache.Get(ctx, cacheKey, func(ctx context.Context) (floor.Distribution, error) {
+		return r.Fetch(ctx, key)
+	})
+}
+
+// Fetch merges distributions of the last Window days.
+func (r *DistributionRepo) Fetch(ctx context.Context, key floor.Key) (floor.Distribution, error) {
+	today := r.Clock.Now().UTC()
+
+	cmds := make([]*redis.MapStringStringCmd, Window)
+	_, err := r.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i := range cmds {
+			cmds[i] = pipe.HGetAll(ctx, dayKey(key, today.AddDate(0, 0, -i)))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return floor.Distribution{}, fmt.Errorf("fetch floor distribution: %v", err)
+	}
+
+	var d floor.Distribution
+	for _, cmd := range cmds {
+		for field, value := range cmd.Val() {
+			count, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return floor.Distribution{}, fmt.Errorf("parse %q field of floor distribution: %v", field, err)
+			}
+
+			if field == auctionsField {
+				d.Auctions += count
+				continue
+			}
+
+			i, err := strconv.Atoi(field)
+			if err != nil || i < 0 || i >= len(d.Buckets) {
+				// Bucket bounds changed since the data was recorded.
+				continue
+			}
+			d.Buckets[i] += count
+		}
+	}
+
+	return d, nil
+}
+
+// dayKey uses hash tag so that all days of a key are stored in the same cluster slot.
+func dayKey(key floor.Key, day time.Time) string {
+	return fmt.Sprintf("floor_distribution:{%s}:%s", key, day.Format("20060102"))
+}
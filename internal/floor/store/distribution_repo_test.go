@@ -0,0 +1,69 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/floor"
+	"github.com/bidon-io/bidon-backend/internal/floor/store"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
+)
+
+func TestDistributionRepo_Record(t *testing.T) {
+	redisClient, mock := redismock.NewClusterMock()
+	mockTime := clock.NewMock()
+	mockTime.Set(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	repo := &store.DistributionRepo{Redis: redisClient, Clock: mockTime}
+
+	key := floor.Key{AppID: 1, AdType: ad.BannerType, Country: "US", AuctionConfigurationID: 5}
+	d := floor.Distribution{Auctions: 10}
+	d.Buckets[3] = 4
+
+	redisKey := "floor_distribution:{1:banner:US:5}:20261017"
+	mock.ExpectHIncrBy(redisKey, "auctions", 10).SetVal(10)
+	mock.ExpectHIncrBy(redisKey, "3", 4).SetVal(4)
+	mock.ExpectExpire(redisKey, 8*24*time.Hour).SetVal(true)
+
+	err := repo.Record(context.Background(), map[floor.Key]floor.Distribution{key: d})
+	if err != nil {
+		t.Fatalf("Record() = %v, want nil", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDistributionRepo_Fetch(t *testing.T) {
+	redisClient, mock := redismock.NewClusterMock()
+	mockTime := clock.NewMock()
+	mockTime.Set(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	repo := &store.DistributionRepo{Redis: redisClient, Clock: mockTime}
+
+	key := floor.Key{AppID: 1, AdType: ad.BannerType, Country: "US", AuctionConfigurationID: 5}
+
+	mock.ExpectHGetAll("floor_distribution:{1:banner:US:5}:20261017").SetVal(map[string]string{"auctions": "10", "3": "4"})
+	mock.ExpectHGetAll("floor_distribution:{1:banner:US:5}:20261016").SetVal(map[string]string{"auctions": "5", "3": "1", "8": "2", "99": "1"})
+	for _, day := range []string{"20261015", "20261014", "20261013", "20261012", "20261011"} {
+		mock.ExpectHGetAll("floor_distribution:{1:banner:US:5}:" + day).SetVal(map[string]string{})
+	}
+
+	got, err := repo.Fetch(context.Background(), key)
+	if err != nil {
+		t.Fatalf("Fetch() = %v, want nil", err)
+	}
+
+	want := floor.Distribution{Auctions: 15}
+	want.Buckets[3] = 5
+	want.Buckets[8] = 2
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Fetch() mismatch (-want +got):\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -2,10 +2,19 @@ package notification
 
 import (
 	"encoding/json"
+	"slices"
+
+	"github.com/prebid/openrtb/v19/openrtb3"
 
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 )
 
+// States of a bid notification kept in AuctionResultRepo.
+const (
+	NotificationPending = "PENDING"
+	NotificationSent    = "SENT"
+)
+
 type AuctionResult struct {
 	AuctionID string `json:"auction_id"`
 	Bids      []Bid  `json:"bids"`
@@ -24,6 +33,61 @@ type Bid struct {
 	RequestID string      `json:"request_id"`
 }
 
+// Outcome is the notification decided for a bid when auction is finalized: NURL for the winner, LURL for the rest.
+type Outcome struct {
+	Bid              Bid
+	NotificationType string
+	Reason           openrtb3.LossReason
+}
+
+// Finalization is the result of recording auction outcomes.
+type Finalization struct {
+	// Pending outcomes are recorded by this finalization, their notifications are to be sent.
+	Pending []Outcome
+	// Conflicts are outcomes that contradict ones recorded earlier, e.g. win after loss. Recorded outcomes are kept.
+	Conflicts []Conflict
+}
+
+type Conflict struct {
+	Outcome Outcome
+	// Recorded is notification type recorded for the bid earlier.
+	Recorded string
+}
+
+// WinnerRef is how SDK identifies the winning bid. BidID is returned to SDK in ext.bid_id of the auction response,
+// SDKs that don't send it back are only able to identify the winner by price.
+type WinnerRef struct {
+	DemandID string
+	BidID    string
+	Price    float64
+}
+
+// FindWinner returns index of the bid referenced by ref or -1 if none of the bids won.
+// Bids are matched by demand and bid ID. If ref has no bid ID, the first bid with the same price is chosen,
+// bids of the referenced demand take precedence.
+func (a *AuctionResult) FindWinner(ref WinnerRef) int {
+	if ref.BidID != "" {
+		return slices.IndexFunc(a.Bids, func(bid Bid) bool {
+			return bid.ID == ref.BidID && (ref.DemandID == "" || string(bid.DemandID) == ref.DemandID)
+		})
+	}
+
+	winner := -1
+	for i, bid := range a.Bids {
+		if bid.Price != ref.Price {
+			continue
+		}
+		if string(bid.DemandID) == ref.DemandID {
+			return i
+		}
+		if winner == -1 {
+			winner = i
+		}
+	}
+
+	return winner
+}
+
 func (a *AuctionResult) MarshalBinary() ([]byte, error) {
 	return json.Marshal(a)
 }
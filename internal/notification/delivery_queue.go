@@ -0,0 +1,255 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
+)
+
+const (
+	DefaultDeliveryWorkers     = 8
+	DefaultDeliveryMaxAttempts = 5
+	DefaultDeliveryBackoff     = 10 * time.Second
+	maxDeliveryBackoff         = 10 * time.Minute
+	promoteInterval            = time.Second
+)
+
+// Delivery is a notification waiting in the queue to be sent to demand.
+type Delivery struct {
+	// ID is assigned by DeliveryStore when the delivery is read from the queue.
+	ID       string `json:"id,omitempty"`
+	Params   Params `json:"params"`
+	Attempts int    `json:"attempts"`
+}
+
+// DeliveryStore persists deliveries between attempts, so that they survive restarts and cancelled requests.
+type DeliveryStore interface {
+	// Setup prepares the store for reading, it's safe to call it from every process.
+	Setup(ctx context.Context) error
+	Enqueue(ctx context.Context, d Delivery) error
+	// Read returns deliveries ready to be sent, including ones abandoned by crashed consumers.
+	Read(ctx context.Context, consumer string, count int) ([]Delivery, error)
+	Ack(ctx context.Context, d Delivery) error
+	// Retry removes delivery from the queue and puts it back at the given time.
+	Retry(ctx context.Context, d Delivery, at time.Time) error
+	// PromoteDue puts deliveries scheduled for retry before now back to the queue.
+	PromoteDue(ctx context.Context, now time.Time) error
+	// DeadLetter removes delivery from the queue and keeps it for manual inspection.
+	DeadLetter(ctx context.Context, d Delivery) error
+}
+
+type Deliverer interface {
+	Deliver(ctx context.Context, p Params) (string, error)
+}
+
+// DeliveryQueue is a Sender that enqueues notifications to DeliveryStore instead of sending them right away.
+// Run starts workers that send queued notifications, retry failed ones with exponential backoff
+// and dead-letter them after MaxAttempts. Every final outcome is logged as notification event.
+type DeliveryQueue struct {
+	Store       DeliveryStore
+	Deliverer   Deliverer
+	EventLogger *event.Logger
+	Clock       clock.Clock
+
+	// Consumer identifies this process in the queue, e.g. hostname.
+	Consumer    string
+	Workers     int
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled for every next one.
+	Backoff time.Duration
+	// RateLimits limit requests per second to a demand. DefaultRateLimit is used for demands not in the map,
+	// zero DefaultRateLimit means no limit. Throttled deliveries are put back to the queue until the limit allows them.
+	RateLimits       map[adapter.Key]rate.Limit
+	DefaultRateLimit rate.Limit
+
+	limitersMu sync.Mutex
+	limiters   map[adapter.Key]*rate.Limiter
+}
+
+// SendEvent enqueues notification. Request context is only used for its values, so that cancelled request doesn't lose the notification.
+// If the queue is unavailable, notification is sent right away.
+func (q *DeliveryQueue) SendEvent(ctx context.Context, p Params) {
+	if p.URL == "" {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	if err := q.Store.Enqueue(ctx, Delivery{Params: p}); err != nil {
+		log.Printf("DeliveryQueue: enqueue %s notification of %s: %v, sending directly", p.NotificationType, p.Bid.DemandID, err)
+		go q.process(ctx, Delivery{Params: p}, false)
+	}
+}
+
+// Run processes queued notifications until ctx is done.
+func (q *DeliveryQueue) Run(ctx context.Context, handleErr func(error)) {
+	if err := q.Store.Setup(ctx); err != nil {
+		handleErr(fmt.Errorf("setup delivery queue: %v", err))
+		return
+	}
+
+	workers := q.Workers
+	if workers <= 0 {
+		workers = DefaultDeliveryWorkers
+	}
+
+	wg := sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx, handleErr)
+		}()
+	}
+
+	ticker := time.NewTicker(promoteInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case <-ticker.C:
+			if err := q.Store.PromoteDue(ctx, q.Clock.Now()); err != nil {
+				handleErr(fmt.Errorf("promote due notifications: %v", err))
+			}
+		}
+	}
+}
+
+func (q *DeliveryQueue) work(ctx context.Context, handleErr func(error)) {
+	for ctx.Err() == nil {
+		deliveries, err := q.Store.Read(ctx, q.Consumer, 1)
+		if err != nil {
+			if ctx.Err() == nil {
+				handleErr(fmt.Errorf("read notifications: %v", err))
+				time.Sleep(promoteInterval)
+			}
+			continue
+		}
+
+		for _, d := range deliveries {
+			q.process(ctx, d, true)
+		}
+	}
+}
+
+// process makes a delivery attempt. Failed deliveries of the queue are retried or dead-lettered,
+// deliveries that couldn't be enqueued have the only attempt.
+func (q *DeliveryQueue) process(ctx context.Context, d Delivery, queued bool) {
+	limiter := q.limiter(d.Params.Bid.DemandID)
+	if !queued {
+		// Delivery has its own goroutine, waiting doesn't hold up other demands.
+		if err := limiter.Wait(ctx); err != nil {
+			return
+		}
+	} else if !q.allow(ctx, limiter, d) {
+		return
+	}
+
+	d.Attempts++
+	u, err := q.Deliverer.Deliver(ctx, d.Params)
+	if !queued {
+		status := "DELIVERED"
+		if err != nil {
+			status = "FAILED"
+		}
+		logNotificationEvent(q.EventLogger, d.Params, u, status, d.Attempts, err)
+		return
+	}
+
+	switch {
+	case err == nil:
+		if err := q.Store.Ack(ctx, d); err != nil {
+			log.Printf("DeliveryQueue: ack notification %s: %v", d.ID, err)
+		}
+		logNotificationEvent(q.EventLogger, d.Params, u, "DELIVERED", d.Attempts, nil)
+	case errors.Is(err, ErrInvalidURL) || d.Attempts >= q.maxAttempts():
+		if err := q.Store.DeadLetter(ctx, d); err != nil {
+			log.Printf("DeliveryQueue: dead-letter notification %s: %v", d.ID, err)
+		}
+		logNotificationEvent(q.EventLogger, d.Params, u, "DEAD_LETTERED", d.Attempts, err)
+	default:
+		if err := q.Store.Retry(ctx, d, q.Clock.Now().Add(q.backoff(d.Attempts))); err != nil {
+			log.Printf("DeliveryQueue: retry notification %s: %v", d.ID, err)
+		}
+	}
+}
+
+// allow reports whether queued delivery can be sent now under the rate limit of its demand.
+// Otherwise, delivery is put back to the queue for the time the limit allows it, without spending an attempt,
+// so that the worker is free to send notifications of other demands meanwhile.
+func (q *DeliveryQueue) allow(ctx context.Context, limiter *rate.Limiter, d Delivery) bool {
+	now := q.Clock.Now()
+	r := limiter.ReserveN(now, 1)
+	delay := r.DelayFrom(now)
+	if delay == 0 {
+		return true
+	}
+	r.CancelAt(now)
+
+	if err := q.Store.Retry(ctx, d, now.Add(delay)); err != nil {
+		log.Printf("DeliveryQueue: delay throttled notification %s: %v", d.ID, err)
+	}
+
+	return false
+}
+
+func (q *DeliveryQueue) limiter(demandID adapter.Key) *rate.Limiter {
+	q.limitersMu.Lock()
+	defer q.limitersMu.Unlock()
+
+	if l, ok := q.limiters[demandID]; ok {
+		return l
+	}
+
+	limit, ok := q.RateLimits[demandID]
+	if !ok {
+		limit = q.DefaultRateLimit
+	}
+	burst := 1
+	if limit <= 0 {
+		limit = rate.Inf
+	} else if limit > 1 {
+		burst = int(limit)
+	}
+
+	l := rate.NewLimiter(limit, burst)
+	if q.limiters == nil {
+		q.limiters = make(map[adapter.Key]*rate.Limiter)
+	}
+	q.limiters[demandID] = l
+
+	return l
+}
+
+func (q *DeliveryQueue) maxAttempts() int {
+	if q.MaxAttempts > 0 {
+		return q.MaxAttempts
+	}
+
+	return DefaultDeliveryMaxAttempts
+}
+
+// backoff returns delay before the next attempt after the given number of attempts.
+func (q *DeliveryQueue) backoff(attempts int) time.Duration {
+	delay := q.Backoff
+	if delay <= 0 {
+		delay = DefaultDeliveryBackoff
+	}
+
+	for i := 1; i < attempts && delay < maxDeliveryBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDeliveryBackoff)
+}
@@ -0,0 +1,233 @@
+package notification_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/notification"
+	"github.com/bidon-io/bidon-backend/internal/notification/mocks"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event/engine"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
+)
+
+func TestDeliveryQueue_SendEvent(t *testing.T) {
+	store := &mocks.DeliveryStoreMock{
+		EnqueueFunc: func(ctx context.Context, _ notification.Delivery) error {
+			if ctx.Err() != nil {
+				t.Errorf("Enqueue() called with done context: %v", ctx.Err())
+			}
+			return nil
+		},
+	}
+	queue := &notification.DeliveryQueue{Store: store}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := notification.Params{NotificationType: "NURL", URL: "https://dsp.com/win", Bid: notification.Bid{DemandID: adapter.MetaKey}}
+	queue.SendEvent(ctx, p)
+	queue.SendEvent(ctx, notification.Params{NotificationType: "LURL"})
+
+	want := []notification.Delivery{{Params: p}}
+	var got []notification.Delivery
+	for _, call := range store.EnqueueCalls() {
+		got = append(got, call.D)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Enqueue() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeliveryQueue_Run(t *testing.T) {
+	mockTime := clock.NewMock()
+	mockTime.Set(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	p := notification.Params{NotificationType: "BURL", URL: "https://dsp.com/billing", Bid: notification.Bid{DemandID: adapter.VungleKey}}
+
+	tests := []struct {
+		name       string
+		delivery   notification.Delivery
+		deliverErr error
+		wantAcks   int
+		wantRetry  []time.Time
+		wantDead   int
+		wantEvents []string
+	}{
+		{
+			name:       "delivered",
+			delivery:   notification.Delivery{ID: "1-0", Params: p},
+			wantAcks:   1,
+			wantEvents: []string{"DELIVERED"},
+		},
+		{
+			name:       "failed, retried with backoff",
+			delivery:   notification.Delivery{ID: "1-0", Params: p, Attempts: 1},
+			deliverErr: errors.New("unexpected status code: 503"),
+			wantRetry:  []time.Time{mockTime.Now().Add(20 * time.Second)},
+		},
+		{
+			name:       "failed, out of attempts",
+			delivery:   notification.Delivery{ID: "1-0", Params: p, Attempts: 2},
+			deliverErr: errors.New("unexpected status code: 503"),
+			wantDead:   1,
+			wantEvents: []string{"DEAD_LETTERED"},
+		},
+		{
+			name:       "invalid url",
+			delivery:   notification.Delivery{ID: "1-0", Params: p},
+			deliverErr: notification.ErrInvalidURL,
+			wantDead:   1,
+			wantEvents: []string{"DEAD_LETTERED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var once sync.Once
+			store := &mocks.DeliveryStoreMock{
+				SetupFunc: func(context.Context) error { return nil },
+				ReadFunc: func(context.Context, string, int) ([]notification.Delivery, error) {
+					deliveries := []notification.Delivery{}
+					once.Do(func() { deliveries = append(deliveries, tt.delivery) })
+					if len(deliveries) == 0 {
+						cancel()
+					}
+					return deliveries, nil
+				},
+				AckFunc:        func(context.Context, notification.Delivery) error { return nil },
+				RetryFunc:      func(context.Context, notification.Delivery, time.Time) error { return nil },
+				DeadLetterFunc: func(context.Context, notification.Delivery) error { return nil },
+				PromoteDueFunc: func(context.Context, time.Time) error { return nil },
+			}
+			deliverer := &mocks.DelivererMock{
+				DeliverFunc: func(_ context.Context, p notification.Params) (string, error) {
+					return p.URL, tt.deliverErr
+				},
+			}
+			events := &notificationEventRecorder{}
+			queue := &notification.DeliveryQueue{
+				Store:       store,
+				Deliverer:   deliverer,
+				EventLogger: &event.Logger{Engine: &engine.Log{}, Observers: []event.Observer{events}},
+				Clock:       mockTime,
+				Workers:     1,
+				MaxAttempts: 3,
+			}
+
+			queue.Run(ctx, func(err error) { t.Errorf("Run() error: %v", err) })
+
+			if got := len(store.AckCalls()); got != tt.wantAcks {
+				t.Errorf("Ack() calls = %d, want %d", got, tt.wantAcks)
+			}
+			var gotRetry []time.Time
+			for _, call := range store.RetryCalls() {
+				gotRetry = append(gotRetry, call.At)
+			}
+			if diff := cmp.Diff(tt.wantRetry, gotRetry); diff != "" {
+				t.Errorf("Retry() mismatch (-want +got):\n%s", diff)
+			}
+			if got := len(store.DeadLetterCalls()); got != tt.wantDead {
+				t.Errorf("DeadLetter() calls = %d, want %d", got, tt.wantDead)
+			}
+			if diff := cmp.Diff(tt.wantEvents, events.statuses); diff != "" {
+				t.Errorf("events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeliveryQueue_Run_RateLimits(t *testing.T) {
+	mockTime := clock.NewMock()
+	mockTime.Set(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	meta := notification.Params{NotificationType: "NURL", URL: "https://meta.com/win", Bid: notification.Bid{DemandID: adapter.MetaKey}}
+	vungle := notification.Params{NotificationType: "NURL", URL: "https://vungle.com/win", Bid: notification.Bid{DemandID: adapter.VungleKey}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	queued := []notification.Delivery{
+		{ID: "1-0", Params: meta},
+		{ID: "2-0", Params: meta},
+		{ID: "3-0", Params: vungle},
+	}
+	var mu sync.Mutex
+	store := &mocks.DeliveryStoreMock{
+		SetupFunc: func(context.Context) error { return nil },
+		ReadFunc: func(context.Context, string, int) ([]notification.Delivery, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if len(queued) == 0 {
+				cancel()
+				return nil, nil
+			}
+			d := queued[0]
+			queued = queued[1:]
+			return []notification.Delivery{d}, nil
+		},
+		AckFunc:        func(context.Context, notification.Delivery) error { return nil },
+		RetryFunc:      func(context.Context, notification.Delivery, time.Time) error { return nil },
+		PromoteDueFunc: func(context.Context, time.Time) error { return nil },
+	}
+	deliverer := &mocks.DelivererMock{
+		DeliverFunc: func(_ context.Context, p notification.Params) (string, error) {
+			return p.URL, nil
+		},
+	}
+	queue := &notification.DeliveryQueue{
+		Store:       store,
+		Deliverer:   deliverer,
+		EventLogger: &event.Logger{Engine: &engine.Log{}},
+		Clock:       mockTime,
+		Workers:     1,
+		RateLimits:  map[adapter.Key]rate.Limit{adapter.MetaKey: 0.1},
+	}
+
+	queue.Run(ctx, func(err error) { t.Errorf("Run() error: %v", err) })
+
+	var delivered []string
+	for _, call := range deliverer.DeliverCalls() {
+		delivered = append(delivered, call.P.URL)
+	}
+	if diff := cmp.Diff([]string{meta.URL, vungle.URL}, delivered); diff != "" {
+		t.Errorf("Deliver() mismatch (-want +got):\n%s", diff)
+	}
+
+	wantRetry := []notification.Delivery{{ID: "2-0", Params: meta}}
+	var gotRetry []notification.Delivery
+	for _, call := range store.RetryCalls() {
+		gotRetry = append(gotRetry, call.D)
+		if want := mockTime.Now().Add(10 * time.Second); !call.At.Equal(want) {
+			t.Errorf("Retry() at = %v, want %v", call.At, want)
+		}
+	}
+	if diff := cmp.Diff(wantRetry, gotRetry); diff != "" {
+		t.Errorf("Retry() mismatch (-want +got):\n%s", diff)
+	}
+	if got := len(store.AckCalls()); got != 2 {
+		t.Errorf("Ack() calls = %d, want 2", got)
+	}
+}
+
+type notificationEventRecorder struct {
+	mu       sync.Mutex
+	statuses []string
+}
+
+func (r *notificationEventRecorder) Observe(e event.Event) {
+	if notificationEvent, ok := e.(*event.NotificationEvent); ok {
+		r.mu.Lock()
+		r.statuses = append(r.statuses, notificationEvent.Status)
+		r.mu.Unlock()
+	}
+}
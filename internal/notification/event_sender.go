@@ -2,11 +2,10 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
-	"net/url"
-	"strconv"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/prebid/openrtb/v19/openrtb3"
@@ -15,51 +14,89 @@ import (
 )
 
 type Params struct {
-	Bundle           string
-	AdType           string
-	AuctionID        string
-	NotificationType string
-	URL              string
-	Bid              Bid
-	Reason           openrtb3.LossReason
-	FirstPrice       float64
-	SecondPrice      float64
+	Bundle           string              `json:"bundle"`
+	AdType           string              `json:"ad_type"`
+	AuctionID        string              `json:"auction_id"`
+	NotificationType string              `json:"notification_type"`
+	URL              string              `json:"url"`
+	Bid              Bid                 `json:"bid"`
+	Reason           openrtb3.LossReason `json:"reason"`
+	FirstPrice       float64             `json:"first_price"`
+	SecondPrice      float64             `json:"second_price"`
 }
 
 type EventSender struct {
 	HttpClient  *http.Client
 	EventLogger *event.Logger
+	// Macros expands macros in notification URLs, standard macros are expanded if nil.
+	Macros *MacroExpander
 }
 
+// ErrInvalidURL is returned by Deliver if notification URL cannot be parsed. Such notifications are never retried.
+var ErrInvalidURL = errors.New("invalid notification url")
+
+// SendEvent sends notification in-process with a few retries and logs the outcome.
+// Request context is only used for its values, so that cancelled request doesn't cancel the notification.
 func (es EventSender) SendEvent(ctx context.Context, p Params) {
-	u, err := url.Parse(p.URL)
-	if p.URL == "" || err != nil {
-		log.Printf("SendNotificationEvent: failed to parse URL type %s: %s", p.NotificationType, p.URL)
+	ctx = context.WithoutCancel(ctx)
+	var u string
+	attempts := 0
+	err := backoff.Retry(func() error {
+		attempts++
+
+		var err error
+		u, err = es.Deliver(ctx, p)
+		if errors.Is(err, ErrInvalidURL) {
+			return backoff.Permanent(err)
+		}
+
+		return err
+	}, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3))
+	if errors.Is(err, ErrInvalidURL) {
+		log.Printf("SendNotificationEvent: %v", err)
 		return
 	}
-	macroses := macrosesMap(p.Bid, p.Reason, p.FirstPrice, p.SecondPrice)
-	params, err := url.ParseQuery(u.RawQuery)
+
+	status := "DELIVERED"
 	if err != nil {
-		log.Printf("SendNotificationEvent: failed to parse params: %s", u.RawQuery)
-		return
+		status = "FAILED"
 	}
-	for param := range params {
-		if val, ok := macroses[params.Get(param)]; ok {
-			params.Set(param, val)
-		}
+	logNotificationEvent(es.EventLogger, p, u, status, attempts, err)
+
+	if err != nil {
+		log.Printf("SendNotificationEvent: failed to send loss notification: %s -> %s", p.Bid.DemandID, p.URL)
 	}
-	u.RawQuery = params.Encode()
-	err = backoff.Retry(func() error {
-		httpResp, err := es.HttpClient.Get(u.String())
-		if err != nil {
-			log.Printf("SendNotificationEvent: send failed: %v", err)
-			return err
-		}
-		defer httpResp.Body.Close()
+}
 
-		return nil
-	}, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3))
+// Deliver makes a single attempt to send notification. It returns URL with expanded macros.
+// Server errors are reported as errors, so that notification can be retried.
+func (es EventSender) Deliver(ctx context.Context, p Params) (string, error) {
+	u, err := es.Macros.Expand(p)
+	if err != nil {
+		return "", err
+	}
 
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return u, fmt.Errorf("%w: %v", ErrInvalidURL, err)
+	}
+
+	httpResp, err := es.HttpClient.Do(req)
+	if err != nil {
+		log.Printf("SendNotificationEvent: send failed: %v", err)
+		return u, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode >= http.StatusInternalServerError {
+		return u, fmt.Errorf("unexpected status code: %d", httpResp.StatusCode)
+	}
+
+	return u, nil
+}
+
+// logNotificationEvent logs final outcome of notification delivery.
+func logNotificationEvent(logger *event.Logger, p Params, u, status string, attempts int, err error) {
 	e := event.NewNotificationEvent(event.NotificationParams{
 		EventType:   p.NotificationType,
 		ImpID:       p.Bid.ImpID,
@@ -71,31 +108,13 @@ func (es EventSender) SendEvent(ctx context.Context, p Params) {
 		Price:       p.Bid.Price,
 		FirstPrice:  p.FirstPrice,
 		SecondPrice: p.SecondPrice,
-		URL:         u.String(),
+		URL:         u,
 		TemplateURL: p.URL,
+		Status:      status,
+		Attempts:    int64(attempts),
 		Error:       err,
 	})
-	es.EventLogger.Log(e, func(err error) {
+	logger.Log(e, func(err error) {
 		log.Printf("SendNotificationEvent: log notification event: %v", err)
 	})
-
-	if err != nil {
-		log.Printf("SendNotificationEvent: failed to send loss notification: %s -> %s", p.Bid.DemandID, p.URL)
-	}
-}
-
-func macrosesMap(bid Bid, lossReason openrtb3.LossReason, firstPrice, secondPrice float64) map[string]string {
-	return map[string]string{
-		"${AUCTION_MIN_TO_WIN}":         strconv.FormatFloat(secondPrice, 'f', -1, 64),
-		"${AUCTION_MINIMUM_BID_TO_WIN}": strconv.FormatFloat(secondPrice, 'f', -1, 64),
-		"${MIN_BID_TO_WIN}":             strconv.FormatFloat(secondPrice, 'f', -1, 64),
-		"${AUCTION_ID}":                 bid.RequestID,
-		"${AUCTION_BID_ID}":             bid.ID,
-		"${AUCTION_IMP_ID}":             bid.ImpID,
-		"${AUCTION_SEAT_ID}":            bid.SeatID,
-		"${AUCTION_AD_ID}":              bid.AdID,
-		"${AUCTION_PRICE}":              strconv.FormatFloat(firstPrice, 'f', -1, 64),
-		"${AUCTION_LOSS}":               fmt.Sprintf("%d", lossReason),
-		"${AUCTION_CURRENCY}":           "USD",
-	}
 }
@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/prebid/openrtb/v19/openrtb3"
@@ -10,6 +11,8 @@ import (
 
 	"github.com/bidon-io/bidon-backend/internal/auction"
 	"github.com/bidon-io/bidon-backend/internal/bidding"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
 )
 
@@ -17,13 +20,21 @@ type Handler struct {
 	AuctionResultRepo AuctionResultRepo
 	Sender            Sender
 	ConfigFetcher     ConfigFetcher
+	// EventLogger logs anomalies such as win notification for a bid that has lost.
+	EventLogger *event.Logger
 }
 
-//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out mocks/mocks.go -pkg mocks . AuctionResultRepo Sender ConfigFetcher
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out mocks/mocks.go -pkg mocks . AuctionResultRepo Sender ConfigFetcher DeliveryStore Deliverer
 
 type AuctionResultRepo interface {
 	CreateOrUpdate(ctx context.Context, adObject *schema.AdObject, bids []Bid) error
 	Find(ctx context.Context, auctionID string) (*AuctionResult, error)
+	// FinalizeResult records outcomes of auction bids. Outcome of a bid is recorded once, so finalizing it again
+	// neither returns it as pending nor sends its notification twice.
+	FinalizeResult(ctx context.Context, auctionID string, outcomes []Outcome) (*Finalization, error)
+	// ClaimNotification marks notification of a bid as pending. Returns false if it was claimed before.
+	ClaimNotification(ctx context.Context, auctionID string, bid Bid, notificationType string) (bool, error)
+	MarkNotificationSent(ctx context.Context, auctionID string, bid Bid, notificationType string) error
 }
 
 type Sender interface {
@@ -57,18 +68,7 @@ func (h Handler) HandleBiddingRound(ctx context.Context, adObject *schema.AdObje
 
 			h.Sender.SendEvent(ctx, p)
 		} else if resp.IsBid() {
-			bid := Bid{
-				ID:        resp.Bid.ID,
-				ImpID:     resp.Bid.ImpID,
-				Price:     resp.Bid.Price,
-				DemandID:  resp.Bid.DemandID,
-				AdID:      resp.Bid.AdID,
-				SeatID:    resp.Bid.SeatID,
-				LURL:      resp.Bid.LURL,
-				NURL:      resp.Bid.NURL,
-				BURL:      resp.Bid.BURL,
-				RequestID: resp.RequestID,
-			}
+			bid := bidFromDemandResponse(resp)
 
 			if bid.Price >= bidFloor { // Valid Bid, use for further processing
 				bids = append(bids, bid)
@@ -95,6 +95,41 @@ func (h Handler) HandleBiddingRound(ctx context.Context, adObject *schema.AdObje
 	return nil
 }
 
+// HandleCachedBidLoss is used to send loss notification for a bid evicted from bid cache before it was shown
+func (h Handler) HandleCachedBidLoss(ctx context.Context, auctionID string, resp adapters.DemandResponse, reason openrtb3.LossReason, firstPrice, secondPrice float64, bundle, adType string) {
+	if !resp.IsBid() {
+		return
+	}
+
+	bid := bidFromDemandResponse(resp)
+	go h.Sender.SendEvent(ctx, Params{
+		Bundle:           bundle,
+		AdType:           adType,
+		AuctionID:        auctionID,
+		NotificationType: "LURL",
+		URL:              bid.LURL,
+		Bid:              bid,
+		Reason:           reason,
+		FirstPrice:       firstPrice,
+		SecondPrice:      secondPrice,
+	})
+}
+
+func bidFromDemandResponse(resp adapters.DemandResponse) Bid {
+	return Bid{
+		ID:        resp.Bid.ID,
+		ImpID:     resp.Bid.ImpID,
+		Price:     resp.Bid.Price,
+		DemandID:  resp.Bid.DemandID,
+		AdID:      resp.Bid.AdID,
+		SeatID:    resp.Bid.SeatID,
+		LURL:      resp.Bid.LURL,
+		NURL:      resp.Bid.NURL,
+		BURL:      resp.Bid.BURL,
+		RequestID: resp.RequestID,
+	}
+}
+
 // HandleStats is used to handle v2/stats request
 // Finalize results of auction in redis
 // If external_win_notification is enabled - do nothing, wait /win or /loss request
@@ -122,7 +157,7 @@ func (h Handler) HandleStats(ctx context.Context, stats schema.Stats, config *au
 		return
 	}
 
-	var notifications []Params
+	var outcomes []Outcome
 	var prices []float64
 
 	prices = append(prices, stats.AuctionPricefloor)
@@ -149,65 +184,37 @@ func (h Handler) HandleStats(ctx context.Context, stats schema.Stats, config *au
 
 	switch stats.Result.Status {
 	case "SUCCESS": // We have winner
-		for _, bid := range auctionResult.Bids {
-			if bid.Price == firstPrice {
-				notifications = append(notifications, Params{
-					Bundle:           bundle,
-					AdType:           adType,
-					AuctionID:        stats.AuctionID,
-					NotificationType: "NURL",
-					URL:              bid.NURL,
-					Bid:              bid,
-					Reason:           openrtb3.LossWon,
-					FirstPrice:       firstPrice,
-					SecondPrice:      secondPrice,
-				})
+		winner := auctionResult.FindWinner(WinnerRef{
+			DemandID: stats.Result.WinnerDemandID,
+			BidID:    stats.Result.WinnerBidID,
+			Price:    firstPrice,
+		})
+		for i, bid := range auctionResult.Bids {
+			if i == winner {
+				outcomes = append(outcomes, Outcome{Bid: bid, NotificationType: "NURL", Reason: openrtb3.LossWon})
 			} else {
-				notifications = append(notifications, Params{
-					Bundle:           bundle,
-					AdType:           adType,
-					AuctionID:        stats.AuctionID,
-					NotificationType: "LURL",
-					URL:              bid.LURL,
-					Bid:              bid,
-					Reason:           openrtb3.LossLostToHigherBid,
-					FirstPrice:       firstPrice,
-					SecondPrice:      secondPrice,
-				})
+				outcomes = append(outcomes, Outcome{Bid: bid, NotificationType: "LURL", Reason: openrtb3.LossLostToHigherBid})
 			}
 		}
 	case "FAIL":
 		for _, bid := range auctionResult.Bids {
-			notifications = append(notifications, Params{
-				Bundle:           bundle,
-				AdType:           adType,
-				AuctionID:        stats.AuctionID,
-				NotificationType: "LURL",
-				URL:              bid.LURL,
-				Bid:              bid,
-				Reason:           openrtb3.LossInternalError,
-				FirstPrice:       firstPrice,
-				SecondPrice:      secondPrice,
-			})
+			outcomes = append(outcomes, Outcome{Bid: bid, NotificationType: "LURL", Reason: openrtb3.LossInternalError})
 		}
 	case "AUCTION_CANCELLED":
 		for _, bid := range auctionResult.Bids {
-			notifications = append(notifications, Params{
-				Bundle:           bundle,
-				AdType:           adType,
-				AuctionID:        stats.AuctionID,
-				NotificationType: "LURL",
-				URL:              bid.LURL,
-				Bid:              bid,
-				Reason:           openrtb3.LossExpired,
-				FirstPrice:       firstPrice,
-				SecondPrice:      secondPrice,
-			})
+			outcomes = append(outcomes, Outcome{Bid: bid, NotificationType: "LURL", Reason: openrtb3.LossExpired})
 		}
 	}
 
-	for _, n := range notifications {
-		go h.Sender.SendEvent(ctx, n)
+	err = h.finalize(ctx, Params{
+		Bundle:      bundle,
+		AdType:      adType,
+		AuctionID:   stats.AuctionID,
+		FirstPrice:  firstPrice,
+		SecondPrice: secondPrice,
+	}, outcomes)
+	if err != nil {
+		log.Printf("HandleStats: finalize AuctionResult: %s", err)
 	}
 }
 
@@ -230,22 +237,40 @@ func (h Handler) HandleShow(ctx context.Context, impression *schema.Bid, bundle,
 		return
 	}
 
-	for _, bid := range auctionResult.Bids {
-		if bid.Price == impression.GetPrice() {
-			go h.Sender.SendEvent(ctx, Params{
-				Bundle:           bundle,
-				AdType:           adType,
-				AuctionID:        impression.AuctionID,
-				NotificationType: "BURL",
-				URL:              bid.BURL,
-				Bid:              bid,
-				Reason:           openrtb3.LossWon,
-				FirstPrice:       impression.GetPrice(),
-				SecondPrice:      0,
-			})
-
-			break
-		}
+	winner := auctionResult.FindWinner(bidWinnerRef(impression))
+	if winner == -1 {
+		return
+	}
+
+	// BURL is sent once per bid, repeated /show requests are ignored
+	bid := auctionResult.Bids[winner]
+	claimed, err := h.AuctionResultRepo.ClaimNotification(ctx, impression.AuctionID, bid, "BURL")
+	if err != nil {
+		log.Printf("HandleShow: claim BURL: %s", err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	go h.send(ctx, Params{
+		Bundle:           bundle,
+		AdType:           adType,
+		AuctionID:        impression.AuctionID,
+		NotificationType: "BURL",
+		URL:              bid.BURL,
+		Bid:              bid,
+		Reason:           openrtb3.LossWon,
+		FirstPrice:       impression.GetPrice(),
+		SecondPrice:      0,
+	})
+}
+
+func bidWinnerRef(bid *schema.Bid) WinnerRef {
+	return WinnerRef{
+		DemandID: bid.DemandID,
+		BidID:    bid.BidID,
+		Price:    bid.GetPrice(),
 	}
 }
 
@@ -300,37 +325,23 @@ func (h Handler) HandleWin(ctx context.Context, bid *schema.Bid, config *auction
 	}
 
 	// Send notifications for all bids stored in auctionResult, regardless of incoming bid type
-	for _, auctionBid := range auctionResult.Bids {
-		if auctionBid.Price == winningPrice {
-			// Send win notification
-			go h.Sender.SendEvent(ctx, Params{
-				Bundle:           bundle,
-				AdType:           adType,
-				AuctionID:        bid.AuctionID,
-				NotificationType: "NURL",
-				URL:              auctionBid.NURL,
-				Bid:              auctionBid,
-				Reason:           openrtb3.LossWon,
-				FirstPrice:       firstPrice,
-				SecondPrice:      secondPrice,
-			})
+	winner := auctionResult.FindWinner(bidWinnerRef(bid))
+	outcomes := make([]Outcome, 0, len(auctionResult.Bids))
+	for i, auctionBid := range auctionResult.Bids {
+		if i == winner {
+			outcomes = append(outcomes, Outcome{Bid: auctionBid, NotificationType: "NURL", Reason: openrtb3.LossWon})
 		} else {
-			// Send loss notification
-			go h.Sender.SendEvent(ctx, Params{
-				Bundle:           bundle,
-				AdType:           adType,
-				AuctionID:        bid.AuctionID,
-				NotificationType: "LURL",
-				URL:              auctionBid.LURL,
-				Bid:              auctionBid,
-				Reason:           openrtb3.LossLostToHigherBid,
-				FirstPrice:       firstPrice,
-				SecondPrice:      secondPrice,
-			})
+			outcomes = append(outcomes, Outcome{Bid: auctionBid, NotificationType: "LURL", Reason: openrtb3.LossLostToHigherBid})
 		}
 	}
 
-	return nil
+	return h.finalize(ctx, Params{
+		Bundle:      bundle,
+		AdType:      adType,
+		AuctionID:   bid.AuctionID,
+		FirstPrice:  firstPrice,
+		SecondPrice: secondPrice,
+	}, outcomes)
 }
 
 // HandleLoss is used to handle /loss request
@@ -392,19 +403,69 @@ func (h Handler) HandleLoss(ctx context.Context, bid *schema.Bid, externalWinner
 
 	// Send loss notifications for all bids stored in auctionResult
 	// (since external winner won)
+	outcomes := make([]Outcome, 0, len(auctionResult.Bids))
 	for _, auctionBid := range auctionResult.Bids {
-		go h.Sender.SendEvent(ctx, Params{
-			Bundle:           bundle,
-			AdType:           adType,
-			AuctionID:        bid.AuctionID,
-			NotificationType: "LURL",
-			URL:              auctionBid.LURL,
-			Bid:              auctionBid,
-			Reason:           openrtb3.LossLostToHigherBid,
-			FirstPrice:       firstPrice,
-			SecondPrice:      secondPrice,
-		})
+		outcomes = append(outcomes, Outcome{Bid: auctionBid, NotificationType: "LURL", Reason: openrtb3.LossLostToHigherBid})
+	}
+
+	return h.finalize(ctx, Params{
+		Bundle:      bundle,
+		AdType:      adType,
+		AuctionID:   bid.AuctionID,
+		FirstPrice:  firstPrice,
+		SecondPrice: secondPrice,
+	}, outcomes)
+}
+
+// finalize records auction outcomes and sends notifications for bids that were not finalized before.
+// Outcomes conflicting with recorded ones are not sent and logged as anomalies.
+func (h Handler) finalize(ctx context.Context, base Params, outcomes []Outcome) error {
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	finalization, err := h.AuctionResultRepo.FinalizeResult(ctx, base.AuctionID, outcomes)
+	if err != nil {
+		return err
+	}
+
+	for _, conflict := range finalization.Conflicts {
+		p := outcomeParams(base, conflict.Outcome)
+		err := fmt.Errorf("%s conflicts with %s recorded earlier", p.NotificationType, conflict.Recorded)
+		log.Printf("Handler: auction %s, bid %s: %v", p.AuctionID, p.Bid.ID, err)
+		if h.EventLogger != nil {
+			logNotificationEvent(h.EventLogger, p, p.URL, "ANOMALY", 0, err)
+		}
+	}
+
+	for _, outcome := range finalization.Pending {
+		go h.send(ctx, outcomeParams(base, outcome))
 	}
 
 	return nil
 }
+
+// send sends notification and marks it as sent, so that it's not sent again.
+func (h Handler) send(ctx context.Context, p Params) {
+	h.Sender.SendEvent(ctx, p)
+
+	err := h.AuctionResultRepo.MarkNotificationSent(context.WithoutCancel(ctx), p.AuctionID, p.Bid, p.NotificationType)
+	if err != nil {
+		log.Printf("Handler: mark %s of auction %s as sent: %v", p.NotificationType, p.AuctionID, err)
+	}
+}
+
+func outcomeParams(base Params, outcome Outcome) Params {
+	p := base
+	p.NotificationType = outcome.NotificationType
+	p.Bid = outcome.Bid
+	p.Reason = outcome.Reason
+	switch outcome.NotificationType {
+	case "NURL":
+		p.URL = outcome.Bid.NURL
+	case "LURL":
+		p.URL = outcome.Bid.LURL
+	}
+
+	return p
+}
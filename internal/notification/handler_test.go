@@ -7,9 +7,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/prebid/openrtb/v19/openrtb3"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/auction"
+	"github.com/bidon-io/bidon-backend/internal/bidding/adapters"
 	"github.com/bidon-io/bidon-backend/internal/notification"
 	"github.com/bidon-io/bidon-backend/internal/notification/mocks"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event/engine"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
 )
 
@@ -88,7 +95,7 @@ func TestHandler_HandleStats_WinBid(t *testing.T) {
 		}
 	}}
 
-	handlerV2 := notification.Handler{AuctionResultRepo: mockRepo, Sender: sender}
+	handlerV2 := notification.Handler{AuctionResultRepo: finalizeAll(mockRepo), Sender: sender}
 
 	handlerV2.HandleStats(ctx, imp, &config, "bundle-1", "banner")
 
@@ -154,7 +161,7 @@ func TestHandler_HandleStats_Loss(t *testing.T) {
 	}}
 
 	handlerV2 := notification.Handler{
-		AuctionResultRepo: repoMock,
+		AuctionResultRepo: finalizeAll(repoMock),
 		Sender:            sender,
 	}
 
@@ -210,7 +217,7 @@ func TestHandler_HandleWin_ExternalNotificationsEnabled(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -270,7 +277,7 @@ func TestHandler_HandleWin_ExternalNotificationsDisabled(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -324,7 +331,7 @@ func TestHandler_HandleWin_NonRTBBid(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -418,7 +425,7 @@ func TestHandler_HandleLoss_ExternalNotificationsEnabled(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -463,7 +470,7 @@ func TestHandler_HandleLoss_ExternalNotificationsDisabled(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -532,7 +539,7 @@ func TestHandler_HandleLoss_NonRTBBid(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -570,7 +577,7 @@ func TestHandler_HandleWin_NilConfig(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -607,7 +614,7 @@ func TestHandler_HandleWin_AuctionResultNotFound(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -642,7 +649,7 @@ func TestHandler_HandleLoss_NilConfig(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -684,7 +691,7 @@ func TestHandler_HandleLoss_AuctionResultNotFound(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -728,7 +735,7 @@ func TestHandler_HandleStats_ExternalNotificationsEnabled(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -765,7 +772,7 @@ func TestHandler_HandleWin_RepoError(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -807,7 +814,7 @@ func TestHandler_HandleLoss_RepoError(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -850,7 +857,7 @@ func TestHandler_HandleWin_EmptyPrices(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -901,7 +908,7 @@ func TestHandler_HandleLoss_NilExternalWinner(t *testing.T) {
 	}
 
 	handler := notification.Handler{
-		AuctionResultRepo: mockRepo,
+		AuctionResultRepo: finalizeAll(mockRepo),
 		Sender:            sender,
 	}
 
@@ -928,3 +935,251 @@ func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
 		return true // timed out
 	}
 }
+
+func TestHandler_HandleCachedBidLoss(t *testing.T) {
+	ctx := context.Background()
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+
+	var got notification.Params
+	sender := &mocks.SenderMock{SendEventFunc: func(_ context.Context, p notification.Params) {
+		defer wg.Done()
+		got = p
+	}}
+	handler := notification.Handler{Sender: sender}
+
+	resp := adapters.DemandResponse{
+		DemandID:  adapter.VungleKey,
+		RequestID: "request-1",
+		Bid: &adapters.BidDemandResponse{
+			ID:       "bid-1",
+			ImpID:    "imp-1",
+			DemandID: adapter.VungleKey,
+			Price:    1.5,
+			LURL:     "https://example.com/loss?reason=${AUCTION_LOSS}",
+		},
+	}
+	handler.HandleCachedBidLoss(ctx, "auction-1", resp, openrtb3.LossLostToHigherBid, 2.0, 1.5, "com.example", "banner")
+	handler.HandleCachedBidLoss(ctx, "auction-1", adapters.DemandResponse{DemandID: adapter.MetaKey}, openrtb3.LossExpired, 0, 0, "com.example", "banner")
+	wg.Wait()
+
+	want := notification.Params{
+		Bundle:           "com.example",
+		AdType:           "banner",
+		AuctionID:        "auction-1",
+		NotificationType: "LURL",
+		URL:              "https://example.com/loss?reason=${AUCTION_LOSS}",
+		Bid: notification.Bid{
+			ID:        "bid-1",
+			ImpID:     "imp-1",
+			Price:     1.5,
+			DemandID:  adapter.VungleKey,
+			LURL:      "https://example.com/loss?reason=${AUCTION_LOSS}",
+			RequestID: "request-1",
+		},
+		Reason:      openrtb3.LossLostToHigherBid,
+		FirstPrice:  2.0,
+		SecondPrice: 1.5,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SendEvent() params mismatch (-want +got):\n%s", diff)
+	}
+	if calls := len(sender.SendEventCalls()); calls != 1 {
+		t.Errorf("SendEvent() called %d times, want 1 as response without bid is skipped", calls)
+	}
+}
+
+func TestHandler_HandleStats_WinnerByBidID(t *testing.T) {
+	ctx := context.Background()
+	stats := schema.Stats{
+		AuctionID:              "auction-1",
+		AuctionPricefloor:      1.0,
+		AuctionConfigurationID: 10,
+		Result: schema.AuctionResult{
+			Status:         "SUCCESS",
+			BidType:        schema.RTBBidType,
+			Price:          2.5,
+			WinnerDemandID: string(adapter.MintegralKey),
+			WinnerBidID:    "bid-2",
+		},
+		AdUnits: []schema.AuctionAdUnitResult{
+			{Price: 2.5, DemandID: string(adapter.VungleKey), BidID: "bid-1", BidType: schema.RTBBidType, Status: "LOSE"},
+			{Price: 2.5, DemandID: string(adapter.MintegralKey), BidID: "bid-2", BidType: schema.RTBBidType, Status: "WIN"},
+		},
+	}
+	repo := &mocks.AuctionResultRepoMock{
+		FindFunc: func(context.Context, string) (*notification.AuctionResult, error) {
+			return &notification.AuctionResult{
+				Bids: []notification.Bid{
+					{ID: "bid-1", DemandID: adapter.VungleKey, Price: 2.5},
+					{ID: "bid-2", DemandID: adapter.MintegralKey, Price: 2.5},
+				},
+			}, nil
+		},
+	}
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	mu := sync.Mutex{}
+	got := map[string]string{}
+	sender := &mocks.SenderMock{SendEventFunc: func(_ context.Context, p notification.Params) {
+		defer wg.Done()
+		mu.Lock()
+		got[p.Bid.ID] = p.NotificationType
+		mu.Unlock()
+	}}
+
+	handler := notification.Handler{AuctionResultRepo: finalizeAll(repo), Sender: sender}
+	handler.HandleStats(ctx, stats, &auction.Config{}, "bundle-1", "banner")
+
+	if waitTimeout(wg, 1*time.Second) {
+		t.Fatal("timeout waiting for events")
+	}
+
+	want := map[string]string{"bid-1": "LURL", "bid-2": "NURL"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("notifications mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHandler_HandleShow(t *testing.T) {
+	ctx := context.Background()
+	result := &notification.AuctionResult{
+		AuctionID: "auction-1",
+		Bids: []notification.Bid{
+			{ID: "amazon-bid-1", DemandID: adapter.AmazonKey, Price: 1.5, BURL: "https://amazon.com/billing/1"},
+			{ID: "amazon-bid-2", DemandID: adapter.AmazonKey, Price: 1.5, BURL: "https://amazon.com/billing/2"},
+			{ID: "vungle-bid", DemandID: adapter.VungleKey, Price: 1.5, BURL: "https://vungle.com/billing"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		impression *schema.Bid
+		wantURL    string
+	}{
+		{
+			name:       "matched by bid id",
+			impression: &schema.Bid{AuctionID: "auction-1", DemandID: "amazon", BidID: "amazon-bid-2", Price: 1.49, BidType: schema.RTBBidType},
+			wantURL:    "https://amazon.com/billing/2",
+		},
+		{
+			name:       "old sdk, matched by price and demand",
+			impression: &schema.Bid{AuctionID: "auction-1", DemandID: "vungle", Price: 1.5, BidType: schema.RTBBidType},
+			wantURL:    "https://vungle.com/billing",
+		},
+		{
+			name:       "unknown bid id",
+			impression: &schema.Bid{AuctionID: "auction-1", DemandID: "amazon", BidID: "amazon-bid-3", Price: 1.5, BidType: schema.RTBBidType},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.AuctionResultRepoMock{
+				FindFunc: func(context.Context, string) (*notification.AuctionResult, error) {
+					return result, nil
+				},
+			}
+			sent := make(chan notification.Params, 1)
+			sender := &mocks.SenderMock{SendEventFunc: func(_ context.Context, p notification.Params) {
+				sent <- p
+			}}
+
+			handler := notification.Handler{AuctionResultRepo: finalizeAll(repo), Sender: sender}
+			handler.HandleShow(ctx, tt.impression, "bundle-1", "banner")
+
+			var gotURL string
+			select {
+			case p := <-sent:
+				gotURL = p.URL
+			case <-time.After(100 * time.Millisecond):
+			}
+			if gotURL != tt.wantURL {
+				t.Errorf("BURL = %q, want %q", gotURL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestHandler_HandleShow_AlreadyClaimed(t *testing.T) {
+	ctx := context.Background()
+	repo := finalizeAll(&mocks.AuctionResultRepoMock{
+		FindFunc: func(context.Context, string) (*notification.AuctionResult, error) {
+			return &notification.AuctionResult{
+				Bids: []notification.Bid{{ID: "bid-1", DemandID: adapter.VungleKey, Price: 1.5, BURL: "https://vungle.com/billing"}},
+			}, nil
+		},
+	})
+	repo.ClaimNotificationFunc = func(context.Context, string, notification.Bid, string) (bool, error) {
+		return false, nil // BURL was sent on previous /show
+	}
+	sender := &mocks.SenderMock{SendEventFunc: func(context.Context, notification.Params) {
+		t.Error("SendEvent() called for already claimed BURL")
+	}}
+
+	handler := notification.Handler{AuctionResultRepo: repo, Sender: sender}
+	handler.HandleShow(ctx, &schema.Bid{AuctionID: "auction-1", DemandID: "vungle", BidID: "bid-1", Price: 1.5, BidType: schema.RTBBidType}, "bundle-1", "banner")
+
+	time.Sleep(50 * time.Millisecond)
+	if calls := len(repo.ClaimNotificationCalls()); calls != 1 {
+		t.Errorf("ClaimNotification() called %d times, want 1", calls)
+	}
+}
+
+func TestHandler_HandleWin_ConflictWithLoss(t *testing.T) {
+	ctx := context.Background()
+	bids := []notification.Bid{
+		{ID: "bid-1", DemandID: adapter.VungleKey, Price: 2.0, NURL: "https://vungle.com/win", LURL: "https://vungle.com/loss"},
+		{ID: "bid-2", DemandID: adapter.MetaKey, Price: 1.0, NURL: "https://meta.com/win", LURL: "https://meta.com/loss"},
+	}
+	repo := finalizeAll(&mocks.AuctionResultRepoMock{
+		FindFunc: func(context.Context, string) (*notification.AuctionResult, error) {
+			return &notification.AuctionResult{AuctionID: "auction-1", Bids: bids}, nil
+		},
+	})
+	// /loss was handled before, both bids are finalized as lost
+	repo.FinalizeResultFunc = func(_ context.Context, _ string, outcomes []notification.Outcome) (*notification.Finalization, error) {
+		f := &notification.Finalization{}
+		for _, o := range outcomes {
+			if o.NotificationType != "LURL" {
+				f.Conflicts = append(f.Conflicts, notification.Conflict{Outcome: o, Recorded: "LURL"})
+			}
+		}
+		return f, nil
+	}
+	sender := &mocks.SenderMock{SendEventFunc: func(context.Context, notification.Params) {
+		t.Error("SendEvent() called for finalized auction")
+	}}
+	events := &notificationEventRecorder{}
+
+	handler := notification.Handler{
+		AuctionResultRepo: repo,
+		Sender:            sender,
+		EventLogger:       &event.Logger{Engine: &engine.Log{}, Observers: []event.Observer{events}},
+	}
+	bid := &schema.Bid{AuctionID: "auction-1", AuctionConfigurationID: 1, DemandID: "vungle", BidID: "bid-1", Price: 2.0, BidType: schema.RTBBidType}
+	if err := handler.HandleWin(ctx, bid, &auction.Config{ExternalWinNotifications: true}, "bundle-1", "banner"); err != nil {
+		t.Fatalf("HandleWin() = %v, want nil", err)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if diff := cmp.Diff([]string{"ANOMALY"}, events.statuses); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// finalizeAll makes repo finalize every outcome as pending and allow every notification to be claimed.
+func finalizeAll(repo *mocks.AuctionResultRepoMock) *mocks.AuctionResultRepoMock {
+	repo.FinalizeResultFunc = func(_ context.Context, _ string, outcomes []notification.Outcome) (*notification.Finalization, error) {
+		return &notification.Finalization{Pending: outcomes}, nil
+	}
+	repo.ClaimNotificationFunc = func(context.Context, string, notification.Bid, string) (bool, error) {
+		return true, nil
+	}
+	repo.MarkNotificationSentFunc = func(context.Context, string, notification.Bid, string) error {
+		return nil
+	}
+
+	return repo
+}
@@ -0,0 +1,237 @@
+package notification
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+)
+
+// baseCurrency is the currency of all prices in auction.
+const baseCurrency = "USD"
+
+// b64Suffix marks macro which value must be Base64 encoded, e.g. ${AUCTION_PRICE:B64}.
+const b64Suffix = ":B64"
+
+// MacroFunc returns value of a macro for the notification.
+type MacroFunc func(p Params) string
+
+// CurrencyConverter converts prices from USD to the currency of a demand.
+type CurrencyConverter interface {
+	Convert(amount float64, from, to string) (float64, error)
+}
+
+// ExchangeRates converts prices from USD with fixed rates: amount of the currency for one USD, by currency code.
+type ExchangeRates map[string]float64
+
+func (r ExchangeRates) Convert(amount float64, from, to string) (float64, error) {
+	if from != baseCurrency {
+		return 0, fmt.Errorf("unsupported base currency %s", from)
+	}
+
+	rate, ok := r[to]
+	if !ok {
+		return 0, fmt.Errorf("no exchange rate for %s", to)
+	}
+
+	return amount * rate, nil
+}
+
+// ParamMacros are functions returning notification params by param name. They are used to configure custom macros.
+var ParamMacros = map[string]MacroFunc{
+	"bundle":            func(p Params) string { return p.Bundle },
+	"ad_type":           func(p Params) string { return p.AdType },
+	"auction_id":        func(p Params) string { return p.AuctionID },
+	"notification_type": func(p Params) string { return p.NotificationType },
+	"demand_id":         func(p Params) string { return string(p.Bid.DemandID) },
+}
+
+// MacroExpander substitutes OpenRTB substitution macros (${AUCTION_PRICE} and alike) in notification URLs.
+// Macros are substituted anywhere in the URL: path, query and fragment, also inside larger values.
+// Percent-encoded macros, e.g. %24%7BAUCTION_PRICE%7D, are substituted the same way.
+// Substituted values are escaped for the part of the URL they are in. Unknown macros are left as is.
+// Zero value expands standard macros with prices in USD.
+type MacroExpander struct {
+	// Currencies are currencies demands expect prices in. Prices are in USD for demands not in the map.
+	Currencies map[adapter.Key]string
+	// CurrencyConverter is required if Currencies are set.
+	CurrencyConverter CurrencyConverter
+	// CustomMacros are macros of a demand, names are without ${}. They take precedence over standard macros.
+	CustomMacros map[adapter.Key]map[string]MacroFunc
+}
+
+// Expand returns notification URL with macros substituted. Nil expander expands standard macros only.
+func (m *MacroExpander) Expand(p Params) (string, error) {
+	if p.URL == "" {
+		return "", fmt.Errorf("%w: type %s: empty url", ErrInvalidURL, p.NotificationType)
+	}
+	if _, err := url.Parse(p.URL); err != nil {
+		return "", fmt.Errorf("%w: type %s: %s", ErrInvalidURL, p.NotificationType, p.URL)
+	}
+
+	macros, err := m.macros(p)
+	if err != nil {
+		return "", err
+	}
+
+	// Macro names contain neither '?' nor '#', so template can be split into URL parts before substitution.
+	rest, fragment, hasFragment := strings.Cut(p.URL, "#")
+	path, query, hasQuery := strings.Cut(rest, "?")
+
+	var b strings.Builder
+	b.WriteString(substitute(path, macros, url.PathEscape))
+	if hasQuery {
+		b.WriteByte('?')
+		b.WriteString(substitute(query, macros, url.QueryEscape))
+	}
+	if hasFragment {
+		b.WriteByte('#')
+		b.WriteString(substitute(fragment, macros, url.QueryEscape))
+	}
+
+	u := b.String()
+	if _, err := url.Parse(u); err != nil {
+		return "", fmt.Errorf("%w: type %s: expanded %s", ErrInvalidURL, p.NotificationType, u)
+	}
+
+	return u, nil
+}
+
+func (m *MacroExpander) macros(p Params) (map[string]string, error) {
+	currency := baseCurrency
+	firstPrice, secondPrice := p.FirstPrice, p.SecondPrice
+	if m != nil && m.Currencies[p.Bid.DemandID] != "" && m.Currencies[p.Bid.DemandID] != baseCurrency {
+		currency = m.Currencies[p.Bid.DemandID]
+		if m.CurrencyConverter == nil {
+			return nil, fmt.Errorf("no currency converter for %s prices of %s", currency, p.Bid.DemandID)
+		}
+
+		var err error
+		if firstPrice, err = m.CurrencyConverter.Convert(firstPrice, baseCurrency, currency); err != nil {
+			return nil, fmt.Errorf("convert price to %s: %v", currency, err)
+		}
+		if secondPrice, err = m.CurrencyConverter.Convert(secondPrice, baseCurrency, currency); err != nil {
+			return nil, fmt.Errorf("convert price to %s: %v", currency, err)
+		}
+	}
+
+	minToWin := formatPrice(secondPrice)
+	macros := map[string]string{
+		"AUCTION_ID":                 p.Bid.RequestID,
+		"AUCTION_BID_ID":             p.Bid.ID,
+		"AUCTION_IMP_ID":             p.Bid.ImpID,
+		"AUCTION_SEAT_ID":            p.Bid.SeatID,
+		"AUCTION_AD_ID":              p.Bid.AdID,
+		"AUCTION_PRICE":              formatPrice(firstPrice),
+		"AUCTION_CURRENCY":           currency,
+		"AUCTION_MBR":                marketBidRatio(p.FirstPrice, p.Bid.Price),
+		"AUCTION_LOSS":               strconv.Itoa(int(p.Reason)),
+		"AUCTION_MIN_TO_WIN":         minToWin,
+		"AUCTION_MINIMUM_BID_TO_WIN": minToWin,
+		"MIN_BID_TO_WIN":             minToWin,
+	}
+
+	if m != nil {
+		for name, fn := range m.CustomMacros[p.Bid.DemandID] {
+			macros[name] = fn(p)
+		}
+	}
+
+	return macros, nil
+}
+
+// macroDelimiters are opening and closing delimiters of macros. DSPs often send templates with percent-encoded macros,
+// e.g. %24%7BAUCTION_PRICE%7D, so both forms are recognized. Hex digits of encoded delimiters are case-insensitive.
+var macroDelimiters = [...][2]string{
+	{"${", "}"},
+	{"%24%7B", "%7D"},
+}
+
+// substitute replaces macros in s with escaped values.
+func substitute(s string, macros map[string]string, escape func(string) string) string {
+	var b strings.Builder
+	for {
+		start, opening, closing := nextMacro(s)
+		if start == -1 {
+			break
+		}
+		end := indexFold(s[start+len(opening):], closing)
+		if end == -1 {
+			break
+		}
+		end += start + len(opening)
+
+		b.WriteString(s[:start])
+		if value, ok := macroValue(s[start+len(opening):end], macros); ok {
+			b.WriteString(escape(value))
+		} else {
+			b.WriteString(s[start : end+len(closing)])
+		}
+		s = s[end+len(closing):]
+	}
+	b.WriteString(s)
+
+	return b.String()
+}
+
+// nextMacro returns index and delimiters of the first macro in s, or -1 if there are no macros.
+func nextMacro(s string) (start int, opening, closing string) {
+	start = -1
+	for _, d := range macroDelimiters {
+		if i := indexFold(s, d[0]); i != -1 && (start == -1 || i < start) {
+			start, opening, closing = i, d[0], d[1]
+		}
+	}
+
+	return start, opening, closing
+}
+
+// indexFold is strings.Index ignoring ASCII case.
+func indexFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func macroValue(name string, macros map[string]string) (string, bool) {
+	// Names of percent-encoded macros may have encoded suffix too, e.g. AUCTION_PRICE%3AB64.
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		name = unescaped
+	}
+
+	encode := false
+	if base, ok := strings.CutSuffix(name, b64Suffix); ok {
+		name = base
+		encode = true
+	}
+
+	value, ok := macros[name]
+	if !ok {
+		return "", false
+	}
+	if encode {
+		value = base64.URLEncoding.EncodeToString([]byte(value))
+	}
+
+	return value, true
+}
+
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', -1, 64)
+}
+
+// marketBidRatio is the ratio of clearing price to the bid price, as defined for ${AUCTION_MBR}.
+func marketBidRatio(clearingPrice, bidPrice float64) string {
+	if bidPrice <= 0 {
+		return ""
+	}
+
+	return strconv.FormatFloat(clearingPrice/bidPrice, 'f', 4, 64)
+}
@@ -0,0 +1,202 @@
+package notification_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prebid/openrtb/v19/openrtb3"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/notification"
+)
+
+func TestMacroExpander_Expand(t *testing.T) {
+	bid := notification.Bid{
+		ID:        "bid-1",
+		ImpID:     "imp-1",
+		Price:     2.5,
+		DemandID:  adapter.MetaKey,
+		AdID:      "ad-1",
+		SeatID:    "seat-1",
+		RequestID: "request-1",
+	}
+
+	tests := []struct {
+		name     string
+		expander *notification.MacroExpander
+		url      string
+		bid      notification.Bid
+		reason   openrtb3.LossReason
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:   "meta win notification",
+			url:    "https://www.facebook.com/audiencenetwork/nurl/?partner=123&app=456&auction=${AUCTION_ID}&ortb_loss_code=${AUCTION_LOSS}&clearing_price=${AUCTION_PRICE}&phase=&bid_id=${AUCTION_BID_ID}",
+			reason: openrtb3.LossWon,
+			want:   "https://www.facebook.com/audiencenetwork/nurl/?partner=123&app=456&auction=request-1&ortb_loss_code=0&clearing_price=2.5&phase=&bid_id=bid-1",
+		},
+		{
+			name:   "loss notification with minimum bid to win",
+			url:    "https://dsp.example.com/lurl?id=${AUCTION_ID}&loss=${AUCTION_LOSS}&min_to_win=${AUCTION_MIN_TO_WIN}&cur=${AUCTION_CURRENCY}",
+			reason: openrtb3.LossLostToHigherBid,
+			want:   "https://dsp.example.com/lurl?id=request-1&loss=102&min_to_win=2.1&cur=USD",
+		},
+		{
+			name: "macros in path",
+			url:  "https://dsp.example.com/win/${AUCTION_ID}/${AUCTION_IMP_ID}/${AUCTION_PRICE}",
+			want: "https://dsp.example.com/win/request-1/imp-1/2.5",
+		},
+		{
+			name: "macros inside larger values",
+			url:  "https://dsp.example.com/event?data=bid:${AUCTION_BID_ID};seat:${AUCTION_SEAT_ID};ad:${AUCTION_AD_ID}&x=1",
+			want: "https://dsp.example.com/event?data=bid:bid-1;seat:seat-1;ad:ad-1&x=1",
+		},
+		{
+			name: "macros in fragment",
+			url:  "https://dsp.example.com/nurl?a=1#bid=${AUCTION_BID_ID}&price=${AUCTION_PRICE}",
+			want: "https://dsp.example.com/nurl?a=1#bid=bid-1&price=2.5",
+		},
+		{
+			name: "base64 encoded macros",
+			url:  "https://dsp.example.com/billing?p=${AUCTION_PRICE:B64}&id=${AUCTION_ID:B64}",
+			want: "https://dsp.example.com/billing?p=Mi41&id=cmVxdWVzdC0x",
+		},
+		{
+			name: "market bid ratio",
+			url:  "https://dsp.example.com/win?mbr=${AUCTION_MBR}",
+			want: "https://dsp.example.com/win?mbr=1.0000",
+		},
+		{
+			name: "values are escaped for their url part",
+			url:  "https://dsp.example.com/win/${AUCTION_BID_ID}?bid=${AUCTION_BID_ID}",
+			bid:  notification.Bid{ID: "a b/c&d", RequestID: "request-1", Price: 2.5, DemandID: adapter.MetaKey},
+			want: "https://dsp.example.com/win/a%20b%2Fc&d?bid=a+b%2Fc%26d",
+		},
+		{
+			name: "unknown and unterminated macros are left as is",
+			url:  "https://dsp.example.com/win?x=${UNKNOWN}&p=${AUCTION_PRICE}&y=${AUCTION",
+			want: "https://dsp.example.com/win?x=${UNKNOWN}&p=2.5&y=${AUCTION",
+		},
+		{
+			name:   "mobilefuse loss notification with percent-encoded macros",
+			url:    "https://mfx-us-east-1.mobilefuse.com/lurl?i=60f859c11e497f9afb51877bec9c391c_0&loss=%24%7BAUCTION_LOSS%7D&price=%24%7BAUCTION_PRICE%7D",
+			reason: openrtb3.LossLostToHigherBid,
+			want:   "https://mfx-us-east-1.mobilefuse.com/lurl?i=60f859c11e497f9afb51877bec9c391c_0&loss=102&price=2.5",
+		},
+		{
+			name: "moloco billing notification with lowercase percent-encoded macros",
+			url:  "https://tracker-us.adsmoloco.com/billing?source=bidon&req=%24%7bAUCTION_ID%7d&imp=%24%7bAUCTION_IMP_ID%7d&price=%24%7bAUCTION_PRICE%7d",
+			want: "https://tracker-us.adsmoloco.com/billing?source=bidon&req=request-1&imp=imp-1&price=2.5",
+		},
+		{
+			name: "macros inside percent-encoded redirect url",
+			url:  "https://click.dsp.example.com/r?u=https%3A%2F%2Ftrack.example.com%2Fwin%3Fbid%3D%24%7BAUCTION_BID_ID%7D%26p%3D%24%7BAUCTION_PRICE%7D",
+			want: "https://click.dsp.example.com/r?u=https%3A%2F%2Ftrack.example.com%2Fwin%3Fbid%3Dbid-1%26p%3D2.5",
+		},
+		{
+			name: "percent-encoded base64 macro",
+			url:  "https://dsp.example.com/billing?p=%24%7BAUCTION_PRICE%3AB64%7D",
+			want: "https://dsp.example.com/billing?p=Mi41",
+		},
+		{
+			name: "mixed and unknown percent-encoded macros",
+			url:  "https://dsp.example.com/win?p=${AUCTION_PRICE}&id=%24%7BAUCTION_ID%7D&x=%24%7BUNKNOWN%7D",
+			want: "https://dsp.example.com/win?p=2.5&id=request-1&x=%24%7BUNKNOWN%7D",
+		},
+		{
+			name: "price in demand currency",
+			expander: &notification.MacroExpander{
+				Currencies:        map[adapter.Key]string{adapter.MetaKey: "EUR"},
+				CurrencyConverter: fixedRateConverter(0.5),
+			},
+			url:  "https://dsp.example.com/win?p=${AUCTION_PRICE}&min=${MIN_BID_TO_WIN}&cur=${AUCTION_CURRENCY}",
+			want: "https://dsp.example.com/win?p=1.25&min=1.05&cur=EUR",
+		},
+		{
+			name: "currency of another demand",
+			expander: &notification.MacroExpander{
+				Currencies:        map[adapter.Key]string{adapter.VungleKey: "EUR"},
+				CurrencyConverter: fixedRateConverter(0.5),
+			},
+			url:  "https://dsp.example.com/win?p=${AUCTION_PRICE}&cur=${AUCTION_CURRENCY}",
+			want: "https://dsp.example.com/win?p=2.5&cur=USD",
+		},
+		{
+			name: "custom macros of demand",
+			expander: &notification.MacroExpander{
+				CustomMacros: map[adapter.Key]map[string]notification.MacroFunc{
+					adapter.MetaKey: {
+						"AUCTION_PRICE": func(p notification.Params) string { return "custom" },
+						"BUNDLE":        func(p notification.Params) string { return p.Bundle },
+					},
+				},
+			},
+			url:  "https://dsp.example.com/win?p=${AUCTION_PRICE}&app=${BUNDLE}",
+			want: "https://dsp.example.com/win?p=custom&app=com.example.app",
+		},
+		{
+			name:    "invalid url",
+			url:     "://dsp.example.com/win?p=${AUCTION_PRICE}",
+			wantErr: true,
+		},
+		{
+			name:    "empty url",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := bid
+			if tt.bid != (notification.Bid{}) {
+				b = tt.bid
+			}
+			p := notification.Params{
+				Bundle:           "com.example.app",
+				NotificationType: "NURL",
+				URL:              tt.url,
+				Bid:              b,
+				Reason:           tt.reason,
+				FirstPrice:       2.5,
+				SecondPrice:      2.1,
+			}
+
+			got, err := tt.expander.Expand(p)
+			if tt.wantErr {
+				if !errors.Is(err, notification.ErrInvalidURL) {
+					t.Errorf("Expand() error = %v, want ErrInvalidURL", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expand() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expand() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExchangeRates_Convert(t *testing.T) {
+	rates := notification.ExchangeRates{"EUR": 0.5}
+
+	got, err := rates.Convert(2.5, "USD", "EUR")
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	if got != 1.25 {
+		t.Errorf("Convert() = %v, want 1.25", got)
+	}
+
+	if _, err := rates.Convert(2.5, "USD", "RUB"); err == nil {
+		t.Error("Convert() to currency without rate: expected error")
+	}
+}
+
+type fixedRateConverter float64
+
+func (r fixedRateConverter) Convert(amount float64, _, _ string) (float64, error) {
+	return amount * float64(r), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/bidon-io/bidon-backend/internal/notification"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
 	"sync"
+	"time"
 )
 
 // Ensure, that AuctionResultRepoMock does implement notification.AuctionResultRepo.
@@ -21,12 +22,21 @@ var _ notification.AuctionResultRepo = &AuctionResultRepoMock{}
 //
 //		// make and configure a mocked notification.AuctionResultRepo
 //		mockedAuctionResultRepo := &AuctionResultRepoMock{
+//			ClaimNotificationFunc: func(ctx context.Context, auctionID string, bid notification.Bid, notificationType string) (bool, error) {
+//				panic("mock out the ClaimNotification method")
+//			},
 //			CreateOrUpdateFunc: func(ctx context.Context, adObject *schema.AdObject, bids []notification.Bid) error {
 //				panic("mock out the CreateOrUpdate method")
 //			},
+//			FinalizeResultFunc: func(ctx context.Context, auctionID string, outcomes []notification.Outcome) (*notification.Finalization, error) {
+//				panic("mock out the FinalizeResult method")
+//			},
 //			FindFunc: func(ctx context.Context, auctionID string) (*notification.AuctionResult, error) {
 //				panic("mock out the Find method")
 //			},
+//			MarkNotificationSentFunc: func(ctx context.Context, auctionID string, bid notification.Bid, notificationType string) error {
+//				panic("mock out the MarkNotificationSent method")
+//			},
 //		}
 //
 //		// use mockedAuctionResultRepo in code that requires notification.AuctionResultRepo
@@ -34,14 +44,34 @@ var _ notification.AuctionResultRepo = &AuctionResultRepoMock{}
 //
 //	}
 type AuctionResultRepoMock struct {
+	// ClaimNotificationFunc mocks the ClaimNotification method.
+	ClaimNotificationFunc func(ctx context.Context, auctionID string, bid notification.Bid, notificationType string) (bool, error)
+
 	// CreateOrUpdateFunc mocks the CreateOrUpdate method.
 	CreateOrUpdateFunc func(ctx context.Context, adObject *schema.AdObject, bids []notification.Bid) error
 
+	// FinalizeResultFunc mocks the FinalizeResult method.
+	FinalizeResultFunc func(ctx context.Context, auctionID string, outcomes []notification.Outcome) (*notification.Finalization, error)
+
 	// FindFunc mocks the Find method.
 	FindFunc func(ctx context.Context, auctionID string) (*notification.AuctionResult, error)
 
+	// MarkNotificationSentFunc mocks the MarkNotificationSent method.
+	MarkNotificationSentFunc func(ctx context.Context, auctionID string, bid notification.Bid, notificationType string) error
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// ClaimNotification holds details about calls to the ClaimNotification method.
+		ClaimNotification []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AuctionID is the auctionID argument value.
+			AuctionID string
+			// Bid is the bid argument value.
+			Bid notification.Bid
+			// NotificationType is the notificationType argument value.
+			NotificationType string
+		}
 		// CreateOrUpdate holds details about calls to the CreateOrUpdate method.
 		CreateOrUpdate []struct {
 			// Ctx is the ctx argument value.
@@ -51,6 +81,15 @@ type AuctionResultRepoMock struct {
 			// Bids is the bids argument value.
 			Bids []notification.Bid
 		}
+		// FinalizeResult holds details about calls to the FinalizeResult method.
+		FinalizeResult []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AuctionID is the auctionID argument value.
+			AuctionID string
+			// Outcomes is the outcomes argument value.
+			Outcomes []notification.Outcome
+		}
 		// Find holds details about calls to the Find method.
 		Find []struct {
 			// Ctx is the ctx argument value.
@@ -58,9 +97,67 @@ type AuctionResultRepoMock struct {
 			// AuctionID is the auctionID argument value.
 			AuctionID string
 		}
+		// MarkNotificationSent holds details about calls to the MarkNotificationSent method.
+		MarkNotificationSent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AuctionID is the auctionID argument value.
+			AuctionID string
+			// Bid is the bid argument value.
+			Bid notification.Bid
+			// NotificationType is the notificationType argument value.
+			NotificationType string
+		}
+	}
+	lockClaimNotification    sync.RWMutex
+	lockCreateOrUpdate       sync.RWMutex
+	lockFinalizeResult       sync.RWMutex
+	lockFind                 sync.RWMutex
+	lockMarkNotificationSent sync.RWMutex
+}
+
+// ClaimNotification calls ClaimNotificationFunc.
+func (mock *AuctionResultRepoMock) ClaimNotification(ctx context.Context, auctionID string, bid notification.Bid, notificationType string) (bool, error) {
+	if mock.ClaimNotificationFunc == nil {
+		panic("AuctionResultRepoMock.ClaimNotificationFunc: method is nil but AuctionResultRepo.ClaimNotification was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		AuctionID        string
+		Bid              notification.Bid
+		NotificationType string
+	}{
+		Ctx:              ctx,
+		AuctionID:        auctionID,
+		Bid:              bid,
+		NotificationType: notificationType,
+	}
+	mock.lockClaimNotification.Lock()
+	mock.calls.ClaimNotification = append(mock.calls.ClaimNotification, callInfo)
+	mock.lockClaimNotification.Unlock()
+	return mock.ClaimNotificationFunc(ctx, auctionID, bid, notificationType)
+}
+
+// ClaimNotificationCalls gets all the calls that were made to ClaimNotification.
+// Check the length with:
+//
+//	len(mockedAuctionResultRepo.ClaimNotificationCalls())
+func (mock *AuctionResultRepoMock) ClaimNotificationCalls() []struct {
+	Ctx              context.Context
+	AuctionID        string
+	Bid              notification.Bid
+	NotificationType string
+} {
+	var calls []struct {
+		Ctx              context.Context
+		AuctionID        string
+		Bid              notification.Bid
+		NotificationType string
 	}
-	lockCreateOrUpdate sync.RWMutex
-	lockFind           sync.RWMutex
+	mock.lockClaimNotification.RLock()
+	calls = mock.calls.ClaimNotification
+	mock.lockClaimNotification.RUnlock()
+	return calls
 }
 
 // CreateOrUpdate calls CreateOrUpdateFunc.
@@ -103,6 +200,46 @@ func (mock *AuctionResultRepoMock) CreateOrUpdateCalls() []struct {
 	return calls
 }
 
+// FinalizeResult calls FinalizeResultFunc.
+func (mock *AuctionResultRepoMock) FinalizeResult(ctx context.Context, auctionID string, outcomes []notification.Outcome) (*notification.Finalization, error) {
+	if mock.FinalizeResultFunc == nil {
+		panic("AuctionResultRepoMock.FinalizeResultFunc: method is nil but AuctionResultRepo.FinalizeResult was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		AuctionID string
+		Outcomes  []notification.Outcome
+	}{
+		Ctx:       ctx,
+		AuctionID: auctionID,
+		Outcomes:  outcomes,
+	}
+	mock.lockFinalizeResult.Lock()
+	mock.calls.FinalizeResult = append(mock.calls.FinalizeResult, callInfo)
+	mock.lockFinalizeResult.Unlock()
+	return mock.FinalizeResultFunc(ctx, auctionID, outcomes)
+}
+
+// FinalizeResultCalls gets all the calls that were made to FinalizeResult.
+// Check the length with:
+//
+//	len(mockedAuctionResultRepo.FinalizeResultCalls())
+func (mock *AuctionResultRepoMock) FinalizeResultCalls() []struct {
+	Ctx       context.Context
+	AuctionID string
+	Outcomes  []notification.Outcome
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AuctionID string
+		Outcomes  []notification.Outcome
+	}
+	mock.lockFinalizeResult.RLock()
+	calls = mock.calls.FinalizeResult
+	mock.lockFinalizeResult.RUnlock()
+	return calls
+}
+
 // Find calls FindFunc.
 func (mock *AuctionResultRepoMock) Find(ctx context.Context, auctionID string) (*notification.AuctionResult, error) {
 	if mock.FindFunc == nil {
@@ -139,6 +276,50 @@ func (mock *AuctionResultRepoMock) FindCalls() []struct {
 	return calls
 }
 
+// MarkNotificationSent calls MarkNotificationSentFunc.
+func (mock *AuctionResultRepoMock) MarkNotificationSent(ctx context.Context, auctionID string, bid notification.Bid, notificationType string) error {
+	if mock.MarkNotificationSentFunc == nil {
+		panic("AuctionResultRepoMock.MarkNotificationSentFunc: method is nil but AuctionResultRepo.MarkNotificationSent was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		AuctionID        string
+		Bid              notification.Bid
+		NotificationType string
+	}{
+		Ctx:              ctx,
+		AuctionID:        auctionID,
+		Bid:              bid,
+		NotificationType: notificationType,
+	}
+	mock.lockMarkNotificationSent.Lock()
+	mock.calls.MarkNotificationSent = append(mock.calls.MarkNotificationSent, callInfo)
+	mock.lockMarkNotificationSent.Unlock()
+	return mock.MarkNotificationSentFunc(ctx, auctionID, bid, notificationType)
+}
+
+// MarkNotificationSentCalls gets all the calls that were made to MarkNotificationSent.
+// Check the length with:
+//
+//	len(mockedAuctionResultRepo.MarkNotificationSentCalls())
+func (mock *AuctionResultRepoMock) MarkNotificationSentCalls() []struct {
+	Ctx              context.Context
+	AuctionID        string
+	Bid              notification.Bid
+	NotificationType string
+} {
+	var calls []struct {
+		Ctx              context.Context
+		AuctionID        string
+		Bid              notification.Bid
+		NotificationType string
+	}
+	mock.lockMarkNotificationSent.RLock()
+	calls = mock.calls.MarkNotificationSent
+	mock.lockMarkNotificationSent.RUnlock()
+	return calls
+}
+
 // Ensure, that SenderMock does implement notification.Sender.
 // If this is not the case, regenerate this file with moq.
 var _ notification.Sender = &SenderMock{}
@@ -294,3 +475,453 @@ func (mock *ConfigFetcherMock) FetchByUIDCachedCalls() []struct {
 	mock.lockFetchByUIDCached.RUnlock()
 	return calls
 }
+
+// Ensure, that DeliveryStoreMock does implement notification.DeliveryStore.
+// If this is not the case, regenerate this file with moq.
+var _ notification.DeliveryStore = &DeliveryStoreMock{}
+
+// DeliveryStoreMock is a mock implementation of notification.DeliveryStore.
+//
+//	func TestSomethingThatUsesDeliveryStore(t *testing.T) {
+//
+//		// make and configure a mocked notification.DeliveryStore
+//		mockedDeliveryStore := &DeliveryStoreMock{
+//			AckFunc: func(ctx context.Context, d notification.Delivery) error {
+//				panic("mock out the Ack method")
+//			},
+//			DeadLetterFunc: func(ctx context.Context, d notification.Delivery) error {
+//				panic("mock out the DeadLetter method")
+//			},
+//			EnqueueFunc: func(ctx context.Context, d notification.Delivery) error {
+//				panic("mock out the Enqueue method")
+//			},
+//			PromoteDueFunc: func(ctx context.Context, now time.Time) error {
+//				panic("mock out the PromoteDue method")
+//			},
+//			ReadFunc: func(ctx context.Context, consumer string, count int) ([]notification.Delivery, error) {
+//				panic("mock out the Read method")
+//			},
+//			RetryFunc: func(ctx context.Context, d notification.Delivery, at time.Time) error {
+//				panic("mock out the Retry method")
+//			},
+//			SetupFunc: func(ctx context.Context) error {
+//				panic("mock out the Setup method")
+//			},
+//		}
+//
+//		// use mockedDeliveryStore in code that requires notification.DeliveryStore
+//		// and then make assertions.
+//
+//	}
+type DeliveryStoreMock struct {
+	// AckFunc mocks the Ack method.
+	AckFunc func(ctx context.Context, d notification.Delivery) error
+
+	// DeadLetterFunc mocks the DeadLetter method.
+	DeadLetterFunc func(ctx context.Context, d notification.Delivery) error
+
+	// EnqueueFunc mocks the Enqueue method.
+	EnqueueFunc func(ctx context.Context, d notification.Delivery) error
+
+	// PromoteDueFunc mocks the PromoteDue method.
+	PromoteDueFunc func(ctx context.Context, now time.Time) error
+
+	// ReadFunc mocks the Read method.
+	ReadFunc func(ctx context.Context, consumer string, count int) ([]notification.Delivery, error)
+
+	// RetryFunc mocks the Retry method.
+	RetryFunc func(ctx context.Context, d notification.Delivery, at time.Time) error
+
+	// SetupFunc mocks the Setup method.
+	SetupFunc func(ctx context.Context) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Ack holds details about calls to the Ack method.
+		Ack []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// D is the d argument value.
+			D notification.Delivery
+		}
+		// DeadLetter holds details about calls to the DeadLetter method.
+		DeadLetter []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// D is the d argument value.
+			D notification.Delivery
+		}
+		// Enqueue holds details about calls to the Enqueue method.
+		Enqueue []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// D is the d argument value.
+			D notification.Delivery
+		}
+		// PromoteDue holds details about calls to the PromoteDue method.
+		PromoteDue []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+		}
+		// Read holds details about calls to the Read method.
+		Read []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Consumer is the consumer argument value.
+			Consumer string
+			// Count is the count argument value.
+			Count int
+		}
+		// Retry holds details about calls to the Retry method.
+		Retry []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// D is the d argument value.
+			D notification.Delivery
+			// At is the at argument value.
+			At time.Time
+		}
+		// Setup holds details about calls to the Setup method.
+		Setup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockAck        sync.RWMutex
+	lockDeadLetter sync.RWMutex
+	lockEnqueue    sync.RWMutex
+	lockPromoteDue sync.RWMutex
+	lockRead       sync.RWMutex
+	lockRetry      sync.RWMutex
+	lockSetup      sync.RWMutex
+}
+
+// Ack calls AckFunc.
+func (mock *DeliveryStoreMock) Ack(ctx context.Context, d notification.Delivery) error {
+	if mock.AckFunc == nil {
+		panic("DeliveryStoreMock.AckFunc: method is nil but DeliveryStore.Ack was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		D   notification.Delivery
+	}{
+		Ctx: ctx,
+		D:   d,
+	}
+	mock.lockAck.Lock()
+	mock.calls.Ack = append(mock.calls.Ack, callInfo)
+	mock.lockAck.Unlock()
+	return mock.AckFunc(ctx, d)
+}
+
+// AckCalls gets all the calls that were made to Ack.
+// Check the length with:
+//
+//	len(mockedDeliveryStore.AckCalls())
+func (mock *DeliveryStoreMock) AckCalls() []struct {
+	Ctx context.Context
+	D   notification.Delivery
+} {
+	var calls []struct {
+		Ctx context.Context
+		D   notification.Delivery
+	}
+	mock.lockAck.RLock()
+	calls = mock.calls.Ack
+	mock.lockAck.RUnlock()
+	return calls
+}
+
+// DeadLetter calls DeadLetterFunc.
+func (mock *DeliveryStoreMock) DeadLetter(ctx context.Context, d notification.Delivery) error {
+	if mock.DeadLetterFunc == nil {
+		panic("DeliveryStoreMock.DeadLetterFunc: method is nil but DeliveryStore.DeadLetter was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		D   notification.Delivery
+	}{
+		Ctx: ctx,
+		D:   d,
+	}
+	mock.lockDeadLetter.Lock()
+	mock.calls.DeadLetter = append(mock.calls.DeadLetter, callInfo)
+	mock.lockDeadLetter.Unlock()
+	return mock.DeadLetterFunc(ctx, d)
+}
+
+// DeadLetterCalls gets all the calls that were made to DeadLetter.
+// Check the length with:
+//
+//	len(mockedDeliveryStore.DeadLetterCalls())
+func (mock *DeliveryStoreMock) DeadLetterCalls() []struct {
+	Ctx context.Context
+	D   notification.Delivery
+} {
+	var calls []struct {
+		Ctx context.Context
+		D   notification.Delivery
+	}
+	mock.lockDeadLetter.RLock()
+	calls = mock.calls.DeadLetter
+	mock.lockDeadLetter.RUnlock()
+	return calls
+}
+
+// Enqueue calls EnqueueFunc.
+func (mock *DeliveryStoreMock) Enqueue(ctx context.Context, d notification.Delivery) error {
+	if mock.EnqueueFunc == nil {
+		panic("DeliveryStoreMock.EnqueueFunc: method is nil but DeliveryStore.Enqueue was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		D   notification.Delivery
+	}{
+		Ctx: ctx,
+		D:   d,
+	}
+	mock.lockEnqueue.Lock()
+	mock.calls.Enqueue = append(mock.calls.Enqueue, callInfo)
+	mock.lockEnqueue.Unlock()
+	return mock.EnqueueFunc(ctx, d)
+}
+
+// EnqueueCalls gets all the calls that were made to Enqueue.
+// Check the length with:
+//
+//	len(mockedDeliveryStore.EnqueueCalls())
+func (mock *DeliveryStoreMock) EnqueueCalls() []struct {
+	Ctx context.Context
+	D   notification.Delivery
+} {
+	var calls []struct {
+		Ctx context.Context
+		D   notification.Delivery
+	}
+	mock.lockEnqueue.RLock()
+	calls = mock.calls.Enqueue
+	mock.lockEnqueue.RUnlock()
+	return calls
+}
+
+// PromoteDue calls PromoteDueFunc.
+func (mock *DeliveryStoreMock) PromoteDue(ctx context.Context, now time.Time) error {
+	if mock.PromoteDueFunc == nil {
+		panic("DeliveryStoreMock.PromoteDueFunc: method is nil but DeliveryStore.PromoteDue was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Now time.Time
+	}{
+		Ctx: ctx,
+		Now: now,
+	}
+	mock.lockPromoteDue.Lock()
+	mock.calls.PromoteDue = append(mock.calls.PromoteDue, callInfo)
+	mock.lockPromoteDue.Unlock()
+	return mock.PromoteDueFunc(ctx, now)
+}
+
+// PromoteDueCalls gets all the calls that were made to PromoteDue.
+// Check the length with:
+//
+//	len(mockedDeliveryStore.PromoteDueCalls())
+func (mock *DeliveryStoreMock) PromoteDueCalls() []struct {
+	Ctx context.Context
+	Now time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		Now time.Time
+	}
+	mock.lockPromoteDue.RLock()
+	calls = mock.calls.PromoteDue
+	mock.lockPromoteDue.RUnlock()
+	return calls
+}
+
+// Read calls ReadFunc.
+func (mock *DeliveryStoreMock) Read(ctx context.Context, consumer string, count int) ([]notification.Delivery, error) {
+	if mock.ReadFunc == nil {
+		panic("DeliveryStoreMock.ReadFunc: method is nil but DeliveryStore.Read was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Consumer string
+		Count    int
+	}{
+		Ctx:      ctx,
+		Consumer: consumer,
+		Count:    count,
+	}
+	mock.lockRead.Lock()
+	mock.calls.Read = append(mock.calls.Read, callInfo)
+	mock.lockRead.Unlock()
+	return mock.ReadFunc(ctx, consumer, count)
+}
+
+// ReadCalls gets all the calls that were made to Read.
+// Check the length with:
+//
+//	len(mockedDeliveryStore.ReadCalls())
+func (mock *DeliveryStoreMock) ReadCalls() []struct {
+	Ctx      context.Context
+	Consumer string
+	Count    int
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Consumer string
+		Count    int
+	}
+	mock.lockRead.RLock()
+	calls = mock.calls.Read
+	mock.lockRead.RUnlock()
+	return calls
+}
+
+// Retry calls RetryFunc.
+func (mock *DeliveryStoreMock) Retry(ctx context.Context, d notification.Delivery, at time.Time) error {
+	if mock.RetryFunc == nil {
+		panic("DeliveryStoreMock.RetryFunc: method is nil but DeliveryStore.Retry was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		D   notification.Delivery
+		At  time.Time
+	}{
+		Ctx: ctx,
+		D:   d,
+		At:  at,
+	}
+	mock.lockRetry.Lock()
+	mock.calls.Retry = append(mock.calls.Retry, callInfo)
+	mock.lockRetry.Unlock()
+	return mock.RetryFunc(ctx, d, at)
+}
+
+// RetryCalls gets all the calls that were made to Retry.
+// Check the length with:
+//
+//	len(mockedDeliveryStore.RetryCalls())
+func (mock *DeliveryStoreMock) RetryCalls() []struct {
+	Ctx context.Context
+	D   notification.Delivery
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		D   notification.Delivery
+		At  time.Time
+	}
+	mock.lockRetry.RLock()
+	calls = mock.calls.Retry
+	mock.lockRetry.RUnlock()
+	return calls
+}
+
+// Setup calls SetupFunc.
+func (mock *DeliveryStoreMock) Setup(ctx context.Context) error {
+	if mock.SetupFunc == nil {
+		panic("DeliveryStoreMock.SetupFunc: method is nil but DeliveryStore.Setup was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockSetup.Lock()
+	mock.calls.Setup = append(mock.calls.Setup, callInfo)
+	mock.lockSetup.Unlock()
+	return mock.SetupFunc(ctx)
+}
+
+// SetupCalls gets all the calls that were made to Setup.
+// Check the length with:
+//
+//	len(mockedDeliveryStore.SetupCalls())
+func (mock *DeliveryStoreMock) SetupCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockSetup.RLock()
+	calls = mock.calls.Setup
+	mock.lockSetup.RUnlock()
+	return calls
+}
+
+// Ensure, that DelivererMock does implement notification.Deliverer.
+// If this is not the case, regenerate this file with moq.
+var _ notification.Deliverer = &DelivererMock{}
+
+// DelivererMock is a mock implementation of notification.Deliverer.
+//
+//	func TestSomethingThatUsesDeliverer(t *testing.T) {
+//
+//		// make and configure a mocked notification.Deliverer
+//		mockedDeliverer := &DelivererMock{
+//			DeliverFunc: func(ctx context.Context, p notification.Params) (string, error) {
+//				panic("mock out the Deliver method")
+//			},
+//		}
+//
+//		// use mockedDeliverer in code that requires notification.Deliverer
+//		// and then make assertions.
+//
+//	}
+type DelivererMock struct {
+	// DeliverFunc mocks the Deliver method.
+	DeliverFunc func(ctx context.Context, p notification.Params) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Deliver holds details about calls to the Deliver method.
+		Deliver []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// P is the p argument value.
+			P notification.Params
+		}
+	}
+	lockDeliver sync.RWMutex
+}
+
+// Deliver calls DeliverFunc.
+func (mock *DelivererMock) Deliver(ctx context.Context, p notification.Params) (string, error) {
+	if mock.DeliverFunc == nil {
+		panic("DelivererMock.DeliverFunc: method is nil but Deliverer.Deliver was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		P   notification.Params
+	}{
+		Ctx: ctx,
+		P:   p,
+	}
+	mock.lockDeliver.Lock()
+	mock.calls.Deliver = append(mock.calls.Deliver, callInfo)
+	mock.lockDeliver.Unlock()
+	return mock.DeliverFunc(ctx, p)
+}
+
+// DeliverCalls gets all the calls that were made to Deliver.
+// Check the length with:
+//
+//	len(mockedDeliverer.DeliverCalls())
+func (mock *DelivererMock) DeliverCalls() []struct {
+	Ctx context.Context
+	P   notification.Params
+} {
+	var calls []struct {
+		Ctx context.Context
+		P   notification.Params
+	}
+	mock.lockDeliver.RLock()
+	calls = mock.calls.Deliver
+	mock.lockDeliver.RUnlock()
+	return calls
+}
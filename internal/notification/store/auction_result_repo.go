@@ -41,20 +41,70 @@ func (r AuctionResultRepo) CreateOrUpdate(ctx context.Context, adObject *schema.
 	return nil
 }
 
-func (r AuctionResultRepo) FinalizeResult(ctx context.Context, statsRequest *schema.Stats) error {
-	if !statsRequest.Result.IsSuccess() {
+// FinalizeResult records outcome of every bid in the hash of auction notifications.
+// Outcome is set only if the bid has none, so concurrent or repeated finalizations agree on a single outcome per bid.
+func (r AuctionResultRepo) FinalizeResult(ctx context.Context, auctionID string, outcomes []notification.Outcome) (*notification.Finalization, error) {
+	key := notificationsKey(auctionID)
+	set := make([]*redis.BoolCmd, len(outcomes))
+	recorded := make([]*redis.StringCmd, len(outcomes))
+	_, err := r.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, outcome := range outcomes {
+			field := outcomeField(outcome.Bid)
+			set[i] = pipe.HSetNX(ctx, key, field, outcome.NotificationType)
+			recorded[i] = pipe.HGet(ctx, key, field)
+		}
+		pipe.Expire(ctx, key, TTL)
 		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record outcomes: %v", err)
+	}
+
+	finalization := &notification.Finalization{}
+	for i, outcome := range outcomes {
+		switch {
+		case set[i].Val():
+			finalization.Pending = append(finalization.Pending, outcome)
+		case recorded[i].Val() != outcome.NotificationType:
+			finalization.Conflicts = append(finalization.Conflicts, notification.Conflict{
+				Outcome:  outcome,
+				Recorded: recorded[i].Val(),
+			})
+		}
+	}
+
+	if len(finalization.Pending) > 0 {
+		_, err = r.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, outcome := range finalization.Pending {
+				pipe.HSet(ctx, key, stateField(outcome.Bid, outcome.NotificationType), notification.NotificationPending)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("mark notifications pending: %v", err)
+		}
 	}
 
-	winningPrice := statsRequest.Result.Price
-	fmt.Println(winningPrice)
-	auctionResult, err := r.Find(ctx, statsRequest.AuctionID)
+	return finalization, nil
+}
+
+func (r AuctionResultRepo) ClaimNotification(ctx context.Context, auctionID string, bid notification.Bid, notificationType string) (bool, error) {
+	key := notificationsKey(auctionID)
+	var claimed *redis.BoolCmd
+	_, err := r.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		claimed = pipe.HSetNX(ctx, key, stateField(bid, notificationType), notification.NotificationPending)
+		pipe.Expire(ctx, key, TTL)
+		return nil
+	})
 	if err != nil {
-		return err
+		return false, err
 	}
-	fmt.Println(auctionResult)
 
-	return nil
+	return claimed.Val(), nil
+}
+
+func (r AuctionResultRepo) MarkNotificationSent(ctx context.Context, auctionID string, bid notification.Bid, notificationType string) error {
+	return r.Redis.HSet(ctx, notificationsKey(auctionID), stateField(bid, notificationType), notification.NotificationSent).Err()
 }
 
 func (r AuctionResultRepo) Find(ctx context.Context, auctionID string) (*notification.AuctionResult, error) {
@@ -70,6 +120,26 @@ func (r AuctionResultRepo) Find(ctx context.Context, auctionID string) (*notific
 	}
 }
 
+// notificationsKey is the hash of auction notification state. It has fields <bid>:outcome with notification type
+// decided for the bid and <bid>:<type> with state of the notification, see bidField.
+func notificationsKey(auctionID string) string {
+	return auctionID + ":notifications"
+}
+
+// bidField identifies the bid in the hash of auction notifications as <demand>:<request>:<imp>:<bid>.
+// Bid IDs are optional for some demands, so request and impression IDs keep bids without them apart.
+func bidField(bid notification.Bid) string {
+	return fmt.Sprintf("%s:%s:%s:%s", bid.DemandID, bid.RequestID, bid.ImpID, bid.ID)
+}
+
+func outcomeField(bid notification.Bid) string {
+	return bidField(bid) + ":outcome"
+}
+
+func stateField(bid notification.Bid, notificationType string) string {
+	return bidField(bid) + ":" + notificationType
+}
+
 func (r AuctionResultRepo) Save(ctx context.Context, a *notification.AuctionResult) error {
 	err := r.Redis.Set(ctx, a.AuctionID, a, TTL).Err()
 	if err != nil {
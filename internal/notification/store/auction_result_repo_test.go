@@ -107,3 +107,67 @@ func TestAuctionResultV2_Save(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestAuctionResultRepo_FinalizeResult(t *testing.T) {
+	ctx := context.Background()
+	won := notification.Outcome{Bid: notification.Bid{ID: "bid-1", ImpID: "imp-1", RequestID: "req-1", DemandID: "vungle"}, NotificationType: "NURL"}
+	repeated := notification.Outcome{Bid: notification.Bid{ID: "bid-2", ImpID: "imp-1", RequestID: "req-2", DemandID: "meta"}, NotificationType: "LURL"}
+	conflicting := notification.Outcome{Bid: notification.Bid{ID: "bid-3", ImpID: "imp-1", RequestID: "req-3", DemandID: "mintegral"}, NotificationType: "NURL"}
+	// Bids without IDs are told apart by impression.
+	lostWithoutID := notification.Outcome{Bid: notification.Bid{ImpID: "imp-1", RequestID: "req-4", DemandID: "amazon"}, NotificationType: "LURL"}
+	otherLostWithoutID := notification.Outcome{Bid: notification.Bid{ImpID: "imp-2", RequestID: "req-4", DemandID: "amazon"}, NotificationType: "LURL"}
+
+	rdb, mock := redismock.NewClusterMock()
+	mock.ExpectHSetNX("auction-1:notifications", "vungle:req-1:imp-1:bid-1:outcome", "NURL").SetVal(true)
+	mock.ExpectHGet("auction-1:notifications", "vungle:req-1:imp-1:bid-1:outcome").SetVal("NURL")
+	mock.ExpectHSetNX("auction-1:notifications", "meta:req-2:imp-1:bid-2:outcome", "LURL").SetVal(false)
+	mock.ExpectHGet("auction-1:notifications", "meta:req-2:imp-1:bid-2:outcome").SetVal("LURL")
+	mock.ExpectHSetNX("auction-1:notifications", "mintegral:req-3:imp-1:bid-3:outcome", "NURL").SetVal(false)
+	mock.ExpectHGet("auction-1:notifications", "mintegral:req-3:imp-1:bid-3:outcome").SetVal("LURL")
+	mock.ExpectHSetNX("auction-1:notifications", "amazon:req-4:imp-1::outcome", "LURL").SetVal(true)
+	mock.ExpectHGet("auction-1:notifications", "amazon:req-4:imp-1::outcome").SetVal("LURL")
+	mock.ExpectHSetNX("auction-1:notifications", "amazon:req-4:imp-2::outcome", "LURL").SetVal(true)
+	mock.ExpectHGet("auction-1:notifications", "amazon:req-4:imp-2::outcome").SetVal("LURL")
+	mock.ExpectExpire("auction-1:notifications", 4*time.Hour).SetVal(true)
+	mock.ExpectHSet("auction-1:notifications", "vungle:req-1:imp-1:bid-1:NURL", "PENDING").SetVal(1)
+	mock.ExpectHSet("auction-1:notifications", "amazon:req-4:imp-1::LURL", "PENDING").SetVal(1)
+	mock.ExpectHSet("auction-1:notifications", "amazon:req-4:imp-2::LURL", "PENDING").SetVal(1)
+
+	repo := store.AuctionResultRepo{Redis: rdb}
+	got, err := repo.FinalizeResult(ctx, "auction-1", []notification.Outcome{won, repeated, conflicting, lostWithoutID, otherLostWithoutID})
+	if err != nil {
+		t.Fatalf("FinalizeResult() = %v, want nil", err)
+	}
+
+	want := &notification.Finalization{
+		Pending:   []notification.Outcome{won, lostWithoutID, otherLostWithoutID},
+		Conflicts: []notification.Conflict{{Outcome: conflicting, Recorded: "LURL"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FinalizeResult() mismatch (-want +got):\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAuctionResultRepo_ClaimNotification(t *testing.T) {
+	ctx := context.Background()
+	bid := notification.Bid{ID: "bid-1", ImpID: "imp-1", RequestID: "req-1", DemandID: "vungle"}
+
+	rdb, mock := redismock.NewClusterMock()
+	mock.ExpectHSetNX("auction-1:notifications", "vungle:req-1:imp-1:bid-1:BURL", "PENDING").SetVal(false)
+	mock.ExpectExpire("auction-1:notifications", 4*time.Hour).SetVal(true)
+
+	repo := store.AuctionResultRepo{Redis: rdb}
+	claimed, err := repo.ClaimNotification(ctx, "auction-1", bid, "BURL")
+	if err != nil {
+		t.Fatalf("ClaimNotification() = %v, want nil", err)
+	}
+	if claimed {
+		t.Error("ClaimNotification() = true, want false for notification claimed before")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,201 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/bidon-io/bidon-backend/internal/notification"
+)
+
+// Keys of the delivery queue share hash tag, so that they are stored in the same cluster slot and can be pipelined together.
+const (
+	deliveryStreamKey     = "notifications:{delivery}:stream"
+	deliveryRetryKey      = "notifications:{delivery}:retry"
+	deliveryDeadLetterKey = "notifications:{delivery}:dead"
+	deliveryGroup         = "notification_workers"
+	deliveryField         = "delivery"
+
+	// DefaultClaimAfter is how long a delivery may stay unacknowledged before another consumer takes it over.
+	DefaultClaimAfter = time.Minute
+	readBlock         = time.Second
+	promoteBatchSize  = 100
+	deadLetterMaxLen  = 100_000
+)
+
+// DeliveryQueueRepo keeps notification deliveries in a Redis stream read by a consumer group.
+// Deliveries waiting for retry are kept in a sorted set scored by retry time,
+// deliveries that ran out of attempts are moved to a dead-letter stream.
+// Delivery is at-least-once: a delivery may be sent twice if the process dies between sending it and acknowledging.
+type DeliveryQueueRepo struct {
+	Redis      *redis.ClusterClient
+	ClaimAfter time.Duration
+}
+
+func (r *DeliveryQueueRepo) Setup(ctx context.Context) error {
+	err := r.Redis.XGroupCreateMkStream(ctx, deliveryStreamKey, deliveryGroup, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("create consumer group: %v", err)
+	}
+
+	return nil
+}
+
+func (r *DeliveryQueueRepo) Enqueue(ctx context.Context, d notification.Delivery) error {
+	d.ID = ""
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("marshal delivery: %v", err)
+	}
+
+	return r.Redis.XAdd(ctx, &redis.XAddArgs{
+		Stream: deliveryStreamKey,
+		Values: map[string]any{deliveryField: data},
+	}).Err()
+}
+
+// Read first takes over deliveries abandoned by other consumers, then waits for new ones.
+func (r *DeliveryQueueRepo) Read(ctx context.Context, consumer string, count int) ([]notification.Delivery, error) {
+	claimed, _, err := r.Redis.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   deliveryStreamKey,
+		Group:    deliveryGroup,
+		Consumer: consumer,
+		MinIdle:  r.claimAfter(),
+		Start:    "0",
+		Count:    int64(count),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("claim deliveries: %v", err)
+	}
+	if len(claimed) > 0 {
+		return r.parseDeliveries(ctx, claimed), nil
+	}
+
+	streams, err := r.Redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    deliveryGroup,
+		Consumer: consumer,
+		Streams:  []string{deliveryStreamKey, ">"},
+		Count:    int64(count),
+		Block:    readBlock,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read deliveries: %v", err)
+	}
+
+	var deliveries []notification.Delivery
+	for _, stream := range streams {
+		deliveries = append(deliveries, r.parseDeliveries(ctx, stream.Messages)...)
+	}
+
+	return deliveries, nil
+}
+
+func (r *DeliveryQueueRepo) Ack(ctx context.Context, d notification.Delivery) error {
+	_, err := r.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		remove(ctx, pipe, d)
+		return nil
+	})
+
+	return err
+}
+
+func (r *DeliveryQueueRepo) Retry(ctx context.Context, d notification.Delivery, at time.Time) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("marshal delivery: %v", err)
+	}
+
+	_, err = r.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, deliveryRetryKey, redis.Z{Score: float64(at.UnixMilli()), Member: data})
+		remove(ctx, pipe, d)
+		return nil
+	})
+
+	return err
+}
+
+// promoteDueScript moves due deliveries from the retry set (KEYS[1]) to the stream (KEYS[2]).
+// Deliveries are added to the stream and removed from the set atomically, so a delivery is neither lost nor duplicated
+// if promotion fails or runs concurrently. Member keeps ID of the stream entry it was retried from,
+// it's replaced with ID of the new entry when the delivery is read.
+var promoteDueScript = redis.NewScript(`
+local members = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, member in ipairs(members) do
+	redis.call('XADD', KEYS[2], '*', ARGV[3], member)
+	redis.call('ZREM', KEYS[1], member)
+end
+return #members
+`)
+
+// PromoteDue moves due deliveries from the retry set back to the stream.
+func (r *DeliveryQueueRepo) PromoteDue(ctx context.Context, now time.Time) error {
+	keys := []string{deliveryRetryKey, deliveryStreamKey}
+	err := promoteDueScript.Run(ctx, r.Redis, keys, now.UnixMilli(), promoteBatchSize, deliveryField).Err()
+	if err != nil {
+		return fmt.Errorf("promote due deliveries: %v", err)
+	}
+
+	return nil
+}
+
+func (r *DeliveryQueueRepo) DeadLetter(ctx context.Context, d notification.Delivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("marshal delivery: %v", err)
+	}
+
+	_, err = r.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: deliveryDeadLetterKey,
+			MaxLen: deadLetterMaxLen,
+			Approx: true,
+			Values: map[string]any{deliveryField: data},
+		})
+		remove(ctx, pipe, d)
+		return nil
+	})
+
+	return err
+}
+
+func (r *DeliveryQueueRepo) claimAfter() time.Duration {
+	if r.ClaimAfter > 0 {
+		return r.ClaimAfter
+	}
+
+	return DefaultClaimAfter
+}
+
+// remove acknowledges delivery and deletes it from the stream.
+func remove(ctx context.Context, pipe redis.Pipeliner, d notification.Delivery) {
+	pipe.XAck(ctx, deliveryStreamKey, deliveryGroup, d.ID)
+	pipe.XDel(ctx, deliveryStreamKey, d.ID)
+}
+
+// parseDeliveries drops malformed messages from the stream, so that they are not claimed over and over again.
+func (r *DeliveryQueueRepo) parseDeliveries(ctx context.Context, messages []redis.XMessage) []notification.Delivery {
+	deliveries := make([]notification.Delivery, 0, len(messages))
+	for _, msg := range messages {
+		data, _ := msg.Values[deliveryField].(string)
+
+		var d notification.Delivery
+		if err := json.Unmarshal([]byte(data), &d); err != nil {
+			log.Printf("DeliveryQueueRepo: drop malformed delivery %s: %v", msg.ID, err)
+			r.Ack(ctx, notification.Delivery{ID: msg.ID}) //nolint:errcheck
+			continue
+		}
+		d.ID = msg.ID
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries
+}
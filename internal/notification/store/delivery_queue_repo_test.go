@@ -0,0 +1,102 @@
+package store_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/go-cmp/cmp"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/bidon-io/bidon-backend/internal/notification"
+	"github.com/bidon-io/bidon-backend/internal/notification/store"
+)
+
+func TestDeliveryQueueRepo_Read(t *testing.T) {
+	ctx := context.Background()
+	rdb, mock := redismock.NewClusterMock()
+	repo := &store.DeliveryQueueRepo{Redis: rdb}
+
+	d := notification.Delivery{Params: notification.Params{NotificationType: "NURL", URL: "https://dsp.com/win"}, Attempts: 1}
+	data, _ := json.Marshal(d)
+
+	mock.ExpectXAutoClaim(&redis.XAutoClaimArgs{
+		Stream:   "notifications:{delivery}:stream",
+		Group:    "notification_workers",
+		Consumer: "pod-1",
+		MinIdle:  store.DefaultClaimAfter,
+		Start:    "0",
+		Count:    1,
+	}).SetVal([]redis.XMessage{}, "0-0")
+	mock.ExpectXReadGroup(&redis.XReadGroupArgs{
+		Group:    "notification_workers",
+		Consumer: "pod-1",
+		Streams:  []string{"notifications:{delivery}:stream", ">"},
+		Count:    1,
+		Block:    time.Second,
+	}).SetVal([]redis.XStream{{
+		Stream:   "notifications:{delivery}:stream",
+		Messages: []redis.XMessage{{ID: "1-0", Values: map[string]any{"delivery": string(data)}}},
+	}})
+
+	got, err := repo.Read(ctx, "pod-1", 1)
+	if err != nil {
+		t.Fatalf("Read() = %v, want nil", err)
+	}
+
+	d.ID = "1-0"
+	if diff := cmp.Diff([]notification.Delivery{d}, got); diff != "" {
+		t.Errorf("Read() mismatch (-want +got):\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeliveryQueueRepo_Retry(t *testing.T) {
+	ctx := context.Background()
+	rdb, mock := redismock.NewClusterMock()
+	repo := &store.DeliveryQueueRepo{Redis: rdb}
+
+	d := notification.Delivery{ID: "1-0", Params: notification.Params{NotificationType: "NURL"}, Attempts: 1}
+	data, _ := json.Marshal(d)
+	at := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectZAdd("notifications:{delivery}:retry", redis.Z{Score: float64(at.UnixMilli()), Member: data}).SetVal(1)
+	mock.ExpectXAck("notifications:{delivery}:stream", "notification_workers", "1-0").SetVal(1)
+	mock.ExpectXDel("notifications:{delivery}:stream", "1-0").SetVal(1)
+
+	if err := repo.Retry(ctx, d, at); err != nil {
+		t.Fatalf("Retry() = %v, want nil", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeliveryQueueRepo_PromoteDue(t *testing.T) {
+	ctx := context.Background()
+	rdb, mock := redismock.NewClusterMock()
+	repo := &store.DeliveryQueueRepo{Redis: rdb}
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	keys := []string{"notifications:{delivery}:retry", "notifications:{delivery}:stream"}
+
+	// Due deliveries are moved by a single script, so that they are not lost if moving fails halfway.
+	mock.Regexp().ExpectEvalSha("^[0-9a-f]{40}$", keys, "^1792238400000$", "^100$", "^delivery$").SetVal(int64(2))
+	if err := repo.PromoteDue(ctx, now); err != nil {
+		t.Fatalf("PromoteDue() = %v, want nil", err)
+	}
+
+	mock.Regexp().ExpectEvalSha("^[0-9a-f]{40}$", keys, "^1792238400000$", "^100$", "^delivery$").SetErr(errors.New("connection reset"))
+	if err := repo.PromoteDue(ctx, now); err == nil {
+		t.Errorf("PromoteDue() = nil, want error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
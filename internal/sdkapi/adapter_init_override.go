@@ -0,0 +1,84 @@
+package sdkapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+)
+
+// AdapterInitOverride customizes adapter init configs returned to SDK for an app or for a segment of an app.
+type AdapterInitOverride struct {
+	// SegmentID is 0 for app-wide override.
+	SegmentID int64
+	// Adapters restricts returned adapters to the listed ones. Empty list means no restriction.
+	Adapters []adapter.Key
+	// Patches are JSON objects merged into init configs of corresponding adapters.
+	Patches map[adapter.Key]json.RawMessage
+}
+
+// FindAdapterInitOverride returns override for the segment, falling back to app-wide override.
+// Returns nil if neither exists.
+func FindAdapterInitOverride(overrides []AdapterInitOverride, segmentID int64) *AdapterInitOverride {
+	var appOverride *AdapterInitOverride
+
+	for i := range overrides {
+		switch overrides[i].SegmentID {
+		case 0:
+			appOverride = &overrides[i]
+		case segmentID:
+			return &overrides[i]
+		}
+	}
+
+	return appOverride
+}
+
+// Allows reports whether adapter should be returned to SDK.
+func (o *AdapterInitOverride) Allows(key adapter.Key) bool {
+	if o == nil || len(o.Adapters) == 0 {
+		return true
+	}
+
+	return slices.Contains(o.Adapters, key)
+}
+
+// Patch merges patch defined for config adapter into config.
+// Config is left unchanged if patch doesn't fit config type.
+func (o *AdapterInitOverride) Patch(config AdapterInitConfig) error {
+	if o == nil {
+		return nil
+	}
+
+	patch, ok := o.Patches[config.Key()]
+	if !ok {
+		return nil
+	}
+
+	// Unmarshal stops at the first mismatching field, so the patch is tried on a blank config first
+	// to not leave config partially patched.
+	blank, err := NewAdapterInitConfig(config.Key(), false)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(patch, blank); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(patch, config)
+}
+
+// ValidateAdapterInitPatch checks that patch unmarshals into init config of the adapter
+// and sets only fields known to it.
+func ValidateAdapterInitPatch(key adapter.Key, patch []byte) error {
+	config, err := NewAdapterInitConfig(key, false)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(patch))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(config)
+}
@@ -0,0 +1,119 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+)
+
+const (
+	// DefaultBatchSize is the number of messages of a topic sent at once by batching engines if BatchSize is not set.
+	DefaultBatchSize = 1000
+	// DefaultFlushInterval is how often batching engines send incomplete batches if interval is not set.
+	DefaultFlushInterval = 5 * time.Second
+)
+
+// batcher buffers messages by topic until they are sent in a batch.
+type batcher struct {
+	mu      sync.Mutex
+	batches map[config.Topic][][]byte
+	// lastErr is the error of the last sent batch, reported by Ping.
+	lastErr error
+}
+
+// add buffers the message and returns the batch of its topic if it reached size.
+func (b *batcher) add(message event.LogMessage, size int) ([][]byte, bool) {
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.batches == nil {
+		b.batches = make(map[config.Topic][][]byte)
+	}
+	batch := append(b.batches[message.Topic], message.Value)
+	if len(batch) < size {
+		b.batches[message.Topic] = batch
+		return nil, false
+	}
+
+	delete(b.batches, message.Topic)
+	return batch, true
+}
+
+// drain returns all buffered batches.
+func (b *batcher) drain() map[config.Topic][][]byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	batches := b.batches
+	b.batches = nil
+
+	return batches
+}
+
+func (b *batcher) setErr(err error) {
+	b.mu.Lock()
+	b.lastErr = err
+	b.mu.Unlock()
+}
+
+func (b *batcher) err() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.lastErr
+}
+
+// flush sends all buffered batches with send.
+func (b *batcher) flush(ctx context.Context, send func(context.Context, config.Topic, [][]byte) error) error {
+	var errs []error
+	for topic, batch := range b.drain() {
+		errs = append(errs, send(ctx, topic, batch))
+	}
+
+	return errors.Join(errs...)
+}
+
+// run flushes batches every interval until ctx is done, then flushes the rest.
+func (b *batcher) run(ctx context.Context, interval time.Duration, send func(context.Context, config.Topic, [][]byte) error, handleErr func(error)) {
+	if interval <= 0 {
+		interval = DefaultFlushInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+			defer cancel()
+			if err := b.flush(flushCtx, send); err != nil {
+				handleErr(err)
+			}
+			return
+		case <-ticker.C:
+			if err := b.flush(ctx, send); err != nil {
+				handleErr(err)
+			}
+		}
+	}
+}
+
+// ndjson joins values into newline delimited JSON.
+func ndjson(values [][]byte) []byte {
+	var buf bytes.Buffer
+	for _, value := range values {
+		buf.Write(value)
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,96 @@
+package engine_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event/engine"
+)
+
+type recordedRequest struct {
+	Query  string
+	Header string
+	Body   string
+}
+
+func recordingServer(t *testing.T, header string) (*httptest.Server, func() []recordedRequest) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests = append(requests, recordedRequest{Query: r.URL.RawQuery, Header: r.Header.Get(header), Body: string(body)})
+		mu.Unlock()
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []recordedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func TestHTTPBatch_Flush(t *testing.T) {
+	server, requests := recordingServer(t, "X-Event-Topic")
+	e := &engine.HTTPBatch{URL: server.URL, Client: server.Client(), BatchSize: 10}
+
+	e.Produce(event.LogMessage{Topic: config.AdEventsTopic, Value: []byte(`{"a":1}`)}, func(err error) { t.Error(err) })
+	e.Produce(event.LogMessage{Topic: config.AdEventsTopic, Value: []byte(`{"a":2}`)}, func(err error) { t.Error(err) })
+	if got := len(requests()); got != 0 {
+		t.Fatalf("requests before flush = %d, want 0", got)
+	}
+
+	if err := e.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() = %v, want nil", err)
+	}
+
+	want := []recordedRequest{{Header: "ad_events", Body: "{\"a\":1}\n{\"a\":2}\n"}}
+	if diff := cmp.Diff(want, requests()); diff != "" {
+		t.Errorf("requests mismatch (-want +got):\n%s", diff)
+	}
+	if err := e.Ping(context.Background()); err != nil {
+		t.Errorf("Ping() = %v, want nil", err)
+	}
+}
+
+func TestClickHouse_Flush(t *testing.T) {
+	server, requests := recordingServer(t, "X-ClickHouse-User")
+	e := &engine.ClickHouse{
+		URL:      server.URL,
+		Database: "bidon",
+		User:     "writer",
+		Tables:   map[config.Topic]string{config.NotificationEventsTopic: "notification_events"},
+		Client:   server.Client(),
+	}
+
+	var errs []error
+	e.Produce(event.LogMessage{Topic: config.NotificationEventsTopic, Value: []byte(`{"n":1}`)}, func(err error) { errs = append(errs, err) })
+	e.Produce(event.LogMessage{Topic: config.AdEventsTopic, Value: []byte(`{"a":1}`)}, func(err error) { errs = append(errs, err) })
+	if len(errs) != 1 {
+		t.Errorf("Produce() errors = %v, want error for topic without table", errs)
+	}
+
+	if err := e.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() = %v, want nil", err)
+	}
+
+	want := []recordedRequest{{
+		Query:  "database=bidon&input_format_skip_unknown_fields=1&query=INSERT+INTO+notification_events+FORMAT+JSONEachRow",
+		Header: "writer",
+		Body:   "{\"n\":1}\n",
+	}}
+	if diff := cmp.Diff(want, requests()); diff != "" {
+		t.Errorf("requests mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,118 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+)
+
+// ClickHouse inserts messages into ClickHouse tables in batches through its HTTP interface, using JSONEachRow format.
+// Fields of events missing in the table are skipped. Batch is inserted when it reaches BatchSize,
+// Run inserts incomplete batches periodically.
+type ClickHouse struct {
+	// URL of ClickHouse HTTP interface, e.g. http://localhost:8123.
+	URL      string
+	Database string
+	User     string
+	Password string
+	Tables   map[config.Topic]string
+	Client   *http.Client
+
+	BatchSize int
+
+	batches batcher
+}
+
+func (e *ClickHouse) Produce(message event.LogMessage, handleErr func(error)) {
+	if !message.IsJSON() {
+		handleErr(fmt.Errorf("%q message is not JSON", message.Topic))
+		return
+	}
+
+	if e.Tables[message.Topic] == "" {
+		handleErr(fmt.Errorf("table for %q not set", message.Topic))
+		return
+	}
+
+	if batch, full := e.batches.add(message, e.BatchSize); full {
+		go func() {
+			if err := e.send(context.Background(), message.Topic, batch); err != nil {
+				handleErr(err)
+			}
+		}()
+	}
+}
+
+func (e *ClickHouse) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, e.URL+"/ping", nil)
+	if err != nil {
+		return fmt.Errorf("clickhouse ping: %v", err)
+	}
+
+	resp, err := e.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("clickhouse ping: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("clickhouse ping: unexpected status code: %d", resp.StatusCode)
+	}
+
+	return e.batches.err()
+}
+
+// Run inserts buffered messages every interval until ctx is done, then inserts the rest.
+func (e *ClickHouse) Run(ctx context.Context, interval time.Duration, handleErr func(error)) {
+	e.batches.run(ctx, interval, e.send, handleErr)
+}
+
+// Flush inserts buffered messages.
+func (e *ClickHouse) Flush(ctx context.Context) error {
+	return e.batches.flush(ctx, e.send)
+}
+
+func (e *ClickHouse) send(ctx context.Context, topic config.Topic, batch [][]byte) error {
+	err := e.insert(ctx, topic, batch)
+	e.batches.setErr(err)
+
+	return err
+}
+
+func (e *ClickHouse) insert(ctx context.Context, topic config.Topic, batch [][]byte) error {
+	params := url.Values{}
+	params.Set("query", fmt.Sprintf("INSERT INTO %s FORMAT JSONEachRow", e.Tables[topic]))
+	params.Set("input_format_skip_unknown_fields", "1")
+	if e.Database != "" {
+		params.Set("database", e.Database)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL+"/?"+params.Encode(), bytes.NewReader(ndjson(batch)))
+	if err != nil {
+		return fmt.Errorf("create %q insert request: %v", topic, err)
+	}
+	if e.User != "" {
+		req.Header.Set("X-ClickHouse-User", e.User)
+		req.Header.Set("X-ClickHouse-Key", e.Password)
+	}
+
+	resp, err := e.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("insert %q batch: %v", topic, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("insert %q batch: unexpected status code: %d: %s", topic, resp.StatusCode, body)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+)
+
+// Sink is an engine FanOut routes messages to.
+type Sink struct {
+	Engine event.LoggerEngine
+	// Topics routed to the engine, all topics are routed if empty.
+	Topics []config.Topic
+}
+
+// FanOut produces every message to all sinks routing its topic.
+type FanOut struct {
+	Sinks []Sink
+}
+
+func (e *FanOut) Produce(message event.LogMessage, handleErr func(error)) {
+	for _, sink := range e.Sinks {
+		if len(sink.Topics) == 0 || slices.Contains(sink.Topics, message.Topic) {
+			sink.Engine.Produce(message, handleErr)
+		}
+	}
+}
+
+func (e *FanOut) Ping(ctx context.Context) error {
+	var errs []error
+	for _, sink := range e.Sinks {
+		errs = append(errs, sink.Engine.Ping(ctx))
+	}
+
+	return errors.Join(errs...)
+}
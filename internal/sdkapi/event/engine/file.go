@@ -0,0 +1,151 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
+)
+
+// File writes messages as NDJSON, one file per topic named <topic>.ndjson.
+// File is rotated when it exceeds MaxSize or gets older than MaxAge: it is renamed to <topic>-<rotation time>.ndjson
+// and a new file is started. Rotated files are left for external tools to ship or remove.
+type File struct {
+	Dir string
+	// MaxSize is the size of a file in bytes, zero means no limit.
+	MaxSize int64
+	// MaxAge is how long a file is written to, zero means no limit.
+	MaxAge time.Duration
+	Clock  clock.Clock
+
+	mu    sync.Mutex
+	files map[config.Topic]*topicFile
+}
+
+type topicFile struct {
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+func (e *File) Produce(message event.LogMessage, handleErr func(error)) {
+	if !message.IsJSON() {
+		handleErr(fmt.Errorf("%q message is not JSON", message.Topic))
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	f, err := e.file(message.Topic, int64(len(message.Value))+1)
+	if err != nil {
+		handleErr(err)
+		return
+	}
+
+	line := make([]byte, 0, len(message.Value)+1)
+	n, err := f.file.Write(append(append(line, message.Value...), '\n'))
+	f.size += int64(n)
+	if err != nil {
+		handleErr(fmt.Errorf("write %q file: %v", message.Topic, err))
+	}
+}
+
+func (e *File) Ping(_ context.Context) error {
+	info, err := os.Stat(e.Dir)
+	if err != nil {
+		return fmt.Errorf("event files dir: %v", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("event files dir: %s is not a directory", e.Dir)
+	}
+
+	return nil
+}
+
+// Close closes files of all topics.
+func (e *File) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var errs []error
+	for topic, f := range e.files {
+		errs = append(errs, f.file.Close())
+		delete(e.files, topic)
+	}
+
+	return errors.Join(errs...)
+}
+
+// file returns file of the topic to write n bytes to, rotating it if needed.
+func (e *File) file(topic config.Topic, n int64) (*topicFile, error) {
+	f, ok := e.files[topic]
+	if ok && !e.full(f, n) {
+		return f, nil
+	}
+
+	if ok {
+		if err := e.rotate(topic, f); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := os.OpenFile(e.path(topic), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open %q file: %v", topic, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("stat %q file: %v", topic, err)
+	}
+
+	f = &topicFile{file: file, size: info.Size(), openedAt: e.Clock.Now()}
+	if !ok && e.full(f, n) {
+		// File left by previous process is full already
+		if err := e.rotate(topic, f); err != nil {
+			return nil, err
+		}
+		return e.file(topic, n)
+	}
+
+	if e.files == nil {
+		e.files = make(map[config.Topic]*topicFile)
+	}
+	e.files[topic] = f
+
+	return f, nil
+}
+
+func (e *File) full(f *topicFile, n int64) bool {
+	if e.MaxSize > 0 && f.size > 0 && f.size+n > e.MaxSize {
+		return true
+	}
+
+	return e.MaxAge > 0 && e.Clock.Since(f.openedAt) >= e.MaxAge
+}
+
+func (e *File) rotate(topic config.Topic, f *topicFile) error {
+	delete(e.files, topic)
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("close %q file: %v", topic, err)
+	}
+
+	rotated := filepath.Join(e.Dir, fmt.Sprintf("%s-%s.ndjson", topic, e.Clock.Now().UTC().Format("20060102T150405.000000000")))
+	if err := os.Rename(e.path(topic), rotated); err != nil {
+		return fmt.Errorf("rotate %q file: %v", topic, err)
+	}
+
+	return nil
+}
+
+func (e *File) path(topic config.Topic) string {
+	return filepath.Join(e.Dir, string(topic)+".ndjson")
+}
@@ -0,0 +1,88 @@
+package engine_test
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event/engine"
+	"github.com/bidon-io/bidon-backend/pkg/clock"
+)
+
+func TestFile_Produce(t *testing.T) {
+	dir := t.TempDir()
+	mockTime := clock.NewMock()
+	mockTime.Set(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	e := &engine.File{Dir: dir, MaxSize: 16, MaxAge: time.Hour, Clock: mockTime}
+	defer e.Close()
+
+	handleErr := func(err error) { t.Errorf("Produce() error: %v", err) }
+	e.Produce(event.LogMessage{Topic: config.AdEventsTopic, Value: []byte(`{"a":1}`)}, handleErr)
+	e.Produce(event.LogMessage{Topic: config.AdEventsTopic, Value: []byte(`{"a":2}`)}, handleErr)
+	e.Produce(event.LogMessage{Topic: config.AdEventsTopic, Value: []byte(`{"a":3}`)}, handleErr) // Exceeds MaxSize
+	e.Produce(event.LogMessage{Topic: config.NotificationEventsTopic, Value: []byte(`{"n":1}`)}, handleErr)
+	mockTime.Set(mockTime.Now().Add(time.Hour))
+	e.Produce(event.LogMessage{Topic: config.NotificationEventsTopic, Value: []byte(`{"n":2}`)}, handleErr) // Exceeds MaxAge
+
+	want := map[string]string{
+		"ad_events-20261017T120000.000000000.ndjson":           "{\"a\":1}\n{\"a\":2}\n",
+		"ad_events.ndjson":                                     "{\"a\":3}\n",
+		"notification_events-20261017T130000.000000000.ndjson": "{\"n\":1}\n",
+		"notification_events.ndjson":                           "{\"n\":2}\n",
+	}
+	got := map[string]string{}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got[entry.Name()] = string(data)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("files mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFanOut_Produce(t *testing.T) {
+	all := &recordingEngine{}
+	adEvents := &recordingEngine{}
+	e := &engine.FanOut{Sinks: []engine.Sink{
+		{Engine: all},
+		{Engine: adEvents, Topics: []config.Topic{config.AdEventsTopic}},
+	}}
+
+	e.Produce(event.LogMessage{Topic: config.AdEventsTopic}, func(error) {})
+	e.Produce(event.LogMessage{Topic: config.NotificationEventsTopic}, func(error) {})
+
+	if diff := cmp.Diff([]config.Topic{config.AdEventsTopic, config.NotificationEventsTopic}, sorted(all.topics)); diff != "" {
+		t.Errorf("all topics sink mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]config.Topic{config.AdEventsTopic}, adEvents.topics); diff != "" {
+		t.Errorf("ad events sink mismatch (-want +got):\n%s", diff)
+	}
+}
+
+type recordingEngine struct {
+	engine.Log
+	topics []config.Topic
+}
+
+func (e *recordingEngine) Produce(message event.LogMessage, _ func(error)) {
+	e.topics = append(e.topics, message.Topic)
+}
+
+func sorted(topics []config.Topic) []config.Topic {
+	sort.Slice(topics, func(i, j int) bool { return topics[i] < topics[j] })
+	return topics
+}
@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+)
+
+// HTTPBatch posts messages to a collector in batches of NDJSON, topic of the batch is sent in X-Event-Topic header.
+// Batch is sent when it reaches BatchSize, Run sends incomplete batches periodically.
+type HTTPBatch struct {
+	URL       string
+	Client    *http.Client
+	BatchSize int
+
+	batches batcher
+}
+
+func (e *HTTPBatch) Produce(message event.LogMessage, handleErr func(error)) {
+	if !message.IsJSON() {
+		handleErr(fmt.Errorf("%q message is not JSON", message.Topic))
+		return
+	}
+
+	if batch, full := e.batches.add(message, e.BatchSize); full {
+		go func() {
+			if err := e.send(context.Background(), message.Topic, batch); err != nil {
+				handleErr(err)
+			}
+		}()
+	}
+}
+
+// Ping reports error of the last sent batch.
+func (e *HTTPBatch) Ping(_ context.Context) error {
+	return e.batches.err()
+}
+
+// Run sends buffered messages every interval until ctx is done, then sends the rest.
+func (e *HTTPBatch) Run(ctx context.Context, interval time.Duration, handleErr func(error)) {
+	e.batches.run(ctx, interval, e.send, handleErr)
+}
+
+// Flush sends buffered messages.
+func (e *HTTPBatch) Flush(ctx context.Context) error {
+	return e.batches.flush(ctx, e.send)
+}
+
+func (e *HTTPBatch) send(ctx context.Context, topic config.Topic, batch [][]byte) error {
+	err := e.post(ctx, topic, batch)
+	e.batches.setErr(err)
+
+	return err
+}
+
+func (e *HTTPBatch) post(ctx context.Context, topic config.Topic, batch [][]byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(ndjson(batch)))
+	if err != nil {
+		return fmt.Errorf("create %q batch request: %v", topic, err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set("X-Event-Topic", string(topic))
+
+	resp, err := e.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send %q batch: %v", topic, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("send %q batch: unexpected status code: %d", topic, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -3,16 +3,39 @@ package engine
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync/atomic"
+	"time"
 
 	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/bidon-io/bidon-backend/config"
 	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
 )
 
+// DefaultReplayTimeout is how long a segment of spilled records is produced during replay.
+const DefaultReplayTimeout = 30 * time.Second
+
+// KafkaClient is the part of *kgo.Client used by Kafka.
+type KafkaClient interface {
+	Produce(ctx context.Context, r *kgo.Record, promise func(*kgo.Record, error))
+	TryProduce(ctx context.Context, r *kgo.Record, promise func(*kgo.Record, error))
+	ProduceSync(ctx context.Context, rs ...*kgo.Record) kgo.ProduceResults
+	Ping(ctx context.Context) error
+}
+
+// Kafka produces messages to Kafka topics.
+//
+// If Spill is set, records that fail to be produced or don't fit into the client buffer are written to it,
+// and so are all new records until spilled ones are replayed by Run, keeping records in order.
+// Without Spill records are dropped on failure.
 type Kafka struct {
 	Topics map[config.Topic]string
-	Client *kgo.Client
+	Client KafkaClient
+	Spill  *Spill
+
+	dropped atomic.Int64
 }
 
 func (e *Kafka) Produce(message event.LogMessage, handleErr func(error)) {
@@ -27,16 +50,136 @@ func (e *Kafka) Produce(message event.LogMessage, handleErr func(error)) {
 		Topic: topicStr,
 		Value: message.Value,
 	}
-	e.Client.Produce(context.Background(), record, func(r *kgo.Record, err error) {
+	for key, value := range message.Headers {
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: key, Value: []byte(value)})
+	}
+
+	if e.Spill == nil {
+		e.Client.Produce(context.Background(), record, func(r *kgo.Record, err error) {
+			if err != nil {
+				e.dropped.Add(1)
+				handleErr(fmt.Errorf("kafka produce record: %v", err))
+			}
+		})
+		return
+	}
+
+	if e.Spill.Size() > 0 {
+		e.spill(record, handleErr)
+		return
+	}
+	e.Client.TryProduce(context.Background(), record, func(r *kgo.Record, err error) {
 		if err != nil {
-			handleErr(fmt.Errorf("kafka produce record: %v", err))
+			e.spill(r, handleErr)
 		}
 	})
 }
 
+func (e *Kafka) spill(record *kgo.Record, handleErr func(error)) {
+	if err := e.Spill.Append(record); err != nil {
+		e.dropped.Add(1)
+		handleErr(fmt.Errorf("kafka spill record: %w", err))
+	}
+}
+
 func (e *Kafka) Ping(ctx context.Context) error {
 	if err := e.Client.Ping(ctx); err != nil {
 		return fmt.Errorf("kafka ping: %v", err)
 	}
 	return nil
 }
+
+// Run replays spilled records every interval once Ping succeeds, until ctx is done.
+func (e *Kafka) Run(ctx context.Context, interval time.Duration, handleErr func(error)) {
+	if interval <= 0 {
+		interval = DefaultFlushInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if e.Spill.Size() == 0 || e.Ping(ctx) != nil {
+				continue
+			}
+			if err := e.Replay(ctx); err != nil {
+				handleErr(err)
+			}
+		}
+	}
+}
+
+// Replay produces spilled records oldest first. It stops at the first segment with failed records,
+// which are kept in order for the next replay.
+func (e *Kafka) Replay(ctx context.Context) error {
+	for {
+		seq, records, ok, err := e.Spill.Next()
+		if err != nil {
+			return fmt.Errorf("kafka replay: %v", err)
+		}
+		if !ok {
+			return nil
+		}
+
+		produceCtx, cancel := context.WithTimeout(ctx, DefaultReplayTimeout)
+		results := e.Client.ProduceSync(produceCtx, records...)
+		cancel()
+
+		var failed []*kgo.Record
+		for _, result := range results {
+			if result.Err != nil {
+				failed = append(failed, result.Record)
+			}
+		}
+		// Results come in order of delivery, not in order of records
+		if len(failed) > 1 {
+			index := make(map[*kgo.Record]int, len(records))
+			for i, record := range records {
+				index[record] = i
+			}
+			slices.SortFunc(failed, func(a, b *kgo.Record) int {
+				return index[a] - index[b]
+			})
+		}
+
+		if err := e.Spill.Commit(seq, failed); err != nil {
+			return fmt.Errorf("kafka replay: %v", err)
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("kafka replay: %d of %d records failed: %v", len(failed), len(records), results.FirstErr())
+		}
+	}
+}
+
+// Monitor sets up OpenTelemetry monitoring of spilled and dropped records.
+func (e *Kafka) Monitor(meter metric.Meter) error {
+	spilled, err := meter.Int64ObservableGauge(
+		"events.kafka.spilled_bytes",
+		metric.WithDescription("Size of Kafka records buffered on disk"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return err
+	}
+
+	dropped, err := meter.Int64ObservableCounter("events.kafka.dropped", metric.WithDescription("Events dropped by Kafka engine"))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			observer.ObserveInt64(spilled, e.Spill.Size())
+			observer.ObserveInt64(dropped, e.dropped.Load())
+
+			return nil
+		},
+		spilled,
+		dropped,
+	)
+
+	return err
+}
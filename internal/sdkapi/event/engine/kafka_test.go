@@ -0,0 +1,150 @@
+package engine_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event/engine"
+)
+
+// fakeKafkaClient fails records while err is set and records produced values otherwise.
+type fakeKafkaClient struct {
+	mu       sync.Mutex
+	err      error
+	failAt   map[string]bool
+	produced []string
+}
+
+func (c *fakeKafkaClient) Produce(ctx context.Context, r *kgo.Record, promise func(*kgo.Record, error)) {
+	c.TryProduce(ctx, r, promise)
+}
+
+func (c *fakeKafkaClient) TryProduce(_ context.Context, r *kgo.Record, promise func(*kgo.Record, error)) {
+	promise(r, c.produce(r))
+}
+
+func (c *fakeKafkaClient) ProduceSync(_ context.Context, rs ...*kgo.Record) kgo.ProduceResults {
+	results := make(kgo.ProduceResults, len(rs))
+	// Report results in reverse order to check that failed records keep their order
+	for i, r := range rs {
+		results[len(rs)-1-i] = kgo.ProduceResult{Record: r, Err: c.produce(r)}
+	}
+
+	return results
+}
+
+func (c *fakeKafkaClient) Ping(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.err
+}
+
+func (c *fakeKafkaClient) produce(r *kgo.Record) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return c.err
+	}
+	if c.failAt[string(r.Value)] {
+		return errors.New("record failed")
+	}
+	c.produced = append(c.produced, string(r.Value))
+
+	return nil
+}
+
+func (c *fakeKafkaClient) setErr(err error) {
+	c.mu.Lock()
+	c.err = err
+	c.mu.Unlock()
+}
+
+func TestKafka_Replay(t *testing.T) {
+	client := &fakeKafkaClient{err: kgo.ErrMaxBuffered}
+	spill := &engine.Spill{Dir: t.TempDir(), SegmentSize: 64}
+	e := &engine.Kafka{Client: client, Topics: map[config.Topic]string{config.AdEventsTopic: "ad-events"}, Spill: spill}
+
+	produce := func(value string) {
+		e.Produce(event.LogMessage{Topic: config.AdEventsTopic, Value: []byte(value)}, func(err error) { t.Error(err) })
+	}
+	produce("a")
+	produce("b")
+	client.setErr(nil)
+	produce("c") // Spilled after older records
+	if len(client.produced) != 0 {
+		t.Fatalf("produced = %v, want records spilled", client.produced)
+	}
+
+	client.failAt = map[string]bool{"b": true, "c": true}
+	if err := e.Replay(context.Background()); err == nil {
+		t.Fatalf("Replay() = nil, want error of failed record")
+	}
+	client.failAt = nil
+	if err := e.Replay(context.Background()); err != nil {
+		t.Fatalf("Replay() = %v, want nil", err)
+	}
+	produce("d") // Produced directly after replay
+
+	// Failed records are retried on the next replay
+	if diff := cmp.Diff([]string{"a", "b", "c", "d"}, client.produced); diff != "" {
+		t.Errorf("produced mismatch (-want +got):\n%s", diff)
+	}
+	if size := spill.Size(); size != 0 {
+		t.Errorf("Spill.Size() = %d, want 0", size)
+	}
+}
+
+func TestKafka_Produce_SpillFull(t *testing.T) {
+	client := &fakeKafkaClient{err: kgo.ErrMaxBuffered}
+	spill := &engine.Spill{Dir: t.TempDir(), MaxSize: 20}
+	e := &engine.Kafka{Client: client, Topics: map[config.Topic]string{config.AdEventsTopic: "ad-events"}, Spill: spill}
+
+	var errs []error
+	for range 3 {
+		e.Produce(event.LogMessage{Topic: config.AdEventsTopic, Value: []byte("value")}, func(err error) { errs = append(errs, err) })
+	}
+
+	if len(errs) != 2 || !errors.Is(errs[0], engine.ErrSpillFull) {
+		t.Errorf("Produce() errors = %v, want 2 spill full errors", errs)
+	}
+}
+
+func TestSpill_Reopen(t *testing.T) {
+	dir := t.TempDir()
+	spill := &engine.Spill{Dir: dir}
+	record := &kgo.Record{Topic: "ad-events", Value: []byte("a"), Headers: []kgo.RecordHeader{{Key: "schema-version", Value: []byte("1")}}}
+	if err := spill.Append(record); err != nil {
+		t.Fatal(err)
+	}
+	if err := spill.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened := &engine.Spill{Dir: dir}
+	_, records, ok, err := reopened.Next()
+	if err != nil || !ok {
+		t.Fatalf("Next() = %v, %v, want records", ok, err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("Next() = %d records, want 1", len(records))
+	}
+	type spilledRecord struct {
+		Topic   string
+		Value   []byte
+		Headers []kgo.RecordHeader
+	}
+	want := spilledRecord{Topic: record.Topic, Value: record.Value, Headers: record.Headers}
+	got := spilledRecord{Topic: records[0].Topic, Value: records[0].Value, Headers: records[0].Headers}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Next() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -11,6 +11,11 @@ type Log struct{}
 
 func (e *Log) Produce(message event.LogMessage, _ func(error)) {
 	topic := message.Topic
+	if !message.IsJSON() {
+		log.Printf("PRODUCE EVENT %T(%v): %s, %d bytes", topic, topic, message.Headers[event.ContentTypeHeader], len(message.Value))
+		return
+	}
+
 	value := message.Value
 	log.Printf("PRODUCE EVENT %T(%v): %s", topic, topic, value)
 }
@@ -0,0 +1,334 @@
+package engine
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// DefaultSpillSegmentSize is the size of Spill segment files if SegmentSize is not set.
+const DefaultSpillSegmentSize = 16 << 20
+
+// ErrSpillFull is returned when Spill has no room for a record.
+var ErrSpillFull = errors.New("spill is full")
+
+const spillExt = ".spill"
+
+// Spill is a disk-backed FIFO buffer of Kafka records. Records are appended to segment files in Dir named
+// <sequence>.spill and read back oldest segment first. Segments left by a previous process are read too.
+// Only topic, headers and value of records are kept.
+type Spill struct {
+	Dir string
+	// MaxSize is the size of all buffered records in bytes, zero means no limit.
+	MaxSize int64
+	// SegmentSize is the size of a segment file in bytes.
+	SegmentSize int64
+
+	mu       sync.Mutex
+	opened   bool
+	segments []spillSegment
+	// current is the segment records are appended to, the last one in segments.
+	current *os.File
+	size    int64
+}
+
+type spillSegment struct {
+	seq  uint64
+	size int64
+}
+
+// Append writes the record to the newest segment.
+func (s *Spill) Append(record *kgo.Record) error {
+	frame := encodeSpillRecord(record)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.open(); err != nil {
+		return err
+	}
+	if s.MaxSize > 0 && s.size+int64(len(frame)) > s.MaxSize {
+		return ErrSpillFull
+	}
+
+	segmentSize := s.SegmentSize
+	if segmentSize <= 0 {
+		segmentSize = DefaultSpillSegmentSize
+	}
+	if s.current == nil || s.segments[len(s.segments)-1].size+int64(len(frame)) > segmentSize {
+		if err := s.startSegment(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.current.Write(frame)
+	s.segments[len(s.segments)-1].size += int64(n)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("write spill segment: %v", err)
+	}
+
+	return nil
+}
+
+// Size returns the size of buffered records in bytes.
+func (s *Spill) Size() int64 {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.open(); err != nil {
+		return 0
+	}
+
+	return s.size
+}
+
+// Next returns records of the oldest segment. They stay buffered until the segment is committed.
+// It returns false if there are no buffered records.
+func (s *Spill) Next() (uint64, []*kgo.Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.open(); err != nil {
+		return 0, nil, false, err
+	}
+	if len(s.segments) == 0 {
+		return 0, nil, false, nil
+	}
+
+	segment := s.segments[0]
+	if len(s.segments) == 1 && s.current != nil {
+		// New records go to the next segment while this one is replayed
+		if err := s.closeCurrent(); err != nil {
+			return 0, nil, false, err
+		}
+	}
+
+	records, err := s.read(segment.seq)
+	if err != nil {
+		return 0, nil, false, err
+	}
+
+	return segment.seq, records, true, nil
+}
+
+// Commit removes the segment returned by Next, keeping remaining records in it.
+func (s *Spill) Commit(seq uint64, remaining []*kgo.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := slices.IndexFunc(s.segments, func(segment spillSegment) bool { return segment.seq == seq })
+	if i < 0 {
+		return fmt.Errorf("spill segment %d not found", seq)
+	}
+
+	if len(remaining) == 0 {
+		if err := os.Remove(s.path(seq)); err != nil {
+			return fmt.Errorf("remove spill segment: %v", err)
+		}
+		s.size -= s.segments[i].size
+		s.segments = slices.Delete(s.segments, i, i+1)
+		return nil
+	}
+
+	var data []byte
+	for _, record := range remaining {
+		data = append(data, encodeSpillRecord(record)...)
+	}
+	tmp := s.path(seq) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write spill segment: %v", err)
+	}
+	if err := os.Rename(tmp, s.path(seq)); err != nil {
+		return fmt.Errorf("rename spill segment: %v", err)
+	}
+	s.size += int64(len(data)) - s.segments[i].size
+	s.segments[i].size = int64(len(data))
+
+	return nil
+}
+
+// Close closes the segment records are appended to.
+func (s *Spill) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.closeCurrent()
+}
+
+// open loads segments left in Dir on first use.
+func (s *Spill) open() error {
+	if s.opened {
+		return nil
+	}
+
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return fmt.Errorf("create spill dir: %v", err)
+	}
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return fmt.Errorf("read spill dir: %v", err)
+	}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), spillExt)
+		if !ok {
+			continue
+		}
+		seq, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("stat spill segment: %v", err)
+		}
+
+		s.segments = append(s.segments, spillSegment{seq: seq, size: info.Size()})
+		s.size += info.Size()
+	}
+	slices.SortFunc(s.segments, func(a, b spillSegment) int { return cmp.Compare(a.seq, b.seq) })
+	s.opened = true
+
+	return nil
+}
+
+func (s *Spill) startSegment() error {
+	if err := s.closeCurrent(); err != nil {
+		return err
+	}
+
+	seq := uint64(1)
+	if len(s.segments) > 0 {
+		seq = s.segments[len(s.segments)-1].seq + 1
+	}
+	file, err := os.OpenFile(s.path(seq), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("create spill segment: %v", err)
+	}
+
+	s.current = file
+	s.segments = append(s.segments, spillSegment{seq: seq})
+
+	return nil
+}
+
+func (s *Spill) closeCurrent() error {
+	if s.current == nil {
+		return nil
+	}
+
+	err := s.current.Close()
+	s.current = nil
+	if err != nil {
+		return fmt.Errorf("close spill segment: %v", err)
+	}
+
+	return nil
+}
+
+// read decodes records of the segment. Truncated record at the end of the segment, e.g. after a crash, is skipped.
+func (s *Spill) read(seq uint64) ([]*kgo.Record, error) {
+	file, err := os.Open(s.path(seq))
+	if err != nil {
+		return nil, fmt.Errorf("open spill segment: %v", err)
+	}
+	defer file.Close()
+
+	var records []*kgo.Record
+	r := bufio.NewReader(file)
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			break
+		}
+		frame := make([]byte, size)
+		if _, err := io.ReadFull(r, frame); err != nil {
+			break
+		}
+
+		record, err := decodeSpillRecord(frame)
+		if err != nil {
+			return nil, fmt.Errorf("decode spill segment %d: %v", seq, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (s *Spill) path(seq uint64) string {
+	return filepath.Join(s.Dir, fmt.Sprintf("%020d%s", seq, spillExt))
+}
+
+// encodeSpillRecord encodes record as length prefixed frame of topic, headers and value.
+func encodeSpillRecord(record *kgo.Record) []byte {
+	body := appendSpillBytes(nil, []byte(record.Topic))
+	body = binary.AppendUvarint(body, uint64(len(record.Headers)))
+	for _, header := range record.Headers {
+		body = appendSpillBytes(body, []byte(header.Key))
+		body = appendSpillBytes(body, header.Value)
+	}
+	body = appendSpillBytes(body, record.Value)
+
+	return appendSpillBytes(nil, body)
+}
+
+func decodeSpillRecord(frame []byte) (*kgo.Record, error) {
+	topic, frame, err := consumeSpillBytes(frame)
+	if err != nil {
+		return nil, err
+	}
+	record := &kgo.Record{Topic: string(topic)}
+
+	headers, n := binary.Uvarint(frame)
+	if n <= 0 {
+		return nil, errors.New("invalid headers count")
+	}
+	frame = frame[n:]
+	for range headers {
+		var key, value []byte
+		if key, frame, err = consumeSpillBytes(frame); err != nil {
+			return nil, err
+		}
+		if value, frame, err = consumeSpillBytes(frame); err != nil {
+			return nil, err
+		}
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: string(key), Value: value})
+	}
+
+	if record.Value, _, err = consumeSpillBytes(frame); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func appendSpillBytes(b, value []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(len(value)))
+	return append(b, value...)
+}
+
+func consumeSpillBytes(b []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(b)
+	if n <= 0 || uint64(len(b)-n) < size {
+		return nil, nil, errors.New("invalid length")
+	}
+	b = b[n:]
+
+	return b[:size], b[size:], nil
+}
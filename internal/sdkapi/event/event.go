@@ -19,6 +19,7 @@ func NewAdEvent(request *schema.BaseRequest, adRequestParams AdRequestParams, ge
 	requestEvent := newBaseRequest(request, geoData)
 
 	requestEvent.EventType = adRequestParams.EventType
+	requestEvent.AppID = adRequestParams.AppID
 	requestEvent.Status = adRequestParams.Status
 	requestEvent.AdType = adRequestParams.AdType
 	requestEvent.AdFormat = adRequestParams.AdFormat
@@ -49,6 +50,9 @@ func NewAdEvent(request *schema.BaseRequest, adRequestParams AdRequestParams, ge
 	requestEvent.Badv = adRequestParams.Badv
 	requestEvent.Bcat = adRequestParams.Bcat
 	requestEvent.Bapp = adRequestParams.Bapp
+	requestEvent.ExperimentID = adRequestParams.ExperimentID
+	requestEvent.ExperimentVariantID = adRequestParams.ExperimentVariantID
+	requestEvent.DealID = adRequestParams.DealID
 
 	return requestEvent
 }
@@ -123,6 +127,7 @@ func newBaseRequest(request *schema.BaseRequest, geoData geocoder.GeoData) *AdEv
 
 type AdRequestParams struct {
 	EventType               string
+	AppID                   int64
 	AdType                  string
 	AdFormat                string
 	AuctionID               string
@@ -149,6 +154,9 @@ type AdRequestParams struct {
 	Badv                    string
 	Bcat                    string
 	Bapp                    string
+	ExperimentID            int64
+	ExperimentVariantID     string
+	DealID                  string
 }
 
 const (
@@ -156,9 +164,12 @@ const (
 	ErrorAdRequestStatus   = "ERROR"
 )
 
+// AdEvent is encoded as JSON or as protobuf message of org/bidon/events/v1/events.proto in schemas directory.
+// New fields have to be added to the message too, then Go code is generated with buf generate.
 type AdEvent struct {
 	Timestamp                   float64           `json:"timestamp"`
 	EventType                   string            `json:"event_type"`
+	AppID                       int64             `json:"app_id,omitempty"`
 	AdType                      string            `json:"ad_type"`
 	AdFormat                    string            `json:"ad_format"`
 	AuctionID                   string            `json:"auction_id"`
@@ -218,6 +229,9 @@ type AdEvent struct {
 	Badv                        string            `json:"badv,omitempty"`
 	Bcat                        string            `json:"bcat,omitempty"`
 	Bapp                        string            `json:"bapp,omitempty"`
+	ExperimentID                int64             `json:"experiment_id,omitempty"`
+	ExperimentVariantID         string            `json:"experiment_variant_id,omitempty"`
+	DealID                      string            `json:"deal_id,omitempty"`
 }
 
 type Session struct {
@@ -266,6 +280,8 @@ func NewNotificationEvent(params NotificationParams) *NotificationEvent {
 		SecondPrice: params.SecondPrice,
 		URL:         params.URL,
 		TemplateURL: params.TemplateURL,
+		Status:      params.Status,
+		Attempts:    params.Attempts,
 		Error:       errorString,
 	}
 }
@@ -283,7 +299,11 @@ type NotificationParams struct {
 	SecondPrice float64
 	URL         string
 	TemplateURL string
-	Error       error
+	// Status is the final outcome of delivery: DELIVERED, FAILED or DEAD_LETTERED.
+	// ANOMALY is logged for notification that was not sent because it conflicts with an earlier one, e.g. win after loss.
+	Status   string
+	Attempts int64
+	Error    error
 }
 
 type NotificationEvent struct {
@@ -300,6 +320,8 @@ type NotificationEvent struct {
 	SecondPrice float64 `json:"second_price"`
 	URL         string  `json:"url"`
 	TemplateURL string  `json:"template_url"`
+	Status      string  `json:"status"`
+	Attempts    int64   `json:"attempts"`
 	Error       string  `json:"error"`
 }
 
@@ -4,12 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/bidon-io/bidon-backend/config"
 )
 
 type Logger struct {
-	Engine LoggerEngine
+	Engine    LoggerEngine
+	Observers []Observer
+	// Encodings of event payloads by topic. Topics not in the map are encoded as JSON.
+	Encodings map[config.Topic]Encoding
 }
 
 type LoggerEngine interface {
@@ -17,25 +21,80 @@ type LoggerEngine interface {
 	Ping(ctx context.Context) error
 }
 
+// Observer is notified of every logged event before it is produced.
+// Observe is called synchronously, so it must be fast and must not modify the event.
+type Observer interface {
+	Observe(event Event)
+}
+
+// Encoding is the format of event payloads.
+type Encoding string
+
+const (
+	JSONEncoding     Encoding = "json"
+	ProtobufEncoding Encoding = "protobuf"
+)
+
+// Headers sent with every message, so consumers can decode payloads of different encodings and schema versions.
+const (
+	ContentTypeHeader   = "content-type"
+	SchemaHeader        = "schema"
+	SchemaVersionHeader = "schema-version"
+
+	JSONContentType     = "application/json"
+	ProtobufContentType = "application/x-protobuf"
+)
+
 type LogMessage struct {
-	Topic config.Topic
-	Value []byte
+	Topic   config.Topic
+	Value   []byte
+	Headers map[string]string
+}
+
+// IsJSON reports whether the message value is JSON. Messages without content type are JSON.
+func (m LogMessage) IsJSON() bool {
+	contentType := m.Headers[ContentTypeHeader]
+	return contentType == "" || contentType == JSONContentType
 }
 
 func (l *Logger) Log(event Event, handleErr func(error)) {
 	topic := event.Topic()
 
-	message, err := json.Marshal(event)
-	if err != nil {
-		handleErr(fmt.Errorf("marshal %q event payload: %v", topic, err))
+	for _, observer := range l.Observers {
+		observer.Observe(event)
 	}
 
-	logMessage := LogMessage{
-		Topic: topic,
-		Value: message,
+	logMessage, err := l.encode(event)
+	if err != nil {
+		handleErr(fmt.Errorf("marshal %q event payload: %v", topic, err))
+		return
 	}
 
 	l.Engine.Produce(logMessage, func(err error) {
 		handleErr(fmt.Errorf("produce %q message: %v", logMessage.Topic, err))
 	})
 }
+
+func (l *Logger) encode(event Event) (LogMessage, error) {
+	message := LogMessage{
+		Topic: event.Topic(),
+		Headers: map[string]string{
+			SchemaHeader:        ProtoMessageName(event),
+			SchemaVersionHeader: strconv.Itoa(SchemaVersion),
+		},
+	}
+
+	var err error
+	switch encoding := l.Encodings[message.Topic]; encoding {
+	case "", JSONEncoding:
+		message.Headers[ContentTypeHeader] = JSONContentType
+		message.Value, err = json.Marshal(event)
+	case ProtobufEncoding:
+		message.Headers[ContentTypeHeader] = ProtobufContentType
+		message.Value, err = MarshalProto(event)
+	default:
+		err = fmt.Errorf("unknown encoding %q", encoding)
+	}
+
+	return message, err
+}
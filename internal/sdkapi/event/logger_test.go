@@ -0,0 +1,65 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/config"
+)
+
+type recordingEngine struct {
+	messages []LogMessage
+}
+
+func (e *recordingEngine) Produce(message LogMessage, _ func(error)) {
+	e.messages = append(e.messages, message)
+}
+
+func (e *recordingEngine) Ping(context.Context) error {
+	return nil
+}
+
+func TestLogger_Log_Encodings(t *testing.T) {
+	engine := &recordingEngine{}
+	logger := &Logger{
+		Engine:    engine,
+		Encodings: map[config.Topic]Encoding{config.AdEventsTopic: ProtobufEncoding},
+	}
+
+	adEvent := &AdEvent{EventType: "show"}
+	notificationEvent := &NotificationEvent{EventType: "win"}
+	logger.Log(adEvent, func(err error) { t.Error(err) })
+	logger.Log(notificationEvent, func(err error) { t.Error(err) })
+
+	adEventValue, _ := MarshalProto(adEvent)
+	notificationEventValue, _ := json.Marshal(notificationEvent)
+	want := []LogMessage{
+		{
+			Topic: config.AdEventsTopic,
+			Value: adEventValue,
+			Headers: map[string]string{
+				ContentTypeHeader:   ProtobufContentType,
+				SchemaHeader:        "org.bidon.events.v1.AdEvent",
+				SchemaVersionHeader: "1",
+			},
+		},
+		{
+			Topic: config.NotificationEventsTopic,
+			Value: notificationEventValue,
+			Headers: map[string]string{
+				ContentTypeHeader:   JSONContentType,
+				SchemaHeader:        "org.bidon.events.v1.NotificationEvent",
+				SchemaVersionHeader: "1",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, engine.messages); diff != "" {
+		t.Errorf("Log() messages mismatch (-want +got):\n%s", diff)
+	}
+	if engine.messages[0].IsJSON() || !engine.messages[1].IsJSON() {
+		t.Errorf("IsJSON() = %v, %v, want false, true", engine.messages[0].IsJSON(), engine.messages[1].IsJSON())
+	}
+}
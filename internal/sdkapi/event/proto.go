@@ -0,0 +1,183 @@
+package event
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	eventsv1 "github.com/bidon-io/bidon-backend/pkg/proto/org/bidon/events/v1"
+)
+
+// SchemaVersion is the version of event schemas, sent with every message. It is increased on incompatible changes only:
+// new fields are added with new numbers, and numbers of removed fields are never reused.
+const SchemaVersion = 1
+
+// MarshalProto encodes event as protobuf message of org/bidon/events/v1/events.proto.
+func MarshalProto(e Event) ([]byte, error) {
+	message := protoMessage(e)
+	if message == nil {
+		return nil, fmt.Errorf("no protobuf message for %T", e)
+	}
+
+	return proto.Marshal(message)
+}
+
+// ProtoMessageName returns full name of the event message in org/bidon/events/v1/events.proto.
+func ProtoMessageName(e Event) string {
+	switch e.(type) {
+	case *AdEvent:
+		return string((&eventsv1.AdEvent{}).ProtoReflect().Descriptor().FullName())
+	case *NotificationEvent:
+		return string((&eventsv1.NotificationEvent{}).ProtoReflect().Descriptor().FullName())
+	default:
+		return ""
+	}
+}
+
+func protoMessage(e Event) proto.Message {
+	switch e := e.(type) {
+	case *AdEvent:
+		return e.proto()
+	case *NotificationEvent:
+		return e.proto()
+	default:
+		return nil
+	}
+}
+
+func (e *AdEvent) proto() *eventsv1.AdEvent {
+	return &eventsv1.AdEvent{
+		Timestamp:                   e.Timestamp,
+		EventType:                   e.EventType,
+		AppId:                       e.AppID,
+		AdType:                      e.AdType,
+		AdFormat:                    e.AdFormat,
+		AuctionId:                   e.AuctionID,
+		AuctionConfigurationId:      e.AuctionConfigurationID,
+		AuctionConfigurationUid:     e.AuctionConfigurationUID,
+		Status:                      e.Status,
+		RoundId:                     e.RoundID,
+		RoundNumber:                 int64(e.RoundNumber),
+		Impid:                       e.ImpID,
+		DemandId:                    e.DemandID,
+		Bidding:                     e.Bidding,
+		AdUnitUid:                   e.AdUnitUID,
+		AdUnitInternalId:            e.AdUnitInternalID,
+		AdUnitLabel:                 e.AdUnitLabel,
+		AdUnitCredentials:           e.AdUnitCredentials,
+		Ecpm:                        e.ECPM,
+		PriceFloor:                  e.PriceFloor,
+		RawRequest:                  e.RawRequest,
+		RawResponse:                 e.RawResponse,
+		Error:                       e.Error,
+		TimingMap:                   e.TimingMap.proto(),
+		ExternalWinnerDemandId:      e.ExternalWinnerDemandID,
+		ExternalWinnerEcpm:          e.ExternalWinnerEcpm,
+		Manufacturer:                e.Manufacturer,
+		Model:                       e.Model,
+		Os:                          e.Os,
+		OsVersion:                   e.OsVersion,
+		ConnectionType:              e.ConnectionType,
+		DeviceType:                  e.DeviceType,
+		UserAgent:                   e.UserAgent,
+		SessionId:                   e.SessionID,
+		SessionUptime:               int64(e.SessionUptime),
+		Bundle:                      e.Bundle,
+		Framework:                   e.Framework,
+		FrameworkVersion:            e.FrameworkVersion,
+		PluginVersion:               e.PluginVersion,
+		PackageVersion:              e.PackageVersion,
+		SdkVersion:                  e.SdkVersion,
+		Idfa:                        e.IDFA,
+		Idg:                         e.IDG,
+		Idfv:                        e.IDFV,
+		TrackingAuthorizationStatus: e.TrackingAuthorizationStatus,
+		AppSetId:                    e.AppSetID,
+		AppSetIdScope:               e.AppSetIDScope,
+		Coppa:                       e.COPPA,
+		Gdpr:                        e.GDPR,
+		CountryCode:                 e.CountryCode,
+		City:                        e.City,
+		Ip:                          e.Ip,
+		CountryId:                   e.CountryID,
+		SegmentId:                   e.SegmentID,
+		SegmentUid:                  e.SegmentUID,
+		Ext:                         e.Ext,
+		Session:                     e.Session.proto(),
+		MediationMode:               e.MediationMode,
+		Mediator:                    e.Mediator,
+		Badv:                        e.Badv,
+		Bcat:                        e.Bcat,
+		Bapp:                        e.Bapp,
+		ExperimentId:                e.ExperimentID,
+		ExperimentVariantId:         e.ExperimentVariantID,
+		DealId:                      e.DealID,
+	}
+}
+
+func (m TimingMap) proto() map[string]*eventsv1.Int64List {
+	if len(m) == 0 {
+		return nil
+	}
+
+	timings := make(map[string]*eventsv1.Int64List, len(m))
+	for stage, timing := range m {
+		timings[stage] = &eventsv1.Int64List{Values: timing[:]}
+	}
+
+	return timings
+}
+
+func (s Session) proto() *eventsv1.Session {
+	return &eventsv1.Session{
+		Id:                        s.ID,
+		LaunchTs:                  int64(s.LaunchTS),
+		LaunchMonotonicTs:         int64(s.LaunchMonotonicTS),
+		StartTs:                   int64(s.StartTS),
+		StartMonotonicTs:          int64(s.StartMonotonicTS),
+		Ts:                        int64(s.TS),
+		MonotonicTs:               int64(s.MonotonicTS),
+		MemoryWarningsTs:          int64s(s.MemoryWarningsTS),
+		MemoryWarningsMonotonicTs: int64s(s.MemoryWarningsMonotonicTS),
+		RamUsed:                   int64(s.RAMUsed),
+		RamSize:                   int64(s.RAMSize),
+		StorageFree:               int64(s.StorageFree),
+		StorageUsed:               int64(s.StorageUsed),
+		Battery:                   s.Battery,
+		CpuUsage:                  s.CPUUsage,
+	}
+}
+
+func (e *NotificationEvent) proto() *eventsv1.NotificationEvent {
+	return &eventsv1.NotificationEvent{
+		Timestamp:   e.Timestamp,
+		EventType:   e.EventType,
+		Bundle:      e.Bundle,
+		AdType:      e.AdType,
+		DemandId:    e.DemandID,
+		AuctionId:   e.AuctionID,
+		ImpId:       e.ImpID,
+		LossReason:  e.LossReason,
+		Ecpm:        e.Price,
+		FirstPrice:  e.FirstPrice,
+		SecondPrice: e.SecondPrice,
+		Url:         e.URL,
+		TemplateUrl: e.TemplateURL,
+		Status:      e.Status,
+		Attempts:    e.Attempts,
+		Error:       e.Error,
+	}
+}
+
+func int64s(values []int) []int64 {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]int64, len(values))
+	for i, v := range values {
+		result[i] = int64(v)
+	}
+
+	return result
+}
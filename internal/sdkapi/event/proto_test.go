@@ -0,0 +1,113 @@
+package event
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	eventsv1 "github.com/bidon-io/bidon-backend/pkg/proto/org/bidon/events/v1"
+)
+
+func TestMarshalProto(t *testing.T) {
+	cpuUsage := 0.0
+	tests := []struct {
+		name  string
+		event Event
+		want  proto.Message
+	}{
+		{
+			name: "ad event",
+			event: &AdEvent{
+				Timestamp:         1.5,
+				EventType:         "show",
+				AppID:             7,
+				RoundNumber:       2,
+				Bidding:           true,
+				AdUnitCredentials: map[string]string{"b": "2", "a": "1"},
+				TimingMap:         TimingMap{"bid": {10, 20}},
+				Session:           Session{ID: "session-1", MemoryWarningsTS: []int{1, 2}, CPUUsage: &cpuUsage},
+				DealID:            "pmp-1",
+			},
+			want: &eventsv1.AdEvent{
+				Timestamp:         1.5,
+				EventType:         "show",
+				AppId:             7,
+				RoundNumber:       2,
+				Bidding:           true,
+				AdUnitCredentials: map[string]string{"b": "2", "a": "1"},
+				TimingMap:         map[string]*eventsv1.Int64List{"bid": {Values: []int64{10, 20}}},
+				Session:           &eventsv1.Session{Id: "session-1", MemoryWarningsTs: []int64{1, 2}, CpuUsage: proto.Float64(0)},
+				DealId:            "pmp-1",
+			},
+		},
+		{
+			name: "notification event",
+			event: &NotificationEvent{
+				Timestamp: 1.5,
+				EventType: "win",
+				DemandID:  "bidmachine",
+				Price:     2.5,
+				URL:       "https://dsp.example.com/win",
+				Attempts:  3,
+			},
+			want: &eventsv1.NotificationEvent{
+				Timestamp: 1.5,
+				EventType: "win",
+				DemandId:  "bidmachine",
+				Ecpm:      2.5,
+				Url:       "https://dsp.example.com/win",
+				Attempts:  3,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := MarshalProto(tt.event)
+			if err != nil {
+				t.Fatalf("MarshalProto() error = %v", err)
+			}
+
+			got := tt.want.ProtoReflect().New().Interface()
+			if err := proto.Unmarshal(b, got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("MarshalProto() mismatch (-want +got):\n%s", diff)
+			}
+			if name := ProtoMessageName(tt.event); name != string(tt.want.ProtoReflect().Descriptor().FullName()) {
+				t.Errorf("ProtoMessageName() = %q, want %q", name, tt.want.ProtoReflect().Descriptor().FullName())
+			}
+		})
+	}
+}
+
+// TestProtoMessages_MatchJSON checks that every JSON field of events has a protobuf field of the same name,
+// so fields added to events are not forgotten in events.proto.
+func TestProtoMessages_MatchJSON(t *testing.T) {
+	tests := []struct {
+		event   any
+		message proto.Message
+	}{
+		{AdEvent{}, &eventsv1.AdEvent{}},
+		{Session{}, &eventsv1.Session{}},
+		{NotificationEvent{}, &eventsv1.NotificationEvent{}},
+	}
+
+	for _, tt := range tests {
+		eventType := reflect.TypeOf(tt.event)
+		fields := tt.message.ProtoReflect().Descriptor().Fields()
+
+		for i := range eventType.NumField() {
+			name, _, _ := strings.Cut(eventType.Field(i).Tag.Get("json"), ",")
+			if fields.ByName(protoreflect.Name(name)) == nil {
+				t.Errorf("%s.%s: no %q field in %s", eventType.Name(), eventType.Field(i).Name, name, tt.message.ProtoReflect().Descriptor().FullName())
+			}
+		}
+	}
+}
@@ -63,7 +63,7 @@ func buildServer(p *serverParams) *Server {
 		},
 	}
 	configFetcher := &handlersmocks.ConfigFetcherMock{
-		MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error) {
+		MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version, unitID string) (*auction.Config, error) {
 			return p.auctionConfig, nil
 		},
 		FetchByUIDCachedFunc: func(ctx context.Context, appId int64, key string, aucUID string) *auction.Config {
@@ -255,6 +255,7 @@ func DefaultAdUnits() []auction.AdUnit {
 			BidType:    schema.RTBBidType,
 			Timeout:    store.AdUnitTimeout,
 			Extra: map[string]any{
+				"bid_id":       "123",
 				"payload":      "payload",
 				"placement_id": "123",
 			},
@@ -67,6 +67,16 @@ func (r *BaseRequest) GetAuctionConfigurationParams() (string, string) {
 func (r *BaseRequest) SetAuctionConfigurationParams(id int64, uid string) {
 }
 
+// GetExperimentUnitID returns identifier users are bucketed into experiment variants by:
+// IDFV if it is available, session ID otherwise.
+func (r *BaseRequest) GetExperimentUnitID() string {
+	if r.User.IDFV != "" {
+		return r.User.IDFV
+	}
+
+	return r.Session.ID
+}
+
 func (r *BaseRequest) GetExtData() map[string]any {
 	if r.extData == nil {
 		return map[string]any{}
@@ -12,6 +12,7 @@ type Bid struct {
 	AuctionConfigurationUID string                `json:"auction_configuration_uid" validate:"required_without=AuctionConfigurationID"`
 	ImpID                   string                `json:"imp_id"`
 	DemandID                string                `json:"demand_id" validate:"required"`
+	BidID                   string                `json:"bid_id"`
 	RoundID                 string                `json:"round_id"`
 	RoundIndex              int                   `json:"round_idx"`
 	AdUnitUID               string                `json:"ad_unit_uid"`
@@ -41,6 +41,7 @@ type Stats struct {
 type AuctionResult struct {
 	Status            string                `json:"status" validate:"required,oneof=SUCCESS FAIL AUCTION_CANCELLED"`
 	WinnerDemandID    string                `json:"winner_demand_id"`
+	WinnerBidID       string                `json:"winner_bid_id"`
 	WinnerAdUnitUID   string                `json:"winner_ad_unit_uid"`
 	WinnerAdUnitLabel string                `json:"winner_ad_unit_label"`
 	Price             float64               `json:"price"`
@@ -56,6 +57,10 @@ func (s *AuctionResult) GetWinnerDemandID() string {
 	return s.WinnerDemandID
 }
 
+func (s *AuctionResult) GetWinnerBidID() string {
+	return s.WinnerBidID
+}
+
 func (s *AuctionResult) GetWinnerPrice() float64 {
 	return s.Price
 }
@@ -91,6 +96,7 @@ type AuctionAdUnitResult struct {
 	FillStartTS   int64   `json:"fill_start_ts"`
 	FillFinishTS  int64   `json:"fill_finish_ts"`
 	DemandID      string  `json:"demand_id" validate:"required"`
+	BidID         string  `json:"bid_id"`
 	BidType       BidType `json:"bid_type" validate:"omitempty,oneof=RTB CPM"`
 	AdUnitUID     string  `json:"ad_unit_uid"`
 	AdUnitLabel   string  `json:"ad_unit_label"`
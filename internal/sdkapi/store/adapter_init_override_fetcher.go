@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/db"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi"
+)
+
+type AdapterInitOverridesFetcher struct {
+	DB    *db.DB
+	Cache cache[[]sdkapi.AdapterInitOverride]
+}
+
+func (f *AdapterInitOverridesFetcher) FetchCached(ctx context.Context, appID int64) ([]sdkapi.AdapterInitOverride, error) {
+	cacheKey := []byte("adapter_init_overrides:" + strconv.FormatInt(appID, 10))
+
+	return f.Cache.Get(ctx, cacheKey, func(ctx context.Context) ([]sdkapi.AdapterInitOverride, error) {
+		return f.Fetch(ctx, appID)
+	})
+}
+
+// Fetch returns enabled adapter init overrides of the app, both app-wide and segment ones.
+func (f *AdapterInitOverridesFetcher) Fetch(ctx context.Context, appID int64) ([]sdkapi.AdapterInitOverride, error) {
+	var dbOverrides []db.AdapterInitOverride
+
+	err := f.DB.
+		WithContext(ctx).
+		Select("id", "segment_id", "adapters", "patches").
+		Where("app_id = ? AND enabled", appID).
+		Order("id").
+		Find(&dbOverrides).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("find adapter init overrides: %v", err)
+	}
+
+	overrides := make([]sdkapi.AdapterInitOverride, 0, len(dbOverrides))
+	for _, dbOverride := range dbOverrides {
+		override := sdkapi.AdapterInitOverride{
+			Adapters: db.StringArrayToAdapterKeys(&dbOverride.Adapters),
+			Patches:  make(map[adapter.Key]json.RawMessage, len(dbOverride.Patches)),
+		}
+		if dbOverride.SegmentID != nil && dbOverride.SegmentID.Valid {
+			override.SegmentID = dbOverride.SegmentID.Int64
+		}
+
+		for key, patch := range dbOverride.Patches {
+			override.Patches[adapter.Key(key)], err = json.Marshal(patch)
+			if err != nil {
+				return nil, fmt.Errorf("marshal %s patch of adapter init override %d: %v", key, dbOverride.ID, err)
+			}
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides, nil
+}
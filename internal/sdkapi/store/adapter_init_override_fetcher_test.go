@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/config"
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/db"
+	"github.com/bidon-io/bidon-backend/internal/db/dbtest"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi"
+)
+
+func TestAdapterInitOverridesFetcher_Fetch(t *testing.T) {
+	tx := testDB.Begin()
+	defer tx.Rollback()
+
+	app := dbtest.CreateApp(t, tx)
+	sgmnt := dbtest.CreateSegment(t, tx, func(s *db.Segment) {
+		s.App = app
+	})
+
+	dbtest.CreateAdapterInitOverride(t, tx, func(o *db.AdapterInitOverride) {
+		o.App = app
+		o.Adapters = []string{"bidmachine"}
+	})
+	dbtest.CreateAdapterInitOverride(t, tx, func(o *db.AdapterInitOverride) {
+		o.App = app
+		o.SegmentID = &sql.NullInt64{Int64: sgmnt.ID, Valid: true}
+		o.Patches = map[string]any{"applovin": map[string]any{"mediator": "Bidon"}}
+	})
+	dbtest.CreateAdapterInitOverride(t, tx, func(o *db.AdapterInitOverride) {
+		o.Adapters = []string{"admob"}
+	})
+	dbtest.CreateAdapterInitOverride(t, tx, func(o *db.AdapterInitOverride) {
+		o.App = app
+		o.SegmentID = &sql.NullInt64{Int64: dbtest.CreateSegment(t, tx, func(s *db.Segment) { s.App = app }).ID, Valid: true}
+		o.Adapters = []string{"meta"}
+		o.Enabled = ptr(false)
+	})
+
+	fetcher := &AdapterInitOverridesFetcher{DB: tx, Cache: config.NewMemoryCacheOf[[]sdkapi.AdapterInitOverride](10 * time.Minute)}
+
+	got, err := fetcher.FetchCached(context.Background(), app.ID)
+	if err != nil {
+		t.Fatalf("FetchCached() error = %v", err)
+	}
+
+	want := []sdkapi.AdapterInitOverride{
+		{
+			Adapters: []adapter.Key{adapter.BidmachineKey},
+			Patches:  map[adapter.Key]json.RawMessage{},
+		},
+		{
+			SegmentID: sgmnt.ID,
+			Adapters:  []adapter.Key{},
+			Patches:   map[adapter.Key]json.RawMessage{adapter.ApplovinKey: json.RawMessage(`{"mediator":"Bidon"}`)},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FetchCached() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -121,7 +121,7 @@ func testHelperAuctionHandler() *apihandlers.AuctionHandler {
 		},
 	}
 	configFetcher := &handlersmocks.ConfigFetcherMock{
-		MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error) {
+		MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version, unitID string) (*auction.Config, error) {
 			return auctionConfig, nil
 		},
 		FetchByUIDCachedFunc: func(ctx context.Context, appId int64, key string, aucUID string) *auction.Config {
@@ -167,12 +167,13 @@ func testHelperAuctionHandler() *apihandlers.AuctionHandler {
 				Bids: []adapters.DemandResponse{
 					{
 						DemandID: "amazon",
-						SlotUUID: "uuid1",
 						Bid: &adapters.BidDemandResponse{
-							Price:    0.5,
-							ID:       "111",
-							ImpID:    "222",
-							DemandID: adapter.MetaKey,
+							Price:     0.5,
+							ID:        "111",
+							ImpID:     "222",
+							DemandID:  adapter.MetaKey,
+							TagID:     "uuid1",
+							AdUnitUID: "123_amazon",
 						},
 					},
 					{
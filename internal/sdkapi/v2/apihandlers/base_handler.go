@@ -27,7 +27,7 @@ type AppFetcher interface {
 
 type ConfigFetcher interface {
 	FetchByUIDCached(ctx context.Context, appId int64, id, uid string) *auction.Config
-	Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error)
+	Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version, unitID string) (*auction.Config, error)
 	FetchBidMachinePlacements(ctx context.Context, appID int64) (map[string]string, error)
 }
 
@@ -65,6 +65,7 @@ func (b *BaseHandler[T, PT]) resolveRequest(c echo.Context) (*request[T, PT], er
 	}
 	if auctionConfig != nil {
 		req.SetAuctionConfigurationParams(auctionConfig.ID, auctionConfig.UID)
+		auctionConfig = auctionConfig.WithExperimentVariant(req.GetExperimentUnitID())
 	}
 
 	geoData, err := b.Geocoder.Lookup(c.Request().Context(), c.RealIP())
@@ -88,6 +89,7 @@ type rawRequest[T any] interface {
 	NormalizeValues()
 	GetAuctionConfigurationParams() (string, string)
 	SetAuctionConfigurationParams(int64, string)
+	GetExperimentUnitID() string
 }
 
 // request wraps raw request and includes additional data that is needed for all sdkapi apihandlers
@@ -110,3 +112,21 @@ func (r *request[T, PT]) countryCode() string {
 
 	return geocoder.UnknownCountryCode
 }
+
+// experimentID returns experiment the auction configuration of request takes part in, 0 if there is none.
+func (r *request[T, PT]) experimentID() int64 {
+	if r.auctionConfig == nil {
+		return 0
+	}
+
+	return r.auctionConfig.ExperimentID
+}
+
+// experimentVariantID returns experiment variant the request is assigned to, empty string if there is none.
+func (r *request[T, PT]) experimentVariantID() string {
+	if r.auctionConfig == nil {
+		return ""
+	}
+
+	return r.auctionConfig.ExperimentVariantID
+}
@@ -56,6 +56,8 @@ func prepareClickEvent(req *request[schema.ClickRequest, *schema.ClickRequest])
 		ECPM:                    bid.GetPrice(),
 		PriceFloor:              bid.AuctionPriceFloor,
 		Bidding:                 bid.IsBidding(),
+		ExperimentID:            req.experimentID(),
+		ExperimentVariantID:     req.experimentVariantID(),
 	}
 
 	return event.NewAdEvent(&req.raw.BaseRequest, adRequestParams, req.geoData)
@@ -16,16 +16,21 @@ import (
 
 type ConfigHandler struct {
 	*BaseHandler[schema.ConfigRequest, *schema.ConfigRequest]
-	AdapterInitConfigsFetcher AdapterInitConfigsFetcher
-	SegmentMatcher            *segment.Matcher
-	EventLogger               *event.Logger
+	AdapterInitConfigsFetcher   AdapterInitConfigsFetcher
+	AdapterInitOverridesFetcher AdapterInitOverridesFetcher
+	SegmentMatcher              *segment.Matcher
+	EventLogger                 *event.Logger
 }
 
-//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out mocks/config_mocks.go -pkg mocks . AdapterInitConfigsFetcher
+//go:generate go run -mod=mod github.com/matryer/moq@v0.5.3 -out mocks/config_mocks.go -pkg mocks . AdapterInitConfigsFetcher AdapterInitOverridesFetcher
 type AdapterInitConfigsFetcher interface {
 	FetchAdapterInitConfigs(ctx context.Context, appID int64, adapterKeys []adapter.Key, setAmazonSlots bool, setOrder bool) ([]sdkapi.AdapterInitConfig, error)
 }
 
+type AdapterInitOverridesFetcher interface {
+	FetchCached(ctx context.Context, appID int64) ([]sdkapi.AdapterInitOverride, error)
+}
+
 type ConfigResponse struct {
 	Init       ConfigResponseInit `json:"init"`
 	Placements []any              `json:"placements"`
@@ -56,9 +61,13 @@ func (h *ConfigHandler) Handle(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	segmentParams := &segment.Params{
-		Country: req.countryCode(),
-		Ext:     req.raw.Segment.Ext,
-		AppID:   req.app.ID,
+		Country:       req.countryCode(),
+		Ext:           req.raw.Segment.Ext,
+		AppID:         req.app.ID,
+		DeviceOS:      req.raw.Device.OS,
+		SDKVersion:    req.raw.App.SDKVersion,
+		AppVersion:    req.raw.App.Version,
+		SessionUptime: req.raw.Session.Uptime(),
 	}
 
 	sgmnt := h.SegmentMatcher.Match(ctx, segmentParams)
@@ -87,12 +96,18 @@ func (h *ConfigHandler) Handle(c echo.Context) error {
 		return sdkapi.ErrNoAdaptersFound
 	}
 
+	// Overrides only customize init configs, so base configs are served if they can't be fetched.
+	overrides, err := h.AdapterInitOverridesFetcher.FetchCached(ctx, req.app.ID)
+	if err != nil {
+		sdkapi.LogError(c, fmt.Errorf("fetch adapter init overrides: %v", err))
+	}
+	override := sdkapi.FindAdapterInitOverride(overrides, sgmnt.ID)
+
 	isIOS := req.raw.Device.OS == "iOS" // For iOS devices we should skip Amazon adapter
 	isCOPPA := false
 	if req.raw.Regulations != nil {
 		isCOPPA = req.raw.Regulations.COPPA
 	}
-	chardonnayHack := req.app.ID == 735400 || req.app.ID == 735401 || req.app.ID == 735402 || req.app.ID == 735456
 	adapters := make(map[adapter.Key]sdkapi.AdapterInitConfig, len(adapterInitConfigs))
 
 	var bidMachinePlacements map[string]string
@@ -105,8 +120,7 @@ func (h *ConfigHandler) Handle(c echo.Context) error {
 		if isCOPPA && adapter.IsDisabledForCOPPA(cfg.Key()) {
 			continue
 		}
-		// TODO: Remove hacks after experiment
-		if chardonnayHack && cfg.Key() != adapter.BidmachineKey {
+		if !override.Allows(cfg.Key()) {
 			continue
 		}
 
@@ -125,39 +139,8 @@ func (h *ConfigHandler) Handle(c echo.Context) error {
 			}
 		}
 
-		// TODO: Move AppLovin experimental configuration to models level
-		if cfg.Key() == adapter.ApplovinKey {
-			if applovinCfg, ok := cfg.(*sdkapi.ApplovinInitConfig); ok {
-				// Hardcoded experimental configuration for specific apps
-				switch req.app.ID {
-				case 735385: // JoinBlocks iOS
-					applovinCfg.AdUnitIDs = []string{
-						"dbadb46cdb8dcbc4",
-						"162430caf838b573",
-						"af144a6470c71e42",
-						"3a06357bdab4ee6b",
-						"75401a0c835c12af",
-						"8aacc527c3b5be39",
-						"1638c8cee4745fa0",
-						"48077ec3fc1fc3ec",
-						"3ed4466ff294569a",
-					}
-					applovinCfg.Mediator = "Bidon"
-				case 735379: // JoinBlocks Android
-					applovinCfg.AdUnitIDs = []string{
-						"062983d4dd0358e2",
-						"4cbcf418b2f994e2",
-						"280987aff5690161",
-						"1cdd6eb52418c959",
-						"e264b1821be63bc9",
-						"065b4f0d1977d97d",
-						"ce39f0751a52990f",
-						"926fb917d86ee946",
-						"13407842c572a710",
-					}
-					applovinCfg.Mediator = "Bidon"
-				}
-			}
+		if err := override.Patch(cfg); err != nil {
+			sdkapi.LogError(c, fmt.Errorf("patch %s init config: %v", cfg.Key(), err))
 		}
 
 		adapters[cfg.Key()] = cfg
@@ -2,11 +2,17 @@ package apihandlers_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/bidon-io/bidon-backend/internal/ad"
 	"github.com/bidon-io/bidon-backend/internal/adapter"
 	"github.com/bidon-io/bidon-backend/internal/auction"
@@ -20,7 +26,7 @@ import (
 	segmentmocks "github.com/bidon-io/bidon-backend/internal/segment/mocks"
 )
 
-func SetupConfigHandler() apihandlers.ConfigHandler {
+func SetupConfigHandler(overrides ...sdkapi.AdapterInitOverride) apihandlers.ConfigHandler {
 	app := sdkapi.App{ID: 1}
 	sgmnt := segment.Segment{
 		ID:  1,
@@ -99,11 +105,17 @@ func SetupConfigHandler() apihandlers.ConfigHandler {
 		},
 	}
 
+	adapterInitOverridesFetcher := &mocks.AdapterInitOverridesFetcherMock{
+		FetchCachedFunc: func(ctx context.Context, appID int64) ([]sdkapi.AdapterInitOverride, error) {
+			return overrides, nil
+		},
+	}
+
 	configFetcher := &mocks.ConfigFetcherMock{
 		FetchByUIDCachedFunc: func(ctx context.Context, appId int64, id, uid string) *auction.Config {
 			return nil
 		},
-		MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error) {
+		MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version, unitID string) (*auction.Config, error) {
 			return nil, nil
 		},
 		FetchBidMachinePlacementsFunc: func(ctx context.Context, appID int64) (map[string]string, error) {
@@ -120,9 +132,10 @@ func SetupConfigHandler() apihandlers.ConfigHandler {
 			ConfigFetcher: configFetcher,
 			Geocoder:      GeocoderMock(),
 		},
-		EventLogger:               &event.Logger{Engine: &engine.Log{}},
-		SegmentMatcher:            segmentMatcher,
-		AdapterInitConfigsFetcher: adapterInitConfigsFetcher,
+		EventLogger:                 &event.Logger{Engine: &engine.Log{}},
+		SegmentMatcher:              segmentMatcher,
+		AdapterInitConfigsFetcher:   adapterInitConfigsFetcher,
+		AdapterInitOverridesFetcher: adapterInitOverridesFetcher,
 	}
 }
 
@@ -228,3 +241,143 @@ func TestConfigHandler_TaurusXPlacements(t *testing.T) {
 	// FetchTaurusXPlacementsFunc is called during handler execution
 	t.Log("TaurusX placements functionality is working correctly")
 }
+
+func TestConfigHandler_AdapterInitOverrides(t *testing.T) {
+	applovinPatch := json.RawMessage(`{"ad_unit_ids":["dbadb46cdb8dcbc4"],"mediator":"Bidon"}`)
+
+	tests := []struct {
+		name         string
+		overrides    []sdkapi.AdapterInitOverride
+		wantAdapters []adapter.Key
+		wantApplovin map[string]any
+	}{
+		{
+			name: "app-wide override restricts adapters",
+			overrides: []sdkapi.AdapterInitOverride{
+				{Adapters: []adapter.Key{adapter.BidmachineKey}},
+			},
+			wantAdapters: []adapter.Key{adapter.BidmachineKey},
+		},
+		{
+			name: "segment override takes precedence over app-wide one",
+			overrides: []sdkapi.AdapterInitOverride{
+				{Adapters: []adapter.Key{adapter.BidmachineKey}},
+				{
+					SegmentID: 1,
+					Adapters:  []adapter.Key{adapter.ApplovinKey},
+					Patches:   map[adapter.Key]json.RawMessage{adapter.ApplovinKey: applovinPatch},
+				},
+			},
+			wantAdapters: []adapter.Key{adapter.ApplovinKey},
+			wantApplovin: map[string]any{
+				"sdk_key":     "applovin",
+				"ad_unit_ids": []any{"dbadb46cdb8dcbc4"},
+				"mediator":    "Bidon",
+				"order":       float64(0),
+			},
+		},
+		{
+			name: "patch that doesn't fit init config is skipped",
+			overrides: []sdkapi.AdapterInitOverride{
+				{
+					Adapters: []adapter.Key{adapter.ApplovinKey, adapter.BidmachineKey},
+					Patches: map[adapter.Key]json.RawMessage{
+						adapter.ApplovinKey: json.RawMessage(`{"mediator":"Bidon","sdk_key":1}`),
+					},
+				},
+			},
+			wantAdapters: []adapter.Key{adapter.ApplovinKey, adapter.BidmachineKey},
+			wantApplovin: map[string]any{
+				"sdk_key": "applovin",
+				"order":   float64(0),
+			},
+		},
+		{
+			name: "override of another segment is ignored",
+			overrides: []sdkapi.AdapterInitOverride{
+				{SegmentID: 2, Adapters: []adapter.Key{adapter.ApplovinKey}},
+				{Adapters: []adapter.Key{adapter.AdmobKey, adapter.BidmachineKey}},
+			},
+			wantAdapters: []adapter.Key{adapter.AdmobKey, adapter.BidmachineKey},
+		},
+	}
+
+	reqBody, err := os.ReadFile("testdata/config/valid_request.json")
+	if err != nil {
+		t.Fatalf("Error reading request file: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := SetupConfigHandler(tt.overrides...)
+			rec, err := ExecuteRequest(t, &handler, http.MethodPost, "/v2/config", string(reqBody), &RequestOptions{
+				Headers: map[string]string{
+					"X-Bidon-Version": "0.7.3",
+				},
+			})
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+
+			var resp struct {
+				Init struct {
+					Adapters map[adapter.Key]map[string]any `json:"adapters"`
+				} `json:"init"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Error unmarshaling response: %v", err)
+			}
+
+			gotAdapters := slices.Sorted(maps.Keys(resp.Init.Adapters))
+			if diff := cmp.Diff(tt.wantAdapters, gotAdapters); diff != "" {
+				t.Errorf("adapters mismatch (-want +got):\n%s", diff)
+			}
+
+			if tt.wantApplovin != nil {
+				if diff := cmp.Diff(tt.wantApplovin, resp.Init.Adapters[adapter.ApplovinKey]); diff != "" {
+					t.Errorf("applovin init config mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigHandler_AdapterInitOverridesFetchError(t *testing.T) {
+	handler := SetupConfigHandler()
+	handler.AdapterInitOverridesFetcher = &mocks.AdapterInitOverridesFetcherMock{
+		FetchCachedFunc: func(ctx context.Context, appID int64) ([]sdkapi.AdapterInitOverride, error) {
+			return nil, errors.New("redis is down")
+		},
+	}
+
+	reqBody, err := os.ReadFile("testdata/config/valid_request.json")
+	if err != nil {
+		t.Fatalf("Error reading request file: %v", err)
+	}
+
+	rec, err := ExecuteRequest(t, &handler, http.MethodPost, "/v2/config", string(reqBody), &RequestOptions{
+		Headers: map[string]string{
+			"X-Bidon-Version": "0.7.3",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp struct {
+		Init struct {
+			Adapters map[adapter.Key]map[string]any `json:"adapters"`
+		} `json:"init"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Error unmarshaling response: %v", err)
+	}
+
+	wantApplovin := map[string]any{"sdk_key": "applovin", "order": float64(0)}
+	if diff := cmp.Diff(wantApplovin, resp.Init.Adapters[adapter.ApplovinKey]); diff != "" {
+		t.Errorf("applovin init config mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,126 @@
+package apihandlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bidon-io/bidon-backend/internal/ad"
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/auction"
+	"github.com/bidon-io/bidon-backend/internal/bidding"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/geocoder"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/schema"
+)
+
+// DebugAuctionHandler re-runs an auction in dry-run mode and responds with its trace.
+// DSPs are not requested: adapters get recorded responses, or no bid if there is none.
+// Events are not logged, and bids are neither cached nor stored for notifications.
+type DebugAuctionHandler struct {
+	AppFetcher     AppFetcher
+	Geocoder       Geocoder
+	AuctionService AuctionService
+}
+
+type DebugAuctionRequest struct {
+	// Request is the auction request as sent by SDK.
+	Request    json.RawMessage `json:"request"`
+	SDKVersion string          `json:"sdk_version"`
+	// IP of the device, used for geolocation.
+	IP string `json:"ip"`
+	// Responses are recorded DSP responses by adapter.
+	Responses map[adapter.Key]bidding.RecordedResponse `json:"responses"`
+	// Events are captured events of the auction. Raw responses of bid_request events are used
+	// for adapters without Responses.
+	Events []event.AdEvent `json:"events"`
+}
+
+type DebugAuctionResponse struct {
+	Response *auction.Response `json:"response"`
+	Trace    *auction.Trace    `json:"trace"`
+}
+
+func (h *DebugAuctionHandler) Handle(c echo.Context) error {
+	var debugReq DebugAuctionRequest
+	if err := c.Bind(&debugReq); err != nil {
+		return err
+	}
+
+	var req schema.AuctionRequest
+	if err := json.Unmarshal(debugReq.Request, &req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request: "+err.Error())
+	}
+	req.AdType = ad.Type(c.Param("ad_type"))
+	req.NormalizeValues()
+	req.SetSDKVersion(debugReq.SDKVersion)
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	app, err := h.AppFetcher.FetchCached(ctx, req.App.Key, req.App.Bundle)
+	if err != nil {
+		return err
+	}
+
+	var geoData geocoder.GeoData
+	if debugReq.IP != "" {
+		geoData, err = h.Geocoder.Lookup(ctx, debugReq.IP)
+		if err != nil {
+			c.Logger().Infof("Failed to lookup ip: %v", err)
+		}
+	}
+	country := geoData.CountryCode
+	if country == "" {
+		country = req.Geo.Country
+	}
+	if country == "" {
+		country = geocoder.UnknownCountryCode
+	}
+
+	trace := &auction.Trace{}
+	params := &auction.ExecutionParams{
+		Req:     &req,
+		App:     &app,
+		Country: country,
+		GeoData: geoData,
+		Log: func(str string) {
+			c.Logger().Print(str)
+		},
+		LogErr: func(err error) {
+			sdkapi.LogError(c, err)
+		},
+		DryRun: &bidding.DryRun{Responses: debugReq.recordedResponses()},
+		Trace:  trace,
+	}
+	// Errors like no ads found are reported in trace
+	response, _ := h.AuctionService.Run(ctx, params)
+
+	return c.JSON(http.StatusOK, DebugAuctionResponse{Response: response, Trace: trace})
+}
+
+// recordedResponses returns Responses completed with raw responses of captured bid_request events.
+func (r *DebugAuctionRequest) recordedResponses() map[adapter.Key]bidding.RecordedResponse {
+	responses := make(map[adapter.Key]bidding.RecordedResponse, len(r.Responses))
+	for _, e := range r.Events {
+		if e.EventType != "bid_request" || e.DemandID == "" {
+			continue
+		}
+
+		status, err := strconv.Atoi(e.Status)
+		if err != nil {
+			// Status is not an HTTP status if the DSP wasn't requested, e.g. CIRCUIT_OPEN. There is no response to replay.
+			continue
+		}
+		responses[adapter.Key(e.DemandID)] = bidding.RecordedResponse{Status: status, Body: e.RawResponse}
+	}
+	for key, response := range r.Responses {
+		responses[key] = response
+	}
+
+	return responses
+}
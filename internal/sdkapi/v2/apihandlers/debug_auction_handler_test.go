@@ -0,0 +1,84 @@
+package apihandlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/bidon-io/bidon-backend/internal/adapter"
+	"github.com/bidon-io/bidon-backend/internal/auction"
+	"github.com/bidon-io/bidon-backend/internal/bidding"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/event"
+	"github.com/bidon-io/bidon-backend/internal/sdkapi/v2/apihandlers"
+)
+
+type debugAuctionService struct {
+	params *auction.ExecutionParams
+}
+
+func (s *debugAuctionService) Run(_ context.Context, params *auction.ExecutionParams) (*auction.Response, error) {
+	s.params = params
+	params.Trace.Error = sdkapi.ErrNoAdsFound.Error()
+
+	return nil, sdkapi.ErrNoAdsFound
+}
+
+func TestDebugAuctionHandler_Handle(t *testing.T) {
+	auctionReq, err := os.ReadFile("testdata/auction/valid_request.json")
+	if err != nil {
+		t.Fatalf("Error reading request file: %v", err)
+	}
+	body, err := json.Marshal(apihandlers.DebugAuctionRequest{
+		Request:    auctionReq,
+		SDKVersion: "0.5",
+		IP:         "192.0.2.1",
+		Responses: map[adapter.Key]bidding.RecordedResponse{
+			adapter.BidmachineKey: {Status: http.StatusOK, Body: `{"id":"explicit"}`},
+		},
+		Events: []event.AdEvent{
+			{EventType: "bid_request", DemandID: "bidmachine", Status: "200", RawResponse: `{"id":"captured"}`},
+			{EventType: "bid_request", DemandID: "mobilefuse", Status: "204"},
+			{EventType: "bid_request", DemandID: "meta", Status: "CIRCUIT_OPEN", RawResponse: `{"id":"not requested"}`},
+			{EventType: "show", DemandID: "meta", RawResponse: `{"id":"show"}`},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error marshaling request: %v", err)
+	}
+
+	auctionService := &debugAuctionService{}
+	handler := &apihandlers.DebugAuctionHandler{
+		AppFetcher:     AppFetcherMock(),
+		Geocoder:       GeocoderMock(),
+		AuctionService: auctionService,
+	}
+	rec, err := ExecuteRequest(t, handler, http.MethodPost, "/debug/auction/interstitial", string(body), &RequestOptions{
+		Params: map[string]string{"ad_type": "interstitial"},
+	})
+	CheckResponseCode(t, err, rec.Code, http.StatusOK)
+
+	params := auctionService.params
+	if params.Country != "US" {
+		t.Errorf("Country = %q, want US", params.Country)
+	}
+	wantResponses := map[adapter.Key]bidding.RecordedResponse{
+		adapter.BidmachineKey: {Status: http.StatusOK, Body: `{"id":"explicit"}`},
+		adapter.MobileFuseKey: {Status: http.StatusNoContent},
+	}
+	if diff := cmp.Diff(wantResponses, params.DryRun.Responses); diff != "" {
+		t.Errorf("DryRun.Responses mismatch (-want +got):\n%s", diff)
+	}
+
+	var resp apihandlers.DebugAuctionResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Error unmarshaling response: %v", err)
+	}
+	if resp.Response != nil || resp.Trace == nil || resp.Trace.Error != sdkapi.ErrNoAdsFound.Error() {
+		t.Errorf("Handle() response = %s, want trace with error", rec.Body.String())
+	}
+}
@@ -71,6 +71,8 @@ func prepareLossEvent(req *request[schema.LossRequest, *schema.LossRequest]) *ev
 		ECPM:                    bid.GetPrice(),
 		PriceFloor:              bid.AuctionPriceFloor,
 		Bidding:                 bid.IsBidding(),
+		ExperimentID:            req.experimentID(),
+		ExperimentVariantID:     req.experimentVariantID(),
 		ExternalWinnerDemandID:  req.raw.ExternalWinner.DemandID,
 		ExternalWinnerEcpm:      req.raw.ExternalWinner.GetPrice(),
 	}
@@ -100,3 +100,75 @@ func (mock *AdapterInitConfigsFetcherMock) FetchAdapterInitConfigsCalls() []stru
 	mock.lockFetchAdapterInitConfigs.RUnlock()
 	return calls
 }
+
+// Ensure, that AdapterInitOverridesFetcherMock does implement apihandlers.AdapterInitOverridesFetcher.
+// If this is not the case, regenerate this file with moq.
+var _ apihandlers.AdapterInitOverridesFetcher = &AdapterInitOverridesFetcherMock{}
+
+// AdapterInitOverridesFetcherMock is a mock implementation of apihandlers.AdapterInitOverridesFetcher.
+//
+//	func TestSomethingThatUsesAdapterInitOverridesFetcher(t *testing.T) {
+//
+//		// make and configure a mocked apihandlers.AdapterInitOverridesFetcher
+//		mockedAdapterInitOverridesFetcher := &AdapterInitOverridesFetcherMock{
+//			FetchCachedFunc: func(ctx context.Context, appID int64) ([]sdkapi.AdapterInitOverride, error) {
+//				panic("mock out the FetchCached method")
+//			},
+//		}
+//
+//		// use mockedAdapterInitOverridesFetcher in code that requires apihandlers.AdapterInitOverridesFetcher
+//		// and then make assertions.
+//
+//	}
+type AdapterInitOverridesFetcherMock struct {
+	// FetchCachedFunc mocks the FetchCached method.
+	FetchCachedFunc func(ctx context.Context, appID int64) ([]sdkapi.AdapterInitOverride, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// FetchCached holds details about calls to the FetchCached method.
+		FetchCached []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AppID is the appID argument value.
+			AppID int64
+		}
+	}
+	lockFetchCached sync.RWMutex
+}
+
+// FetchCached calls FetchCachedFunc.
+func (mock *AdapterInitOverridesFetcherMock) FetchCached(ctx context.Context, appID int64) ([]sdkapi.AdapterInitOverride, error) {
+	if mock.FetchCachedFunc == nil {
+		panic("AdapterInitOverridesFetcherMock.FetchCachedFunc: method is nil but AdapterInitOverridesFetcher.FetchCached was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		AppID int64
+	}{
+		Ctx:   ctx,
+		AppID: appID,
+	}
+	mock.lockFetchCached.Lock()
+	mock.calls.FetchCached = append(mock.calls.FetchCached, callInfo)
+	mock.lockFetchCached.Unlock()
+	return mock.FetchCachedFunc(ctx, appID)
+}
+
+// FetchCachedCalls gets all the calls that were made to FetchCached.
+// Check the length with:
+//
+//	len(mockedAdapterInitOverridesFetcher.FetchCachedCalls())
+func (mock *AdapterInitOverridesFetcherMock) FetchCachedCalls() []struct {
+	Ctx   context.Context
+	AppID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		AppID int64
+	}
+	mock.lockFetchCached.RLock()
+	calls = mock.calls.FetchCached
+	mock.lockFetchCached.RUnlock()
+	return calls
+}
@@ -107,7 +107,7 @@ var _ apihandlers.ConfigFetcher = &ConfigFetcherMock{}
 //			FetchByUIDCachedFunc: func(ctx context.Context, appId int64, id string, uid string) *auction.Config {
 //				panic("mock out the FetchByUIDCached method")
 //			},
-//			MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error) {
+//			MatchFunc: func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string, unitID string) (*auction.Config, error) {
 //				panic("mock out the Match method")
 //			},
 //		}
@@ -124,7 +124,7 @@ type ConfigFetcherMock struct {
 	FetchByUIDCachedFunc func(ctx context.Context, appId int64, id string, uid string) *auction.Config
 
 	// MatchFunc mocks the Match method.
-	MatchFunc func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error)
+	MatchFunc func(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string, unitID string) (*auction.Config, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -158,6 +158,8 @@ type ConfigFetcherMock struct {
 			SegmentID int64
 			// Version is the version argument value.
 			Version string
+			// UnitID is the unitID argument value.
+			UnitID string
 		}
 	}
 	lockFetchBidMachinePlacements sync.RWMutex
@@ -246,7 +248,7 @@ func (mock *ConfigFetcherMock) FetchByUIDCachedCalls() []struct {
 }
 
 // Match calls MatchFunc.
-func (mock *ConfigFetcherMock) Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string) (*auction.Config, error) {
+func (mock *ConfigFetcherMock) Match(ctx context.Context, appID int64, adType ad.Type, segmentID int64, version string, unitID string) (*auction.Config, error) {
 	if mock.MatchFunc == nil {
 		panic("ConfigFetcherMock.MatchFunc: method is nil but ConfigFetcher.Match was just called")
 	}
@@ -256,17 +258,19 @@ func (mock *ConfigFetcherMock) Match(ctx context.Context, appID int64, adType ad
 		AdType    ad.Type
 		SegmentID int64
 		Version   string
+		UnitID    string
 	}{
 		Ctx:       ctx,
 		AppID:     appID,
 		AdType:    adType,
 		SegmentID: segmentID,
 		Version:   version,
+		UnitID:    unitID,
 	}
 	mock.lockMatch.Lock()
 	mock.calls.Match = append(mock.calls.Match, callInfo)
 	mock.lockMatch.Unlock()
-	return mock.MatchFunc(ctx, appID, adType, segmentID, version)
+	return mock.MatchFunc(ctx, appID, adType, segmentID, version, unitID)
 }
 
 // MatchCalls gets all the calls that were made to Match.
@@ -279,6 +283,7 @@ func (mock *ConfigFetcherMock) MatchCalls() []struct {
 	AdType    ad.Type
 	SegmentID int64
 	Version   string
+	UnitID    string
 } {
 	var calls []struct {
 		Ctx       context.Context
@@ -286,6 +291,7 @@ func (mock *ConfigFetcherMock) MatchCalls() []struct {
 		AdType    ad.Type
 		SegmentID int64
 		Version   string
+		UnitID    string
 	}
 	mock.lockMatch.RLock()
 	calls = mock.calls.Match
@@ -56,6 +56,8 @@ func prepareRewardEvent(req *request[schema.RewardRequest, *schema.RewardRequest
 		ECPM:                    bid.GetPrice(),
 		PriceFloor:              bid.AuctionPriceFloor,
 		Bidding:                 bid.IsBidding(),
+		ExperimentID:            req.experimentID(),
+		ExperimentVariantID:     req.experimentVariantID(),
 	}
 
 	return event.NewAdEvent(&req.raw.BaseRequest, adRequestParams, req.geoData)
@@ -83,6 +83,8 @@ func prepareShowEvent(ctx context.Context, req *request[schema.ShowRequest, *sch
 		ECPM:                    bid.GetPrice(),
 		PriceFloor:              bid.AuctionPriceFloor,
 		Bidding:                 bid.IsBidding(),
+		ExperimentID:            req.experimentID(),
+		ExperimentVariantID:     req.experimentVariantID(),
 	}
 
 	return event.NewAdEvent(&req.raw.BaseRequest, adRequestParams, req.geoData)
@@ -72,6 +72,8 @@ func prepareStatsEvents(req *request[schema.StatsRequest, *schema.StatsRequest])
 		ECPM:                    stats.Result.GetWinnerPrice(),
 		PriceFloor:              stats.AuctionPricefloor,
 		Bidding:                 stats.Result.IsBidding(),
+		ExperimentID:            req.experimentID(),
+		ExperimentVariantID:     req.experimentVariantID(),
 		TimingMap:               event.TimingMap{"auction": {stats.Result.AuctionStartTS, stats.Result.AuctionFinishTS}},
 	}
 	events = append(events, event.NewAdEvent(&req.raw.BaseRequest, adRequestParams, req.geoData))
@@ -93,6 +95,8 @@ func prepareStatsEvents(req *request[schema.StatsRequest, *schema.StatsRequest])
 				ECPM:                    adUnit.GetPrice(),
 				PriceFloor:              stats.AuctionPricefloor,
 				Bidding:                 false,
+				ExperimentID:            req.experimentID(),
+				ExperimentVariantID:     req.experimentVariantID(),
 				TimingMap:               event.TimingMap{"fill": {adUnit.FillStartTS, adUnit.FillFinishTS}},
 				Error:                   adUnit.ErrorMessage,
 			}
@@ -113,6 +117,8 @@ func prepareStatsEvents(req *request[schema.StatsRequest, *schema.StatsRequest])
 				ECPM:                    adUnit.GetPrice(),
 				PriceFloor:              stats.AuctionPricefloor,
 				Bidding:                 true,
+				ExperimentID:            req.experimentID(),
+				ExperimentVariantID:     req.experimentVariantID(),
 				TimingMap: event.TimingMap{
 					"fill":  {adUnit.FillStartTS, adUnit.FillFinishTS},
 					"token": {adUnit.TokenStartTS, adUnit.TokenFinishTS},
@@ -18,18 +18,19 @@ import (
 )
 
 type Router struct {
-	ConfigFetcher             *auctionstore.ConfigFetcher
-	AppFetcher                *sdkapistore.AppFetcher
-	SegmentMatcher            *segment.Matcher
-	AdUnitsMatcher            *auctionstore.AdUnitsMatcher
-	NotificationHandler       notification.Handler
-	GeoCoder                  *geocoder.Geocoder
-	EventLogger               *event.Logger
-	AdapterInitConfigsFetcher *sdkapistore.AdapterInitConfigsFetcher
-	ConfigurationFetcher      *adapterstore.ConfigurationFetcher
-	BiddingBuilder            *bidding.Builder
-	AuctionService            *auction.Service
-	AdUnitLookup              *sdkapistore.AdUnitLookup
+	ConfigFetcher               *auctionstore.ConfigFetcher
+	AppFetcher                  *sdkapistore.AppFetcher
+	SegmentMatcher              *segment.Matcher
+	AdUnitsMatcher              *auctionstore.AdUnitsMatcher
+	NotificationHandler         notification.Handler
+	GeoCoder                    *geocoder.Geocoder
+	EventLogger                 *event.Logger
+	AdapterInitConfigsFetcher   *sdkapistore.AdapterInitConfigsFetcher
+	AdapterInitOverridesFetcher *sdkapistore.AdapterInitOverridesFetcher
+	ConfigurationFetcher        *adapterstore.ConfigurationFetcher
+	BiddingBuilder              *bidding.Builder
+	AuctionService              *auction.Service
+	AdUnitLookup                *sdkapistore.AdUnitLookup
 }
 
 func (r *Router) RegisterRoutes(g *echo.Group) {
@@ -56,9 +57,10 @@ func (r *Router) RegisterRoutes(g *echo.Group) {
 			ConfigFetcher: r.ConfigFetcher,
 			Geocoder:      r.GeoCoder,
 		},
-		SegmentMatcher:            r.SegmentMatcher,
-		AdapterInitConfigsFetcher: r.AdapterInitConfigsFetcher,
-		EventLogger:               r.EventLogger,
+		SegmentMatcher:              r.SegmentMatcher,
+		AdapterInitConfigsFetcher:   r.AdapterInitConfigsFetcher,
+		AdapterInitOverridesFetcher: r.AdapterInitOverridesFetcher,
+		EventLogger:                 r.EventLogger,
 	}
 	showHandler := apihandlers.ShowHandler{
 		BaseHandler: &apihandlers.BaseHandler[schema.ShowRequest, *schema.ShowRequest]{
@@ -116,3 +118,14 @@ func (r *Router) RegisterRoutes(g *echo.Group) {
 		WinHandler:     &winHandler,
 	})
 }
+
+// RegisterDebugRoutes registers endpoints for debugging auctions. They must be protected by authorization.
+func (r *Router) RegisterDebugRoutes(g *echo.Group) {
+	debugAuctionHandler := apihandlers.DebugAuctionHandler{
+		AppFetcher:     r.AppFetcher,
+		Geocoder:       r.GeoCoder,
+		AuctionService: r.AuctionService,
+	}
+
+	g.POST("/auction/:ad_type", debugAuctionHandler.Handle)
+}
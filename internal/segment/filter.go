@@ -0,0 +1,433 @@
+package segment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// Filter types supported by the segment engine.
+const (
+	GroupFilterType             = "group"
+	CountryFilterType           = "country"
+	CustomStringFilterType      = "custom_string"
+	GameLevelFilterType         = "game_level"
+	AgeFilterType               = "age"
+	TotalInAppsAmountFilterType = "total_in_apps_amount"
+	IsPayingFilterType          = "is_paying"
+	GenderFilterType            = "gender"
+	DeviceOSFilterType          = "device_os"
+	SDKVersionFilterType        = "sdk_version"
+	AppVersionFilterType        = "app_version"
+	SessionUptimeFilterType     = "session_uptime"
+)
+
+// Filter operators supported by the segment engine. Not every operator is applicable to every filter type.
+const (
+	AndOperator        = "AND"
+	OrOperator         = "OR"
+	EqOperator         = "=="
+	NotEqOperator      = "!="
+	GtOperator         = ">"
+	GteOperator        = ">="
+	LtOperator         = "<"
+	LteOperator        = "<="
+	BetweenOperator    = "BETWEEN"
+	InOperator         = "IN"
+	NotInOperator      = "NOT IN"
+	MatchesOperator    = "MATCHES"
+	StartsWithOperator = "STARTS WITH"
+)
+
+type valueKind int
+
+const (
+	stringKind valueKind = iota
+	numberKind
+	boolKind
+	versionKind
+)
+
+var filterKinds = map[string]valueKind{
+	CountryFilterType:           stringKind,
+	CustomStringFilterType:      stringKind,
+	GenderFilterType:            stringKind,
+	DeviceOSFilterType:          stringKind,
+	GameLevelFilterType:         numberKind,
+	AgeFilterType:               numberKind,
+	TotalInAppsAmountFilterType: numberKind,
+	SessionUptimeFilterType:     numberKind,
+	IsPayingFilterType:          boolKind,
+	SDKVersionFilterType:        versionKind,
+	AppVersionFilterType:        versionKind,
+}
+
+var kindOperators = map[valueKind][]string{
+	stringKind:  {EqOperator, NotEqOperator, InOperator, NotInOperator, MatchesOperator, StartsWithOperator},
+	numberKind:  {EqOperator, NotEqOperator, GtOperator, GteOperator, LtOperator, LteOperator, BetweenOperator, InOperator, NotInOperator},
+	boolKind:    {EqOperator, NotEqOperator},
+	versionKind: {EqOperator, NotEqOperator, GtOperator, GteOperator, LtOperator, LteOperator, BetweenOperator},
+}
+
+// attributes holds request values the filters are evaluated against.
+type attributes struct {
+	params *Params
+	ext    Ext
+	extErr error
+}
+
+func newAttributes(params *Params) *attributes {
+	attrs := &attributes{params: params}
+	attrs.extErr = json.Unmarshal([]byte(params.Ext), &attrs.ext)
+
+	return attrs
+}
+
+// ignoresCase reports whether string values of filter type are compared case-insensitively.
+func ignoresCase(filterType string) bool {
+	return filterType == GenderFilterType || filterType == DeviceOSFilterType
+}
+
+func (f Filter) match(attrs *attributes) bool {
+	switch f.Type {
+	case GroupFilterType:
+		return f.matchGroup(attrs)
+	case CountryFilterType:
+		return matchCountry(f, attrs.params.Country)
+	case DeviceOSFilterType:
+		return matchString(f, attrs.params.DeviceOS, ignoresCase(f.Type))
+	case SDKVersionFilterType:
+		return matchVersion(f, attrs.params.SDKVersion)
+	case AppVersionFilterType:
+		return matchVersion(f, attrs.params.AppVersion)
+	case SessionUptimeFilterType:
+		return matchNumber(f, float64(attrs.params.SessionUptime))
+	}
+
+	// The remaining filters are evaluated against segment ext sent by SDK.
+	if attrs.extErr != nil {
+		return false
+	}
+
+	switch f.Type {
+	case CustomStringFilterType:
+		return matchCustomAttribute(f, attrs.ext.CustomAttributes)
+	case GenderFilterType:
+		return matchString(f, attrs.ext.Gender, ignoresCase(f.Type))
+	case GameLevelFilterType:
+		return matchNumber(f, float64(attrs.ext.GameLevel))
+	case AgeFilterType:
+		return matchNumber(f, float64(attrs.ext.Age))
+	case TotalInAppsAmountFilterType:
+		return matchNumber(f, attrs.ext.TotalInAppsAmount)
+	case IsPayingFilterType:
+		return matchBool(f, attrs.ext.IsPaying)
+	default:
+		return false
+	}
+}
+
+// explain evaluates filter and returns the filter that caused mismatch, or nil if filter matches.
+// For AND groups the first failed nested filter is reported, OR groups are reported as a whole.
+func (f Filter) explain(attrs *attributes) *Filter {
+	if f.Type == GroupFilterType && f.Operator == AndOperator && len(f.Filters) > 0 {
+		for _, filter := range f.Filters {
+			if failed := filter.explain(attrs); failed != nil {
+				return failed
+			}
+		}
+
+		return nil
+	}
+
+	if !f.match(attrs) {
+		return &f
+	}
+
+	return nil
+}
+
+func (f Filter) matchGroup(attrs *attributes) bool {
+	if len(f.Filters) == 0 {
+		return false
+	}
+
+	switch f.Operator {
+	case AndOperator:
+		for _, filter := range f.Filters {
+			if !filter.match(attrs) {
+				return false
+			}
+		}
+		return true
+	case OrOperator:
+		for _, filter := range f.Filters {
+			if filter.match(attrs) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func matchString(f Filter, value string, ignoreCase bool) bool {
+	equal := func(a, b string) bool {
+		if ignoreCase {
+			return strings.EqualFold(a, b)
+		}
+		return a == b
+	}
+	contains := func() bool {
+		return slices.ContainsFunc(f.Values, func(v string) bool { return equal(v, value) })
+	}
+
+	if len(f.Values) == 0 {
+		return false
+	}
+
+	switch f.Operator {
+	case EqOperator:
+		return equal(f.Values[0], value)
+	case NotEqOperator:
+		return !equal(f.Values[0], value)
+	case InOperator:
+		return contains()
+	case NotInOperator:
+		return !contains()
+	case StartsWithOperator:
+		return slices.ContainsFunc(f.Values, func(prefix string) bool {
+			return len(value) >= len(prefix) && equal(value[:len(prefix)], prefix)
+		})
+	case MatchesOperator:
+		re, err := compileRegexp(f.Values[0])
+		if err != nil {
+			return false
+		}
+		return re.MatchString(value)
+	default:
+		return false
+	}
+}
+
+func matchNumber(f Filter, value float64) bool {
+	values, err := parseNumbers(f.Values)
+	if err != nil || len(values) == 0 {
+		return false
+	}
+
+	switch f.Operator {
+	case EqOperator:
+		return value == values[0]
+	case NotEqOperator:
+		return value != values[0]
+	case GtOperator:
+		return value > values[0]
+	case GteOperator:
+		return value >= values[0]
+	case LtOperator:
+		return value < values[0]
+	case LteOperator:
+		return value <= values[0]
+	case BetweenOperator:
+		return len(values) == 2 && value >= values[0] && value <= values[1]
+	case InOperator:
+		return slices.Contains(values, value)
+	case NotInOperator:
+		return !slices.Contains(values, value)
+	default:
+		return false
+	}
+}
+
+func matchBool(f Filter, value bool) bool {
+	if len(f.Values) == 0 {
+		return false
+	}
+
+	expected, err := strconv.ParseBool(f.Values[0])
+	if err != nil {
+		return false
+	}
+
+	switch f.Operator {
+	case EqOperator:
+		return value == expected
+	case NotEqOperator:
+		return value != expected
+	default:
+		return false
+	}
+}
+
+func matchVersion(f Filter, value string) bool {
+	version, err := semver.NewVersion(value)
+	if err != nil {
+		return false
+	}
+
+	values, err := parseVersions(f.Values)
+	if err != nil || len(values) == 0 {
+		return false
+	}
+
+	switch f.Operator {
+	case EqOperator:
+		return version.Equal(values[0])
+	case NotEqOperator:
+		return !version.Equal(values[0])
+	case GtOperator:
+		return version.GreaterThan(values[0])
+	case GteOperator:
+		return version.GreaterThanEqual(values[0])
+	case LtOperator:
+		return version.LessThan(values[0])
+	case LteOperator:
+		return version.LessThanEqual(values[0])
+	case BetweenOperator:
+		return len(values) == 2 && version.GreaterThanEqual(values[0]) && version.LessThanEqual(values[1])
+	default:
+		return false
+	}
+}
+
+func parseNumbers(values []string) ([]float64, error) {
+	numbers := make([]float64, len(values))
+	for i, v := range values {
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a number", v)
+		}
+		numbers[i] = n
+	}
+
+	return numbers, nil
+}
+
+func parseVersions(values []string) ([]*semver.Version, error) {
+	versions := make([]*semver.Version, len(values))
+	for i, v := range values {
+		version, err := semver.NewVersion(v)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a version", v)
+		}
+		versions[i] = version
+	}
+
+	return versions, nil
+}
+
+var regexpCache sync.Map
+
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexpCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexpCache.Store(pattern, re)
+
+	return re, nil
+}
+
+// ValidateFilters checks that filters tree is well-formed: every filter has a known type,
+// an operator applicable to that type and values of the expected shape.
+func ValidateFilters(filters []Filter) error {
+	if len(filters) == 0 {
+		return errors.New("at least one filter is required")
+	}
+
+	var errs []error
+	for i, filter := range filters {
+		if err := filter.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("filters[%d]: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (f Filter) validate() error {
+	if f.Type == GroupFilterType {
+		if f.Operator != AndOperator && f.Operator != OrOperator {
+			return fmt.Errorf("unsupported operator %q for group, expected %q or %q", f.Operator, AndOperator, OrOperator)
+		}
+
+		return ValidateFilters(f.Filters)
+	}
+
+	kind, ok := filterKinds[f.Type]
+	if !ok {
+		return fmt.Errorf("unsupported filter type %q", f.Type)
+	}
+	if len(f.Filters) > 0 {
+		return fmt.Errorf("nested filters are allowed only for %q type", GroupFilterType)
+	}
+	if !slices.Contains(kindOperators[kind], f.Operator) {
+		return fmt.Errorf("unsupported operator %q for filter type %q", f.Operator, f.Type)
+	}
+	if f.Type == CustomStringFilterType && f.Name == "" {
+		return errors.New("name is required for custom_string filter")
+	}
+
+	return validateValues(kind, f.Operator, f.Values)
+}
+
+func validateValues(kind valueKind, operator string, values []string) error {
+	switch operator {
+	case BetweenOperator:
+		if len(values) != 2 {
+			return fmt.Errorf("operator %q requires exactly 2 values", operator)
+		}
+	case InOperator, NotInOperator, StartsWithOperator:
+		if len(values) == 0 {
+			return fmt.Errorf("operator %q requires at least 1 value", operator)
+		}
+	default:
+		if len(values) != 1 {
+			return fmt.Errorf("operator %q requires exactly 1 value", operator)
+		}
+	}
+
+	switch kind {
+	case numberKind:
+		numbers, err := parseNumbers(values)
+		if err != nil {
+			return err
+		}
+		if operator == BetweenOperator && numbers[0] > numbers[1] {
+			return errors.New("lower bound of range is greater than upper bound")
+		}
+	case boolKind:
+		if _, err := strconv.ParseBool(values[0]); err != nil {
+			return fmt.Errorf("value %q is not a boolean", values[0])
+		}
+	case versionKind:
+		versions, err := parseVersions(values)
+		if err != nil {
+			return err
+		}
+		if operator == BetweenOperator && versions[0].GreaterThan(versions[1]) {
+			return errors.New("lower bound of range is greater than upper bound")
+		}
+	case stringKind:
+		if operator == MatchesOperator {
+			if _, err := regexp.Compile(values[0]); err != nil {
+				return fmt.Errorf("invalid regular expression %q: %v", values[0], err)
+			}
+		}
+	}
+
+	return nil
+}
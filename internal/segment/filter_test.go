@@ -0,0 +1,259 @@
+package segment
+
+import (
+	"testing"
+)
+
+func TestFilter_match(t *testing.T) {
+	params := &Params{
+		Country:       "US",
+		Ext:           `{"gender":"female","total_in_apps_amount":12.5,"is_paying":true,"game_level":7,"age":25,"custom_attributes":{"best_friend":"Winnie Pooh"}}`,
+		DeviceOS:      "iOS",
+		SDKVersion:    "0.7.3",
+		AppVersion:    "2.10.0",
+		SessionUptime: 60000,
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{
+			name:   "country IN",
+			filter: Filter{Type: CountryFilterType, Operator: InOperator, Values: []string{"US", "CA"}},
+			want:   true,
+		},
+		{
+			name:   "country STARTS WITH",
+			filter: Filter{Type: CountryFilterType, Operator: StartsWithOperator, Values: []string{"U"}},
+			want:   true,
+		},
+		{
+			name:   "game_level >=",
+			filter: Filter{Type: GameLevelFilterType, Operator: GteOperator, Values: []string{"7"}},
+			want:   true,
+		},
+		{
+			name:   "game_level >",
+			filter: Filter{Type: GameLevelFilterType, Operator: GtOperator, Values: []string{"7"}},
+			want:   false,
+		},
+		{
+			name:   "age BETWEEN",
+			filter: Filter{Type: AgeFilterType, Operator: BetweenOperator, Values: []string{"18", "30"}},
+			want:   true,
+		},
+		{
+			name:   "age NOT IN",
+			filter: Filter{Type: AgeFilterType, Operator: NotInOperator, Values: []string{"25", "26"}},
+			want:   false,
+		},
+		{
+			name:   "total_in_apps_amount <",
+			filter: Filter{Type: TotalInAppsAmountFilterType, Operator: LtOperator, Values: []string{"12.6"}},
+			want:   true,
+		},
+		{
+			name:   "is_paying ==",
+			filter: Filter{Type: IsPayingFilterType, Operator: EqOperator, Values: []string{"true"}},
+			want:   true,
+		},
+		{
+			name:   "gender is case insensitive",
+			filter: Filter{Type: GenderFilterType, Operator: EqOperator, Values: []string{"FEMALE"}},
+			want:   true,
+		},
+		{
+			name:   "device_os is case insensitive",
+			filter: Filter{Type: DeviceOSFilterType, Operator: InOperator, Values: []string{"ios"}},
+			want:   true,
+		},
+		{
+			name:   "sdk_version >= compares semver",
+			filter: Filter{Type: SDKVersionFilterType, Operator: GteOperator, Values: []string{"0.7.0"}},
+			want:   true,
+		},
+		{
+			name:   "app_version > compares semver, not strings",
+			filter: Filter{Type: AppVersionFilterType, Operator: GtOperator, Values: []string{"2.9.0"}},
+			want:   true,
+		},
+		{
+			name:   "app_version BETWEEN",
+			filter: Filter{Type: AppVersionFilterType, Operator: BetweenOperator, Values: []string{"1.0.0", "2.0.0"}},
+			want:   false,
+		},
+		{
+			name:   "session_uptime >",
+			filter: Filter{Type: SessionUptimeFilterType, Operator: GtOperator, Values: []string{"30000"}},
+			want:   true,
+		},
+		{
+			name:   "custom_string MATCHES",
+			filter: Filter{Type: CustomStringFilterType, Name: "best_friend", Operator: MatchesOperator, Values: []string{"^Winnie"}},
+			want:   true,
+		},
+		{
+			name:   "custom_string STARTS WITH on missing attribute",
+			filter: Filter{Type: CustomStringFilterType, Name: "enemy", Operator: StartsWithOperator, Values: []string{"W"}},
+			want:   false,
+		},
+		{
+			name:   "custom_string != on missing attribute",
+			filter: Filter{Type: CustomStringFilterType, Name: "enemy", Operator: NotEqOperator, Values: []string{"Tigger"}},
+			want:   true,
+		},
+		{
+			name:   "custom_string NOT IN on missing attribute",
+			filter: Filter{Type: CustomStringFilterType, Name: "enemy", Operator: NotInOperator, Values: []string{"Tigger", "Eeyore"}},
+			want:   true,
+		},
+		{
+			name:   "custom_string == on missing attribute",
+			filter: Filter{Type: CustomStringFilterType, Name: "enemy", Operator: EqOperator, Values: []string{"Tigger"}},
+			want:   false,
+		},
+		{
+			name: "OR group",
+			filter: Filter{Type: GroupFilterType, Operator: OrOperator, Filters: []Filter{
+				{Type: CountryFilterType, Operator: InOperator, Values: []string{"DE"}},
+				{Type: AgeFilterType, Operator: GtOperator, Values: []string{"21"}},
+			}},
+			want: true,
+		},
+		{
+			name: "AND group with nested OR group",
+			filter: Filter{Type: GroupFilterType, Operator: AndOperator, Filters: []Filter{
+				{Type: IsPayingFilterType, Operator: EqOperator, Values: []string{"true"}},
+				{Type: GroupFilterType, Operator: OrOperator, Filters: []Filter{
+					{Type: CountryFilterType, Operator: InOperator, Values: []string{"DE"}},
+					{Type: DeviceOSFilterType, Operator: EqOperator, Values: []string{"android"}},
+				}},
+			}},
+			want: false,
+		},
+		{
+			name:   "empty group",
+			filter: Filter{Type: GroupFilterType, Operator: AndOperator},
+			want:   false,
+		},
+		{
+			name:   "unknown type",
+			filter: Filter{Type: "unknown", Operator: EqOperator, Values: []string{"1"}},
+			want:   false,
+		},
+	}
+
+	attrs := newAttributes(params)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.match(attrs); got != tt.want {
+				t.Errorf("match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter_match_InvalidExt(t *testing.T) {
+	attrs := newAttributes(&Params{Country: "US", Ext: "invalid JSON"})
+
+	filter := Filter{Type: AgeFilterType, Operator: LtOperator, Values: []string{"100"}}
+	if filter.match(attrs) {
+		t.Errorf("match() = true, want false for ext filter with invalid ext")
+	}
+
+	filter = Filter{Type: CountryFilterType, Operator: InOperator, Values: []string{"US"}}
+	if !filter.match(attrs) {
+		t.Errorf("match() = false, want true for country filter with invalid ext")
+	}
+}
+
+func TestValidateFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []Filter
+		wantErr bool
+	}{
+		{
+			name: "valid tree",
+			filters: []Filter{
+				{Type: CountryFilterType, Operator: NotInOperator, Values: []string{"US"}},
+				{Type: GroupFilterType, Operator: OrOperator, Filters: []Filter{
+					{Type: AgeFilterType, Operator: BetweenOperator, Values: []string{"18", "30"}},
+					{Type: SDKVersionFilterType, Operator: LtOperator, Values: []string{"0.7.0"}},
+					{Type: CustomStringFilterType, Name: "tier", Operator: MatchesOperator, Values: []string{"^gold|silver$"}},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "empty filters",
+			filters: []Filter{},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			filters: []Filter{{Type: "city", Operator: EqOperator, Values: []string{"Berlin"}}},
+			wantErr: true,
+		},
+		{
+			name:    "operator not applicable to type",
+			filters: []Filter{{Type: IsPayingFilterType, Operator: GtOperator, Values: []string{"true"}}},
+			wantErr: true,
+		},
+		{
+			name:    "non numeric value",
+			filters: []Filter{{Type: GameLevelFilterType, Operator: GtOperator, Values: []string{"ten"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid range",
+			filters: []Filter{{Type: AgeFilterType, Operator: BetweenOperator, Values: []string{"30", "18"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid version",
+			filters: []Filter{{Type: AppVersionFilterType, Operator: EqOperator, Values: []string{"latest"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid regexp",
+			filters: []Filter{{Type: CountryFilterType, Operator: MatchesOperator, Values: []string{"(US"}}},
+			wantErr: true,
+		},
+		{
+			name:    "custom_string without name",
+			filters: []Filter{{Type: CustomStringFilterType, Operator: EqOperator, Values: []string{"value"}}},
+			wantErr: true,
+		},
+		{
+			name:    "too many values",
+			filters: []Filter{{Type: GenderFilterType, Operator: EqOperator, Values: []string{"male", "female"}}},
+			wantErr: true,
+		},
+		{
+			name: "invalid nested filter",
+			filters: []Filter{
+				{Type: GroupFilterType, Operator: AndOperator, Filters: []Filter{
+					{Type: CountryFilterType, Operator: GtOperator, Values: []string{"US"}},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid group operator",
+			filters: []Filter{{Type: GroupFilterType, Operator: "XOR", Filters: []Filter{{Type: CountryFilterType, Operator: InOperator, Values: []string{"US"}}}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFilters(tt.filters)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFilters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
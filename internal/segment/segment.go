@@ -2,7 +2,6 @@ package segment
 
 import (
 	"context"
-	"encoding/json"
 	"strconv"
 )
 
@@ -16,9 +15,13 @@ type Ext struct {
 }
 
 type Params struct {
-	Country string `json:"country"`
-	Ext     string `json:"ext"`
-	AppID   int64  `json:"app_id"`
+	Country       string `json:"country"`
+	Ext           string `json:"ext"`
+	AppID         int64  `json:"app_id"`
+	DeviceOS      string `json:"device_os"`
+	SDKVersion    string `json:"sdk_version"`
+	AppVersion    string `json:"app_version"`
+	SessionUptime int    `json:"session_uptime"`
 }
 
 type Segment struct {
@@ -36,11 +39,14 @@ func (s Segment) StringID() string {
 	return segmentID
 }
 
+// Filter is a single targeting rule of a segment. Filters of GroupFilterType combine nested Filters
+// with AND/OR operator, every other type compares a request attribute against Values.
 type Filter struct {
 	Type     string   `json:"type"`
 	Name     string   `json:"name"`
 	Operator string   `json:"operator"`
 	Values   []string `json:"values"`
+	Filters  []Filter `json:"filters,omitempty"`
 }
 
 type Matcher struct {
@@ -59,8 +65,9 @@ func (m *Matcher) Match(ctx context.Context, params *Params) Segment {
 		return Segment{ID: 0}
 	}
 
+	attrs := newAttributes(params)
 	for _, sgmnt := range sgmnts {
-		if isSegmentMatch(sgmnt, params) {
+		if isSegmentMatch(sgmnt, attrs) {
 			return sgmnt
 		}
 	}
@@ -68,62 +75,71 @@ func (m *Matcher) Match(ctx context.Context, params *Params) Segment {
 	return Segment{ID: 0}
 }
 
-func isSegmentMatch(sgmnt Segment, params *Params) bool {
+// Evaluation is a result of evaluating a single segment against request params.
+type Evaluation struct {
+	Segment      Segment `json:"segment"`
+	Matched      bool    `json:"matched"`
+	FailedFilter *Filter `json:"failed_filter"`
+}
+
+// Evaluate evaluates every segment of the app in priority order and explains why each of them matched or not.
+// Unlike Match, it does not stop on the first matched segment, so overlapping segments can be inspected.
+func (m *Matcher) Evaluate(ctx context.Context, params *Params) ([]Evaluation, error) {
+	sgmnts, err := m.Fetcher.FetchCached(ctx, params.AppID)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := newAttributes(params)
+	evaluations := make([]Evaluation, len(sgmnts))
+	for i, sgmnt := range sgmnts {
+		evaluations[i] = evaluateSegment(sgmnt, attrs)
+	}
+
+	return evaluations, nil
+}
+
+func evaluateSegment(sgmnt Segment, attrs *attributes) Evaluation {
+	evaluation := Evaluation{Segment: sgmnt}
 	if len(sgmnt.Filters) == 0 {
-		return false
+		return evaluation
 	}
 
 	for _, filter := range sgmnt.Filters {
-		switch filter.Type {
-		case "country":
-			if !matchCountry(filter, params.Country) {
-				return false
-			}
-		case "custom_string":
-			if !matchCustomString(filter, params.Ext) {
-				return false
-			}
-		default:
-			return false
+		if failed := filter.explain(attrs); failed != nil {
+			evaluation.FailedFilter = failed
+			return evaluation
 		}
 	}
 
-	return true
+	evaluation.Matched = true
+	return evaluation
 }
 
-func matchCountry(filter Filter, country string) bool {
-	switch filter.Operator {
-	case "IN":
-		return containsString(filter.Values, country)
-	case "NOT IN":
-		return !containsString(filter.Values, country)
-	default:
+func isSegmentMatch(sgmnt Segment, attrs *attributes) bool {
+	if len(sgmnt.Filters) == 0 {
 		return false
 	}
-}
-
-func matchCustomString(filter Filter, ext string) bool {
-	var parsedExt Ext
 
-	if err := json.Unmarshal([]byte(ext), &parsedExt); err != nil {
-		return false
+	for _, filter := range sgmnt.Filters {
+		if !filter.match(attrs) {
+			return false
+		}
 	}
 
-	switch filter.Operator {
-	case "==":
-		return filter.Values[0] == parsedExt.CustomAttributes[filter.Name]
-	case "!=":
-		return filter.Values[0] != parsedExt.CustomAttributes[filter.Name]
-	default:
-		return false
-	}
+	return true
 }
 
-func containsString(values []string, str string) bool {
-	for _, v := range values {
-		if v == str {
-			return true
-		}
+func matchCountry(filter Filter, country string) bool {
+	return matchString(filter, country, false)
+}
+
+func matchCustomAttribute(filter Filter, customAttributes map[string]any) bool {
+	value, ok := customAttributes[filter.Name].(string)
+	if !ok {
+		// Missing attribute has no value to compare, so it matches only negative filters
+		return len(filter.Values) > 0 && (filter.Operator == NotEqOperator || filter.Operator == NotInOperator)
 	}
-	return false
+
+	return matchString(filter, value, false)
 }
@@ -120,6 +120,59 @@ func TestMatchTwoFilters(t *testing.T) {
 	}
 }
 
+func TestMatcher_Evaluate(t *testing.T) {
+	ctx := context.Background()
+	failedFilter := segment.Filter{Type: "game_level", Operator: ">", Values: []string{"10"}}
+	segments := []segment.Segment{
+		{
+			ID: 1,
+			Filters: []segment.Filter{
+				{Type: "country", Operator: "IN", Values: []string{"US"}},
+				{Type: "group", Operator: "AND", Filters: []segment.Filter{
+					{Type: "is_paying", Operator: "==", Values: []string{"true"}},
+					failedFilter,
+				}},
+			},
+		},
+		{
+			ID: 2,
+			Filters: []segment.Filter{
+				{Type: "country", Operator: "IN", Values: []string{"US"}},
+			},
+		},
+		{
+			ID: 3,
+		},
+	}
+
+	segmentMatcher := &segment.Matcher{
+		Fetcher: &segmentmocks.FetcherMock{
+			FetchCachedFunc: func(ctx context.Context, appID int64) ([]segment.Segment, error) {
+				return segments, nil
+			},
+		},
+	}
+	params := &segment.Params{
+		Ext:     `{"is_paying":true,"game_level":5}`,
+		Country: "US",
+		AppID:   1,
+	}
+
+	result, err := segmentMatcher.Evaluate(ctx, params)
+	if err != nil {
+		t.Fatalf("segmentMatcher.Evaluate returned unexpected error: %v", err)
+	}
+
+	expected := []segment.Evaluation{
+		{Segment: segments[0], Matched: false, FailedFilter: &failedFilter},
+		{Segment: segments[1], Matched: true},
+		{Segment: segments[2], Matched: false},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("segmentMatcher.Evaluate returned unexpected result. Expected: %+v, Got: %+v", expected, result)
+	}
+}
+
 func TestSegment_StringID_known(t *testing.T) {
 	segment := segment.Segment{ID: 123}
 
@@ -0,0 +1,274 @@
+package segment
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// Shadowing describes a segment that can never be matched, because every request it matches
+// is already matched by a segment with higher priority.
+type Shadowing struct {
+	Segment    Segment `json:"segment"`
+	ShadowedBy Segment `json:"shadowed_by"`
+}
+
+// FindShadowed statically analyzes segments and returns those fully shadowed by higher-priority ones.
+// Segments must be ordered by priority, as returned by Fetcher. The analysis is conservative:
+// only shadowing that can be proven from filters is reported.
+func FindShadowed(sgmnts []Segment) []Shadowing {
+	var shadowings []Shadowing
+
+	for i, sgmnt := range sgmnts {
+		if len(sgmnt.Filters) == 0 {
+			continue
+		}
+
+		for _, higher := range sgmnts[:i] {
+			if covers(higher, sgmnt) {
+				shadowings = append(shadowings, Shadowing{Segment: sgmnt, ShadowedBy: higher})
+				break
+			}
+		}
+	}
+
+	return shadowings
+}
+
+// covers reports whether segment a matches every request matched by segment b.
+func covers(a, b Segment) bool {
+	if len(a.Filters) == 0 {
+		return false
+	}
+
+	for _, filter := range a.Filters {
+		if !allImply(b.Filters, filter) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// allImply reports whether conjunction of filters implies target filter.
+func allImply(filters []Filter, target Filter) bool {
+	if target.Type == GroupFilterType {
+		switch target.Operator {
+		case AndOperator:
+			for _, nested := range target.Filters {
+				if !allImply(filters, nested) {
+					return false
+				}
+			}
+			return len(target.Filters) > 0
+		case OrOperator:
+			if slices.ContainsFunc(target.Filters, func(nested Filter) bool { return allImply(filters, nested) }) {
+				return true
+			}
+		}
+	}
+
+	return slices.ContainsFunc(filters, func(filter Filter) bool { return implies(filter, target) })
+}
+
+// implies reports whether filter b implies filter a, i.e. every request matching b also matches a.
+func implies(b, a Filter) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+
+	if b.Type == GroupFilterType {
+		switch b.Operator {
+		case AndOperator:
+			return len(b.Filters) > 0 && allImply(b.Filters, a)
+		case OrOperator:
+			if len(b.Filters) == 0 {
+				return false
+			}
+			for _, nested := range b.Filters {
+				if !implies(nested, a) {
+					return false
+				}
+			}
+			return true
+		default:
+			return false
+		}
+	}
+
+	if a.Type == GroupFilterType {
+		return allImply([]Filter{b}, a)
+	}
+
+	if a.Type != b.Type || a.Name != b.Name {
+		return false
+	}
+
+	kind, ok := filterKinds[a.Type]
+	if !ok {
+		return false
+	}
+	accepts := func(f Filter, value string) bool { return acceptsValue(kind, f, value) }
+
+	if values, ok := finiteValues(kind, b); ok {
+		for _, v := range values {
+			if !accepts(a, v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if a.Operator == NotEqOperator || a.Operator == NotInOperator {
+		for _, excluded := range a.Values {
+			if accepts(b, excluded) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if a.Operator == StartsWithOperator && b.Operator == StartsWithOperator {
+		for _, prefix := range b.Values {
+			matched := slices.ContainsFunc(a.Values, func(p string) bool {
+				return acceptsValue(kind, Filter{Type: a.Type, Operator: StartsWithOperator, Values: []string{p}}, prefix)
+			})
+			if !matched {
+				return false
+			}
+		}
+		return true
+	}
+
+	if compare := comparator(kind); compare != nil {
+		aRange, aOK := rangeOf(a)
+		bRange, bOK := rangeOf(b)
+		return aOK && bOK && aRange.contains(bRange, compare)
+	}
+
+	return false
+}
+
+func acceptsValue(kind valueKind, f Filter, value string) bool {
+	switch kind {
+	case stringKind:
+		return matchString(f, value, ignoresCase(f.Type))
+	case numberKind:
+		n, err := strconv.ParseFloat(value, 64)
+		return err == nil && matchNumber(f, n)
+	case boolKind:
+		b, err := strconv.ParseBool(value)
+		return err == nil && matchBool(f, b)
+	case versionKind:
+		return matchVersion(f, value)
+	default:
+		return false
+	}
+}
+
+// finiteValues returns the full set of values accepted by filter, if it is finite.
+func finiteValues(kind valueKind, f Filter) ([]string, bool) {
+	switch f.Operator {
+	case EqOperator, InOperator:
+		return f.Values, len(f.Values) > 0
+	case NotEqOperator:
+		if kind == boolKind && len(f.Values) > 0 {
+			b, err := strconv.ParseBool(f.Values[0])
+			return []string{strconv.FormatBool(!b)}, err == nil
+		}
+	}
+
+	return nil, false
+}
+
+func comparator(kind valueKind) func(a, b string) int {
+	switch kind {
+	case numberKind:
+		return func(a, b string) int {
+			x, _ := strconv.ParseFloat(a, 64)
+			y, _ := strconv.ParseFloat(b, 64)
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			default:
+				return 0
+			}
+		}
+	case versionKind:
+		return func(a, b string) int {
+			x, errX := semver.NewVersion(a)
+			y, errY := semver.NewVersion(b)
+			if errX != nil || errY != nil {
+				return strings.Compare(a, b)
+			}
+			return x.Compare(y)
+		}
+	default:
+		return nil
+	}
+}
+
+type bound struct {
+	value     string
+	inclusive bool
+	unbounded bool
+}
+
+type valueRange struct {
+	lower bound
+	upper bound
+}
+
+func rangeOf(f Filter) (valueRange, bool) {
+	unbounded := bound{unbounded: true}
+
+	switch {
+	case f.Operator == BetweenOperator && len(f.Values) == 2:
+		return valueRange{lower: bound{value: f.Values[0], inclusive: true}, upper: bound{value: f.Values[1], inclusive: true}}, true
+	case len(f.Values) == 0:
+		return valueRange{}, false
+	}
+
+	switch f.Operator {
+	case GtOperator:
+		return valueRange{lower: bound{value: f.Values[0]}, upper: unbounded}, true
+	case GteOperator:
+		return valueRange{lower: bound{value: f.Values[0], inclusive: true}, upper: unbounded}, true
+	case LtOperator:
+		return valueRange{lower: unbounded, upper: bound{value: f.Values[0]}}, true
+	case LteOperator:
+		return valueRange{lower: unbounded, upper: bound{value: f.Values[0], inclusive: true}}, true
+	default:
+		return valueRange{}, false
+	}
+}
+
+// contains reports whether r includes every value of other.
+func (r valueRange) contains(other valueRange, compare func(a, b string) int) bool {
+	if !r.lower.unbounded {
+		if other.lower.unbounded {
+			return false
+		}
+		c := compare(other.lower.value, r.lower.value)
+		if c < 0 || (c == 0 && other.lower.inclusive && !r.lower.inclusive) {
+			return false
+		}
+	}
+
+	if !r.upper.unbounded {
+		if other.upper.unbounded {
+			return false
+		}
+		c := compare(other.upper.value, r.upper.value)
+		if c > 0 || (c == 0 && other.upper.inclusive && !r.upper.inclusive) {
+			return false
+		}
+	}
+
+	return true
+}
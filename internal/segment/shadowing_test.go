@@ -0,0 +1,93 @@
+package segment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindShadowed(t *testing.T) {
+	usOnly := Segment{ID: 1, Filters: []Filter{
+		{Type: CountryFilterType, Operator: InOperator, Values: []string{"US", "CA"}},
+	}}
+	usPayers := Segment{ID: 2, Filters: []Filter{
+		{Type: CountryFilterType, Operator: EqOperator, Values: []string{"US"}},
+		{Type: IsPayingFilterType, Operator: EqOperator, Values: []string{"true"}},
+	}}
+	highLevel := Segment{ID: 3, Filters: []Filter{
+		{Type: GameLevelFilterType, Operator: GtOperator, Values: []string{"10"}},
+	}}
+	midLevel := Segment{ID: 4, Filters: []Filter{
+		{Type: GameLevelFilterType, Operator: BetweenOperator, Values: []string{"11", "20"}},
+	}}
+	lowLevel := Segment{ID: 5, Filters: []Filter{
+		{Type: GameLevelFilterType, Operator: BetweenOperator, Values: []string{"10", "20"}},
+	}}
+	notDE := Segment{ID: 6, Filters: []Filter{
+		{Type: CountryFilterType, Operator: NotInOperator, Values: []string{"DE"}},
+	}}
+	newSDK := Segment{ID: 7, Filters: []Filter{
+		{Type: SDKVersionFilterType, Operator: GteOperator, Values: []string{"0.7.0"}},
+	}}
+	newerSDKOrUS := Segment{ID: 8, Filters: []Filter{
+		{Type: GroupFilterType, Operator: OrOperator, Filters: []Filter{
+			{Type: SDKVersionFilterType, Operator: GtOperator, Values: []string{"0.8.0"}},
+			{Type: CountryFilterType, Operator: EqOperator, Values: []string{"US"}},
+		}},
+	}}
+	empty := Segment{ID: 9}
+
+	tests := []struct {
+		name     string
+		segments []Segment
+		want     []Shadowing
+	}{
+		{
+			name:     "narrower country filter is shadowed",
+			segments: []Segment{usOnly, usPayers},
+			want:     []Shadowing{{Segment: usPayers, ShadowedBy: usOnly}},
+		},
+		{
+			name:     "broader segment with lower priority is not shadowed",
+			segments: []Segment{usPayers, usOnly},
+			want:     nil,
+		},
+		{
+			name:     "range is contained in open range",
+			segments: []Segment{highLevel, midLevel, lowLevel},
+			want:     []Shadowing{{Segment: midLevel, ShadowedBy: highLevel}},
+		},
+		{
+			name:     "membership implies exclusion",
+			segments: []Segment{notDE, usOnly},
+			want:     []Shadowing{{Segment: usOnly, ShadowedBy: notDE}},
+		},
+		{
+			name:     "every branch of OR group is covered",
+			segments: []Segment{newSDK, newerSDKOrUS},
+			want:     nil,
+		},
+		{
+			name:     "every branch of OR group is covered by OR target",
+			segments: []Segment{newerSDKOrUS, {ID: 10, Filters: []Filter{{Type: CountryFilterType, Operator: EqOperator, Values: []string{"US"}}}}},
+			want: []Shadowing{{
+				Segment:    Segment{ID: 10, Filters: []Filter{{Type: CountryFilterType, Operator: EqOperator, Values: []string{"US"}}}},
+				ShadowedBy: newerSDKOrUS,
+			}},
+		},
+		{
+			name:     "segment without filters never shadows",
+			segments: []Segment{empty, usOnly},
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindShadowed(tt.segments)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FindShadowed() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
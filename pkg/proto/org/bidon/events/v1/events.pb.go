@@ -0,0 +1,1242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: org/bidon/events/v1/events.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdEvent is an event of ad request lifecycle: config, auction, bid requests, bids, shows, clicks and so on.
+type AdEvent struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp               float64                `protobuf:"fixed64,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EventType               string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	AppId                   int64                  `protobuf:"varint,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AdType                  string                 `protobuf:"bytes,4,opt,name=ad_type,json=adType,proto3" json:"ad_type,omitempty"`
+	AdFormat                string                 `protobuf:"bytes,5,opt,name=ad_format,json=adFormat,proto3" json:"ad_format,omitempty"`
+	AuctionId               string                 `protobuf:"bytes,6,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	AuctionConfigurationId  int64                  `protobuf:"varint,7,opt,name=auction_configuration_id,json=auctionConfigurationId,proto3" json:"auction_configuration_id,omitempty"`
+	AuctionConfigurationUid int64                  `protobuf:"varint,8,opt,name=auction_configuration_uid,json=auctionConfigurationUid,proto3" json:"auction_configuration_uid,omitempty"`
+	Status                  string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	RoundId                 string                 `protobuf:"bytes,10,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	RoundNumber             int64                  `protobuf:"varint,11,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	Impid                   string                 `protobuf:"bytes,12,opt,name=impid,proto3" json:"impid,omitempty"`
+	DemandId                string                 `protobuf:"bytes,13,opt,name=demand_id,json=demandId,proto3" json:"demand_id,omitempty"`
+	Bidding                 bool                   `protobuf:"varint,14,opt,name=bidding,proto3" json:"bidding,omitempty"`
+	AdUnitUid               int64                  `protobuf:"varint,15,opt,name=ad_unit_uid,json=adUnitUid,proto3" json:"ad_unit_uid,omitempty"`
+	AdUnitInternalId        int64                  `protobuf:"varint,16,opt,name=ad_unit_internal_id,json=adUnitInternalId,proto3" json:"ad_unit_internal_id,omitempty"`
+	AdUnitLabel             string                 `protobuf:"bytes,17,opt,name=ad_unit_label,json=adUnitLabel,proto3" json:"ad_unit_label,omitempty"`
+	AdUnitCredentials       map[string]string      `protobuf:"bytes,18,rep,name=ad_unit_credentials,json=adUnitCredentials,proto3" json:"ad_unit_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ecpm                    float64                `protobuf:"fixed64,19,opt,name=ecpm,proto3" json:"ecpm,omitempty"`
+	PriceFloor              float64                `protobuf:"fixed64,20,opt,name=price_floor,json=priceFloor,proto3" json:"price_floor,omitempty"`
+	RawRequest              string                 `protobuf:"bytes,21,opt,name=raw_request,json=rawRequest,proto3" json:"raw_request,omitempty"`
+	RawResponse             string                 `protobuf:"bytes,22,opt,name=raw_response,json=rawResponse,proto3" json:"raw_response,omitempty"`
+	Error                   string                 `protobuf:"bytes,23,opt,name=error,proto3" json:"error,omitempty"`
+	// Start and finish timestamps of auction stages by stage name.
+	TimingMap                   map[string]*Int64List `protobuf:"bytes,24,rep,name=timing_map,json=timingMap,proto3" json:"timing_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalWinnerDemandId      string                `protobuf:"bytes,25,opt,name=external_winner_demand_id,json=externalWinnerDemandId,proto3" json:"external_winner_demand_id,omitempty"`
+	ExternalWinnerEcpm          float64               `protobuf:"fixed64,26,opt,name=external_winner_ecpm,json=externalWinnerEcpm,proto3" json:"external_winner_ecpm,omitempty"`
+	Manufacturer                string                `protobuf:"bytes,27,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Model                       string                `protobuf:"bytes,28,opt,name=model,proto3" json:"model,omitempty"`
+	Os                          string                `protobuf:"bytes,29,opt,name=os,proto3" json:"os,omitempty"`
+	OsVersion                   string                `protobuf:"bytes,30,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
+	ConnectionType              string                `protobuf:"bytes,31,opt,name=connection_type,json=connectionType,proto3" json:"connection_type,omitempty"`
+	DeviceType                  string                `protobuf:"bytes,32,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	UserAgent                   string                `protobuf:"bytes,33,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	SessionId                   string                `protobuf:"bytes,34,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionUptime               int64                 `protobuf:"varint,35,opt,name=session_uptime,json=sessionUptime,proto3" json:"session_uptime,omitempty"`
+	Bundle                      string                `protobuf:"bytes,36,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	Framework                   string                `protobuf:"bytes,37,opt,name=framework,proto3" json:"framework,omitempty"`
+	FrameworkVersion            string                `protobuf:"bytes,38,opt,name=framework_version,json=frameworkVersion,proto3" json:"framework_version,omitempty"`
+	PluginVersion               string                `protobuf:"bytes,39,opt,name=plugin_version,json=pluginVersion,proto3" json:"plugin_version,omitempty"`
+	PackageVersion              string                `protobuf:"bytes,40,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
+	SdkVersion                  string                `protobuf:"bytes,41,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	Idfa                        string                `protobuf:"bytes,42,opt,name=idfa,proto3" json:"idfa,omitempty"`
+	Idg                         string                `protobuf:"bytes,43,opt,name=idg,proto3" json:"idg,omitempty"`
+	Idfv                        string                `protobuf:"bytes,44,opt,name=idfv,proto3" json:"idfv,omitempty"`
+	TrackingAuthorizationStatus string                `protobuf:"bytes,45,opt,name=tracking_authorization_status,json=trackingAuthorizationStatus,proto3" json:"tracking_authorization_status,omitempty"`
+	AppSetId                    string                `protobuf:"bytes,46,opt,name=app_set_id,json=appSetId,proto3" json:"app_set_id,omitempty"`
+	AppSetIdScope               string                `protobuf:"bytes,47,opt,name=app_set_id_scope,json=appSetIdScope,proto3" json:"app_set_id_scope,omitempty"`
+	Coppa                       bool                  `protobuf:"varint,48,opt,name=coppa,proto3" json:"coppa,omitempty"`
+	Gdpr                        bool                  `protobuf:"varint,49,opt,name=gdpr,proto3" json:"gdpr,omitempty"`
+	CountryCode                 string                `protobuf:"bytes,50,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	City                        string                `protobuf:"bytes,51,opt,name=city,proto3" json:"city,omitempty"`
+	Ip                          string                `protobuf:"bytes,52,opt,name=ip,proto3" json:"ip,omitempty"`
+	CountryId                   int64                 `protobuf:"varint,53,opt,name=country_id,json=countryId,proto3" json:"country_id,omitempty"`
+	SegmentId                   string                `protobuf:"bytes,54,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	SegmentUid                  int64                 `protobuf:"varint,55,opt,name=segment_uid,json=segmentUid,proto3" json:"segment_uid,omitempty"`
+	Ext                         string                `protobuf:"bytes,56,opt,name=ext,proto3" json:"ext,omitempty"`
+	Session                     *Session              `protobuf:"bytes,57,opt,name=session,proto3" json:"session,omitempty"`
+	MediationMode               string                `protobuf:"bytes,58,opt,name=mediation_mode,json=mediationMode,proto3" json:"mediation_mode,omitempty"`
+	Mediator                    string                `protobuf:"bytes,59,opt,name=mediator,proto3" json:"mediator,omitempty"`
+	Badv                        string                `protobuf:"bytes,60,opt,name=badv,proto3" json:"badv,omitempty"`
+	Bcat                        string                `protobuf:"bytes,61,opt,name=bcat,proto3" json:"bcat,omitempty"`
+	Bapp                        string                `protobuf:"bytes,62,opt,name=bapp,proto3" json:"bapp,omitempty"`
+	ExperimentId                int64                 `protobuf:"varint,63,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	ExperimentVariantId         string                `protobuf:"bytes,64,opt,name=experiment_variant_id,json=experimentVariantId,proto3" json:"experiment_variant_id,omitempty"`
+	DealId                      string                `protobuf:"bytes,65,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AdEvent) Reset() {
+	*x = AdEvent{}
+	mi := &file_org_bidon_events_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdEvent) ProtoMessage() {}
+
+func (x *AdEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_org_bidon_events_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdEvent.ProtoReflect.Descriptor instead.
+func (*AdEvent) Descriptor() ([]byte, []int) {
+	return file_org_bidon_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdEvent) GetTimestamp() float64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AdEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *AdEvent) GetAppId() int64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *AdEvent) GetAdType() string {
+	if x != nil {
+		return x.AdType
+	}
+	return ""
+}
+
+func (x *AdEvent) GetAdFormat() string {
+	if x != nil {
+		return x.AdFormat
+	}
+	return ""
+}
+
+func (x *AdEvent) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *AdEvent) GetAuctionConfigurationId() int64 {
+	if x != nil {
+		return x.AuctionConfigurationId
+	}
+	return 0
+}
+
+func (x *AdEvent) GetAuctionConfigurationUid() int64 {
+	if x != nil {
+		return x.AuctionConfigurationUid
+	}
+	return 0
+}
+
+func (x *AdEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdEvent) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *AdEvent) GetRoundNumber() int64 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *AdEvent) GetImpid() string {
+	if x != nil {
+		return x.Impid
+	}
+	return ""
+}
+
+func (x *AdEvent) GetDemandId() string {
+	if x != nil {
+		return x.DemandId
+	}
+	return ""
+}
+
+func (x *AdEvent) GetBidding() bool {
+	if x != nil {
+		return x.Bidding
+	}
+	return false
+}
+
+func (x *AdEvent) GetAdUnitUid() int64 {
+	if x != nil {
+		return x.AdUnitUid
+	}
+	return 0
+}
+
+func (x *AdEvent) GetAdUnitInternalId() int64 {
+	if x != nil {
+		return x.AdUnitInternalId
+	}
+	return 0
+}
+
+func (x *AdEvent) GetAdUnitLabel() string {
+	if x != nil {
+		return x.AdUnitLabel
+	}
+	return ""
+}
+
+func (x *AdEvent) GetAdUnitCredentials() map[string]string {
+	if x != nil {
+		return x.AdUnitCredentials
+	}
+	return nil
+}
+
+func (x *AdEvent) GetEcpm() float64 {
+	if x != nil {
+		return x.Ecpm
+	}
+	return 0
+}
+
+func (x *AdEvent) GetPriceFloor() float64 {
+	if x != nil {
+		return x.PriceFloor
+	}
+	return 0
+}
+
+func (x *AdEvent) GetRawRequest() string {
+	if x != nil {
+		return x.RawRequest
+	}
+	return ""
+}
+
+func (x *AdEvent) GetRawResponse() string {
+	if x != nil {
+		return x.RawResponse
+	}
+	return ""
+}
+
+func (x *AdEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AdEvent) GetTimingMap() map[string]*Int64List {
+	if x != nil {
+		return x.TimingMap
+	}
+	return nil
+}
+
+func (x *AdEvent) GetExternalWinnerDemandId() string {
+	if x != nil {
+		return x.ExternalWinnerDemandId
+	}
+	return ""
+}
+
+func (x *AdEvent) GetExternalWinnerEcpm() float64 {
+	if x != nil {
+		return x.ExternalWinnerEcpm
+	}
+	return 0
+}
+
+func (x *AdEvent) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *AdEvent) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AdEvent) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *AdEvent) GetOsVersion() string {
+	if x != nil {
+		return x.OsVersion
+	}
+	return ""
+}
+
+func (x *AdEvent) GetConnectionType() string {
+	if x != nil {
+		return x.ConnectionType
+	}
+	return ""
+}
+
+func (x *AdEvent) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *AdEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AdEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AdEvent) GetSessionUptime() int64 {
+	if x != nil {
+		return x.SessionUptime
+	}
+	return 0
+}
+
+func (x *AdEvent) GetBundle() string {
+	if x != nil {
+		return x.Bundle
+	}
+	return ""
+}
+
+func (x *AdEvent) GetFramework() string {
+	if x != nil {
+		return x.Framework
+	}
+	return ""
+}
+
+func (x *AdEvent) GetFrameworkVersion() string {
+	if x != nil {
+		return x.FrameworkVersion
+	}
+	return ""
+}
+
+func (x *AdEvent) GetPluginVersion() string {
+	if x != nil {
+		return x.PluginVersion
+	}
+	return ""
+}
+
+func (x *AdEvent) GetPackageVersion() string {
+	if x != nil {
+		return x.PackageVersion
+	}
+	return ""
+}
+
+func (x *AdEvent) GetSdkVersion() string {
+	if x != nil {
+		return x.SdkVersion
+	}
+	return ""
+}
+
+func (x *AdEvent) GetIdfa() string {
+	if x != nil {
+		return x.Idfa
+	}
+	return ""
+}
+
+func (x *AdEvent) GetIdg() string {
+	if x != nil {
+		return x.Idg
+	}
+	return ""
+}
+
+func (x *AdEvent) GetIdfv() string {
+	if x != nil {
+		return x.Idfv
+	}
+	return ""
+}
+
+func (x *AdEvent) GetTrackingAuthorizationStatus() string {
+	if x != nil {
+		return x.TrackingAuthorizationStatus
+	}
+	return ""
+}
+
+func (x *AdEvent) GetAppSetId() string {
+	if x != nil {
+		return x.AppSetId
+	}
+	return ""
+}
+
+func (x *AdEvent) GetAppSetIdScope() string {
+	if x != nil {
+		return x.AppSetIdScope
+	}
+	return ""
+}
+
+func (x *AdEvent) GetCoppa() bool {
+	if x != nil {
+		return x.Coppa
+	}
+	return false
+}
+
+func (x *AdEvent) GetGdpr() bool {
+	if x != nil {
+		return x.Gdpr
+	}
+	return false
+}
+
+func (x *AdEvent) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *AdEvent) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *AdEvent) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AdEvent) GetCountryId() int64 {
+	if x != nil {
+		return x.CountryId
+	}
+	return 0
+}
+
+func (x *AdEvent) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *AdEvent) GetSegmentUid() int64 {
+	if x != nil {
+		return x.SegmentUid
+	}
+	return 0
+}
+
+func (x *AdEvent) GetExt() string {
+	if x != nil {
+		return x.Ext
+	}
+	return ""
+}
+
+func (x *AdEvent) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *AdEvent) GetMediationMode() string {
+	if x != nil {
+		return x.MediationMode
+	}
+	return ""
+}
+
+func (x *AdEvent) GetMediator() string {
+	if x != nil {
+		return x.Mediator
+	}
+	return ""
+}
+
+func (x *AdEvent) GetBadv() string {
+	if x != nil {
+		return x.Badv
+	}
+	return ""
+}
+
+func (x *AdEvent) GetBcat() string {
+	if x != nil {
+		return x.Bcat
+	}
+	return ""
+}
+
+func (x *AdEvent) GetBapp() string {
+	if x != nil {
+		return x.Bapp
+	}
+	return ""
+}
+
+func (x *AdEvent) GetExperimentId() int64 {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return 0
+}
+
+func (x *AdEvent) GetExperimentVariantId() string {
+	if x != nil {
+		return x.ExperimentVariantId
+	}
+	return ""
+}
+
+func (x *AdEvent) GetDealId() string {
+	if x != nil {
+		return x.DealId
+	}
+	return ""
+}
+
+// Session is the SDK session of an ad event.
+type Session struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Id                        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LaunchTs                  int64                  `protobuf:"varint,2,opt,name=launch_ts,json=launchTs,proto3" json:"launch_ts,omitempty"`
+	LaunchMonotonicTs         int64                  `protobuf:"varint,3,opt,name=launch_monotonic_ts,json=launchMonotonicTs,proto3" json:"launch_monotonic_ts,omitempty"`
+	StartTs                   int64                  `protobuf:"varint,4,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	StartMonotonicTs          int64                  `protobuf:"varint,5,opt,name=start_monotonic_ts,json=startMonotonicTs,proto3" json:"start_monotonic_ts,omitempty"`
+	Ts                        int64                  `protobuf:"varint,6,opt,name=ts,proto3" json:"ts,omitempty"`
+	MonotonicTs               int64                  `protobuf:"varint,7,opt,name=monotonic_ts,json=monotonicTs,proto3" json:"monotonic_ts,omitempty"`
+	MemoryWarningsTs          []int64                `protobuf:"varint,8,rep,packed,name=memory_warnings_ts,json=memoryWarningsTs,proto3" json:"memory_warnings_ts,omitempty"`
+	MemoryWarningsMonotonicTs []int64                `protobuf:"varint,9,rep,packed,name=memory_warnings_monotonic_ts,json=memoryWarningsMonotonicTs,proto3" json:"memory_warnings_monotonic_ts,omitempty"`
+	RamUsed                   int64                  `protobuf:"varint,10,opt,name=ram_used,json=ramUsed,proto3" json:"ram_used,omitempty"`
+	RamSize                   int64                  `protobuf:"varint,11,opt,name=ram_size,json=ramSize,proto3" json:"ram_size,omitempty"`
+	StorageFree               int64                  `protobuf:"varint,12,opt,name=storage_free,json=storageFree,proto3" json:"storage_free,omitempty"`
+	StorageUsed               int64                  `protobuf:"varint,13,opt,name=storage_used,json=storageUsed,proto3" json:"storage_used,omitempty"`
+	Battery                   float64                `protobuf:"fixed64,14,opt,name=battery,proto3" json:"battery,omitempty"`
+	CpuUsage                  *float64               `protobuf:"fixed64,15,opt,name=cpu_usage,json=cpuUsage,proto3,oneof" json:"cpu_usage,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_org_bidon_events_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_org_bidon_events_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_org_bidon_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetLaunchTs() int64 {
+	if x != nil {
+		return x.LaunchTs
+	}
+	return 0
+}
+
+func (x *Session) GetLaunchMonotonicTs() int64 {
+	if x != nil {
+		return x.LaunchMonotonicTs
+	}
+	return 0
+}
+
+func (x *Session) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+func (x *Session) GetStartMonotonicTs() int64 {
+	if x != nil {
+		return x.StartMonotonicTs
+	}
+	return 0
+}
+
+func (x *Session) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+func (x *Session) GetMonotonicTs() int64 {
+	if x != nil {
+		return x.MonotonicTs
+	}
+	return 0
+}
+
+func (x *Session) GetMemoryWarningsTs() []int64 {
+	if x != nil {
+		return x.MemoryWarningsTs
+	}
+	return nil
+}
+
+func (x *Session) GetMemoryWarningsMonotonicTs() []int64 {
+	if x != nil {
+		return x.MemoryWarningsMonotonicTs
+	}
+	return nil
+}
+
+func (x *Session) GetRamUsed() int64 {
+	if x != nil {
+		return x.RamUsed
+	}
+	return 0
+}
+
+func (x *Session) GetRamSize() int64 {
+	if x != nil {
+		return x.RamSize
+	}
+	return 0
+}
+
+func (x *Session) GetStorageFree() int64 {
+	if x != nil {
+		return x.StorageFree
+	}
+	return 0
+}
+
+func (x *Session) GetStorageUsed() int64 {
+	if x != nil {
+		return x.StorageUsed
+	}
+	return 0
+}
+
+func (x *Session) GetBattery() float64 {
+	if x != nil {
+		return x.Battery
+	}
+	return 0
+}
+
+func (x *Session) GetCpuUsage() float64 {
+	if x != nil && x.CpuUsage != nil {
+		return *x.CpuUsage
+	}
+	return 0
+}
+
+// NotificationEvent is an outcome of win, loss or billing notification delivery to a demand source.
+type NotificationEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     float64                `protobuf:"fixed64,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Bundle        string                 `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	AdType        string                 `protobuf:"bytes,4,opt,name=ad_type,json=adType,proto3" json:"ad_type,omitempty"`
+	DemandId      string                 `protobuf:"bytes,5,opt,name=demand_id,json=demandId,proto3" json:"demand_id,omitempty"`
+	AuctionId     string                 `protobuf:"bytes,6,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	ImpId         string                 `protobuf:"bytes,7,opt,name=imp_id,json=impId,proto3" json:"imp_id,omitempty"`
+	LossReason    int64                  `protobuf:"varint,8,opt,name=loss_reason,json=lossReason,proto3" json:"loss_reason,omitempty"`
+	Ecpm          float64                `protobuf:"fixed64,9,opt,name=ecpm,proto3" json:"ecpm,omitempty"`
+	FirstPrice    float64                `protobuf:"fixed64,10,opt,name=first_price,json=firstPrice,proto3" json:"first_price,omitempty"`
+	SecondPrice   float64                `protobuf:"fixed64,11,opt,name=second_price,json=secondPrice,proto3" json:"second_price,omitempty"`
+	Url           string                 `protobuf:"bytes,12,opt,name=url,proto3" json:"url,omitempty"`
+	TemplateUrl   string                 `protobuf:"bytes,13,opt,name=template_url,json=templateUrl,proto3" json:"template_url,omitempty"`
+	Status        string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts      int64                  `protobuf:"varint,15,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Error         string                 `protobuf:"bytes,16,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationEvent) Reset() {
+	*x = NotificationEvent{}
+	mi := &file_org_bidon_events_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent) ProtoMessage() {}
+
+func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_org_bidon_events_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent.ProtoReflect.Descriptor instead.
+func (*NotificationEvent) Descriptor() ([]byte, []int) {
+	return file_org_bidon_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotificationEvent) GetTimestamp() float64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *NotificationEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetBundle() string {
+	if x != nil {
+		return x.Bundle
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetAdType() string {
+	if x != nil {
+		return x.AdType
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetDemandId() string {
+	if x != nil {
+		return x.DemandId
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetImpId() string {
+	if x != nil {
+		return x.ImpId
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetLossReason() int64 {
+	if x != nil {
+		return x.LossReason
+	}
+	return 0
+}
+
+func (x *NotificationEvent) GetEcpm() float64 {
+	if x != nil {
+		return x.Ecpm
+	}
+	return 0
+}
+
+func (x *NotificationEvent) GetFirstPrice() float64 {
+	if x != nil {
+		return x.FirstPrice
+	}
+	return 0
+}
+
+func (x *NotificationEvent) GetSecondPrice() float64 {
+	if x != nil {
+		return x.SecondPrice
+	}
+	return 0
+}
+
+func (x *NotificationEvent) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetTemplateUrl() string {
+	if x != nil {
+		return x.TemplateUrl
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *NotificationEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Int64List wraps repeated int64 values that can't be used as map values directly.
+type Int64List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []int64                `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64List) Reset() {
+	*x = Int64List{}
+	mi := &file_org_bidon_events_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64List) ProtoMessage() {}
+
+func (x *Int64List) ProtoReflect() protoreflect.Message {
+	mi := &file_org_bidon_events_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64List.ProtoReflect.Descriptor instead.
+func (*Int64List) Descriptor() ([]byte, []int) {
+	return file_org_bidon_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Int64List) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_org_bidon_events_v1_events_proto protoreflect.FileDescriptor
+
+var file_org_bidon_events_v1_events_proto_rawDesc = string([]byte{
+	0x0a, 0x20, 0x6f, 0x72, 0x67, 0x2f, 0x62, 0x69, 0x64, 0x6f, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x6f, 0x72, 0x67, 0x2e, 0x62, 0x69, 0x64, 0x6f, 0x6e, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x22, 0xcb, 0x12, 0x0a, 0x07, 0x41, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x64, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x70, 0x69,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x70, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x69, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x69,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x64, 0x55, 0x6e,
+	0x69, 0x74, 0x55, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x61, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x61, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x55,
+	0x6e, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x63, 0x0a, 0x13, 0x61, 0x64, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18,
+	0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x62, 0x69, 0x64, 0x6f,
+	0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61, 0x64, 0x55, 0x6e,
+	0x69, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x65, 0x63, 0x70, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x65, 0x63, 0x70,
+	0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f,
+	0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4a, 0x0a, 0x0a,
+	0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x62, 0x69, 0x64, 0x6f, 0x6e, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x69, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x12, 0x39, 0x0a, 0x19, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6d, 0x61,
+	0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6d, 0x61, 0x6e,
+	0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x65, 0x63, 0x70, 0x6d, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x12, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x45, 0x63, 0x70, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e,
+	0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x64, 0x6b, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x64, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x66, 0x61, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x69, 0x64, 0x66, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x67, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x66, 0x76, 0x18,
+	0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x64, 0x66, 0x76, 0x12, 0x42, 0x0a, 0x1d, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x2d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1c, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x2e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x70, 0x70, 0x61, 0x18,
+	0x30, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x70, 0x70, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x67, 0x64, 0x70, 0x72, 0x18, 0x31, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x67, 0x64, 0x70, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x33, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x34, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x35, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x36, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x37, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x38, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x62, 0x69, 0x64, 0x6f, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x64, 0x76, 0x18, 0x3c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x61, 0x64, 0x76, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x63, 0x61, 0x74, 0x18,
+	0x3d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x63, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x61, 0x70, 0x70, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x70, 0x70, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x3f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x40, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x41, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x49,
+	0x64, 0x1a, 0x44, 0x0a, 0x16, 0x41, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x69, 0x6e,
+	0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x62, 0x69, 0x64, 0x6f, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x97, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x54, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x6e, 0x6f, 0x74, 0x6f, 0x6e,
+	0x69, 0x63, 0x5f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x75,
+	0x6e, 0x63, 0x68, 0x4d, 0x6f, 0x6e, 0x6f, 0x74, 0x6f, 0x6e, 0x69, 0x63, 0x54, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x6f, 0x74, 0x6f, 0x6e, 0x69, 0x63, 0x5f, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x6f,
+	0x74, 0x6f, 0x6e, 0x69, 0x63, 0x54, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x6f, 0x74,
+	0x6f, 0x6e, 0x69, 0x63, 0x5f, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d,
+	0x6f, 0x6e, 0x6f, 0x74, 0x6f, 0x6e, 0x69, 0x63, 0x54, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x54, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x6d, 0x6f, 0x6e, 0x6f,
+	0x74, 0x6f, 0x6e, 0x69, 0x63, 0x5f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x19,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x4d, 0x6f,
+	0x6e, 0x6f, 0x74, 0x6f, 0x6e, 0x69, 0x63, 0x54, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x6d,
+	0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x72,
+	0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x55, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12,
+	0x20, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xcc, 0x03, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x69, 0x6d, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6d, 0x70, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x73,
+	0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x63, 0x70, 0x6d, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x65, 0x63, 0x70, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x23,
+	0x0a, 0x09, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x42, 0xdf, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x62, 0x69, 0x64, 0x6f, 0x6e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x64, 0x6f, 0x6e,
+	0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x69, 0x64, 0x6f, 0x6e, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x67, 0x2f,
+	0x62, 0x69, 0x64, 0x6f, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x42, 0x45, 0xaa, 0x02,
+	0x13, 0x4f, 0x72, 0x67, 0x2e, 0x42, 0x69, 0x64, 0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4f, 0x72, 0x67, 0x5c, 0x42, 0x69, 0x64, 0x6f, 0x6e,
+	0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4f, 0x72, 0x67,
+	0x5c, 0x42, 0x69, 0x64, 0x6f, 0x6e, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x4f,
+	0x72, 0x67, 0x3a, 0x3a, 0x42, 0x69, 0x64, 0x6f, 0x6e, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_org_bidon_events_v1_events_proto_rawDescOnce sync.Once
+	file_org_bidon_events_v1_events_proto_rawDescData []byte
+)
+
+func file_org_bidon_events_v1_events_proto_rawDescGZIP() []byte {
+	file_org_bidon_events_v1_events_proto_rawDescOnce.Do(func() {
+		file_org_bidon_events_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_org_bidon_events_v1_events_proto_rawDesc), len(file_org_bidon_events_v1_events_proto_rawDesc)))
+	})
+	return file_org_bidon_events_v1_events_proto_rawDescData
+}
+
+var file_org_bidon_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_org_bidon_events_v1_events_proto_goTypes = []any{
+	(*AdEvent)(nil),           // 0: org.bidon.events.v1.AdEvent
+	(*Session)(nil),           // 1: org.bidon.events.v1.Session
+	(*NotificationEvent)(nil), // 2: org.bidon.events.v1.NotificationEvent
+	(*Int64List)(nil),         // 3: org.bidon.events.v1.Int64List
+	nil,                       // 4: org.bidon.events.v1.AdEvent.AdUnitCredentialsEntry
+	nil,                       // 5: org.bidon.events.v1.AdEvent.TimingMapEntry
+}
+var file_org_bidon_events_v1_events_proto_depIdxs = []int32{
+	4, // 0: org.bidon.events.v1.AdEvent.ad_unit_credentials:type_name -> org.bidon.events.v1.AdEvent.AdUnitCredentialsEntry
+	5, // 1: org.bidon.events.v1.AdEvent.timing_map:type_name -> org.bidon.events.v1.AdEvent.TimingMapEntry
+	1, // 2: org.bidon.events.v1.AdEvent.session:type_name -> org.bidon.events.v1.Session
+	3, // 3: org.bidon.events.v1.AdEvent.TimingMapEntry.value:type_name -> org.bidon.events.v1.Int64List
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_org_bidon_events_v1_events_proto_init() }
+func file_org_bidon_events_v1_events_proto_init() {
+	if File_org_bidon_events_v1_events_proto != nil {
+		return
+	}
+	file_org_bidon_events_v1_events_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_org_bidon_events_v1_events_proto_rawDesc), len(file_org_bidon_events_v1_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_org_bidon_events_v1_events_proto_goTypes,
+		DependencyIndexes: file_org_bidon_events_v1_events_proto_depIdxs,
+		MessageInfos:      file_org_bidon_events_v1_events_proto_msgTypes,
+	}.Build()
+	File_org_bidon_events_v1_events_proto = out.File
+	file_org_bidon_events_v1_events_proto_goTypes = nil
+	file_org_bidon_events_v1_events_proto_depIdxs = nil
+}